@@ -30,6 +30,7 @@ require (
 	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1
 	gopkg.in/yaml.v3 v3.0.0
 	helm.sh/helm/v3 v3.9.0
+	sigs.k8s.io/yaml v1.3.0
 )
 
 require (
@@ -187,7 +188,6 @@ require (
 	sigs.k8s.io/kustomize/api v0.11.4 // indirect
 	sigs.k8s.io/kustomize/kyaml v0.13.6 // indirect
 	sigs.k8s.io/structured-merge-diff/v4 v4.2.1 // indirect
-	sigs.k8s.io/yaml v1.3.0 // indirect
 )
 
 // See https://github.com/moby/moby/issues/42939#issuecomment-1114255529
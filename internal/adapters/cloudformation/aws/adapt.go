@@ -20,8 +20,10 @@ import (
 	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/elasticache"
 	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/elasticsearch"
 	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/elb"
+	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/emr"
 	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/iam"
 	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/kinesis"
+	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/kms"
 	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/lambda"
 	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/mq"
 	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/msk"
@@ -30,6 +32,7 @@ import (
 	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/redshift"
 	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/s3"
 	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/sam"
+	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/sfn"
 	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/sns"
 	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/sqs"
 	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/ssm"
@@ -62,15 +65,18 @@ func Adapt(cfFile parser.FileContext) aws.AWS {
 		ElastiCache:   elasticache.Adapt(cfFile),
 		Elasticsearch: elasticsearch.Adapt(cfFile),
 		ELB:           elb.Adapt(cfFile),
+		EMR:           emr.Adapt(cfFile),
 		MSK:           msk.Adapt(cfFile),
 		MQ:            mq.Adapt(cfFile),
 		Kinesis:       kinesis.Adapt(cfFile),
+		KMS:           kms.Adapt(cfFile),
 		Lambda:        lambda.Adapt(cfFile),
 		Neptune:       neptune.Adapt(cfFile),
 		RDS:           rds.Adapt(cfFile),
 		Redshift:      redshift.Adapt(cfFile),
 		S3:            s3.Adapt(cfFile),
 		SAM:           sam.Adapt(cfFile),
+		SFN:           sfn.Adapt(cfFile),
 		SNS:           sns.Adapt(cfFile),
 		SQS:           sqs.Adapt(cfFile),
 		SSM:           ssm.Adapt(cfFile),
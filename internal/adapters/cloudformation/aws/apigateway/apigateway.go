@@ -7,6 +7,7 @@ import (
 
 // Adapt ...
 func Adapt(cfFile parser.FileContext) (gateway apigateway.APIGateway) {
-	gateway.APIs = getApis(cfFile)
+	gateway.APIs = append(getApis(cfFile), getRestApis(cfFile)...)
+	gateway.DomainNames = getDomainNames(cfFile)
 	return gateway
 }
@@ -0,0 +1,38 @@
+package apigateway
+
+import (
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/apigateway"
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/parser"
+)
+
+func getDomainNames(cfFile parser.FileContext) (domainNames []apigateway.DomainName) {
+
+	for _, r := range cfFile.GetResourcesByType("AWS::ApiGateway::DomainName") {
+		domainNames = append(domainNames, apigateway.DomainName{
+			Metadata:       r.Metadata(),
+			Name:           r.GetStringProperty("DomainName"),
+			Version:        types.Int(1, r.Metadata()),
+			SecurityPolicy: r.GetStringProperty("SecurityPolicy", "TLS_1_0"),
+			Tags:           r.GetTags(),
+		})
+	}
+
+	for _, r := range cfFile.GetResourcesByType("AWS::ApiGatewayV2::DomainName") {
+		domainName := apigateway.DomainName{
+			Metadata:       r.Metadata(),
+			Name:           r.GetStringProperty("DomainName"),
+			Version:        types.Int(2, r.Metadata()),
+			SecurityPolicy: types.StringDefault("TLS_1_0", r.Metadata()),
+			Tags:           r.GetTags(),
+		}
+		if configurations := r.GetProperty("DomainNameConfigurations"); configurations.IsList() {
+			for _, configuration := range configurations.AsList() {
+				domainName.SecurityPolicy = configuration.GetStringProperty("SecurityPolicy", "TLS_1_0")
+			}
+		}
+		domainNames = append(domainNames, domainName)
+	}
+
+	return domainNames
+}
@@ -0,0 +1,111 @@
+package apigateway
+
+import (
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/apigateway"
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/parser"
+)
+
+func getRestApis(cfFile parser.FileContext) (apis []apigateway.API) {
+
+	apiResources := cfFile.GetResourcesByType("AWS::ApiGateway::RestApi")
+	for _, apiRes := range apiResources {
+		api := apigateway.API{
+			Metadata:     apiRes.Metadata(),
+			Name:         apiRes.GetStringProperty("Name"),
+			Version:      types.Int(1, apiRes.Metadata()),
+			ProtocolType: types.StringDefault(apigateway.ProtocolTypeREST, apiRes.Metadata()),
+			Stages:       getRestStages(apiRes.ID(), cfFile),
+			RESTMethods:  getRestMethods(apiRes.ID(), cfFile),
+			Tags:         apiRes.GetTags(),
+		}
+		apis = append(apis, api)
+	}
+
+	return apis
+}
+
+func getRestStages(apiId string, cfFile parser.FileContext) []apigateway.Stage {
+	var apiStages []apigateway.Stage
+
+	stageResources := cfFile.GetResourcesByType("AWS::ApiGateway::Stage")
+	for _, r := range stageResources {
+		if r.GetStringProperty("RestApiId").Value() != apiId {
+			continue
+		}
+
+		s := apigateway.Stage{
+			Metadata: r.Metadata(),
+			Name:     r.GetStringProperty("StageName"),
+			Version:  types.Int(1, r.Metadata()),
+			AccessLogging: apigateway.AccessLogging{
+				Metadata:              r.Metadata(),
+				CloudwatchLogGroupARN: types.StringDefault("", r.Metadata()),
+			},
+			RESTMethodSettings: getMethodSettings(r),
+			XRayTracingEnabled: r.GetBoolProperty("TracingEnabled"),
+			Tags:               r.GetTags(),
+		}
+
+		if logging := r.GetProperty("AccessLogSetting"); logging.IsNotNil() {
+			s.AccessLogging.Metadata = logging.Metadata()
+			s.AccessLogging.CloudwatchLogGroupARN = r.GetStringProperty("AccessLogSetting.DestinationArn")
+		}
+
+		apiStages = append(apiStages, s)
+	}
+
+	return apiStages
+}
+
+// getMethodSettings uses the method settings which enable caching, if there are any, as those are the ones where
+// the encryption of the cache matters
+func getMethodSettings(r *parser.Resource) apigateway.RESTMethodSettings {
+	settings := apigateway.RESTMethodSettings{
+		Metadata:           r.Metadata(),
+		CacheDataEncrypted: types.BoolDefault(false, r.Metadata()),
+		CacheEnabled:       types.BoolDefault(false, r.Metadata()),
+	}
+
+	methodSettings := r.GetProperty("MethodSettings")
+	if methodSettings.IsNil() || methodSettings.IsNotList() {
+		return settings
+	}
+
+	for i, setting := range methodSettings.AsList() {
+		cacheEnabled := setting.GetBoolProperty("CachingEnabled")
+		if i > 0 && cacheEnabled.IsFalse() {
+			continue
+		}
+		settings = apigateway.RESTMethodSettings{
+			Metadata:           setting.Metadata(),
+			CacheDataEncrypted: setting.GetBoolProperty("CacheDataEncrypted"),
+			CacheEnabled:       cacheEnabled,
+		}
+		if cacheEnabled.IsTrue() {
+			break
+		}
+	}
+
+	return settings
+}
+
+func getRestMethods(apiId string, cfFile parser.FileContext) []apigateway.RESTMethod {
+	var methods []apigateway.RESTMethod
+
+	methodResources := cfFile.GetResourcesByType("AWS::ApiGateway::Method")
+	for _, r := range methodResources {
+		if r.GetStringProperty("RestApiId").Value() != apiId {
+			continue
+		}
+
+		methods = append(methods, apigateway.RESTMethod{
+			Metadata:          r.Metadata(),
+			HTTPMethod:        r.GetStringProperty("HttpMethod"),
+			AuthorizationType: r.GetStringProperty("AuthorizationType", apigateway.AuthorizationNone),
+			APIKeyRequired:    r.GetBoolProperty("ApiKeyRequired"),
+		})
+	}
+
+	return methods
+}
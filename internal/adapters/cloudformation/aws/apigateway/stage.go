@@ -12,11 +12,12 @@ func getApis(cfFile parser.FileContext) (apis []apigateway.API) {
 	for _, apiRes := range apiResources {
 		api := apigateway.API{
 			Metadata:     apiRes.Metadata(),
-			Name:         types.StringDefault("", apiRes.Metadata()),
+			Name:         apiRes.GetStringProperty("Name"),
 			Version:      types.Int(2, apiRes.Metadata()),
-			ProtocolType: types.StringDefault("", apiRes.Metadata()),
+			ProtocolType: apiRes.GetStringProperty("ProtocolType"),
 			Stages:       getStages(apiRes.ID(), cfFile),
 			RESTMethods:  nil,
+			Tags:         apiRes.GetTags(),
 		}
 		apis = append(apis, api)
 	}
@@ -45,6 +46,7 @@ func getStages(apiId string, cfFile parser.FileContext) []apigateway.Stage {
 				CacheEnabled:       types.BoolDefault(false, r.Metadata()),
 			},
 			XRayTracingEnabled: types.BoolUnresolvable(r.Metadata()),
+			Tags:               r.GetTags(),
 		}
 		apiStages = append(apiStages, s)
 	}
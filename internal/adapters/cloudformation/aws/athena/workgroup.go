@@ -21,6 +21,7 @@ func getWorkGroups(cfFile parser.FileContext) []athena.Workgroup {
 				Type:     r.GetStringProperty("WorkGroupConfiguration.ResultConfiguration.EncryptionConfiguration.EncryptionOption"),
 			},
 			EnforceConfiguration: r.GetBoolProperty("WorkGroupConfiguration.EnforceWorkGroupConfiguration"),
+			Tags:                 r.GetTags(),
 		}
 
 		workgroups = append(workgroups, wg)
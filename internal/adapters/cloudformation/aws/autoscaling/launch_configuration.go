@@ -14,7 +14,7 @@ func getLaunchConfigurations(file parser.FileContext) (launchConfigurations []au
 
 		launchConfig := autoscaling.LaunchConfiguration{
 			Metadata:          r.Metadata(),
-			Name:              r.GetStringProperty("Name"),
+			Name:              r.GetStringProperty("LaunchConfigurationName"),
 			AssociatePublicIP: r.GetBoolProperty("AssociatePublicIpAddress"),
 			MetadataOptions: ec2.MetadataOptions{
 				Metadata:     r.Metadata(),
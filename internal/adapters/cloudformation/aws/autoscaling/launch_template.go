@@ -25,6 +25,7 @@ func getLaunchTemplates(file parser.FileContext) (templates []autoscaling.Launch
 				SecurityGroups:  nil,
 				RootBlockDevice: nil,
 				EBSBlockDevices: nil,
+				Tags:            r.GetTags(),
 			},
 		}
 
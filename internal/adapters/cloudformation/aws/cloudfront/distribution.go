@@ -15,13 +15,23 @@ func getDistributions(ctx parser.FileContext) (distributions []cloudfront.Distri
 			Metadata: r.Metadata(),
 			WAFID:    r.GetStringProperty("DistributionConfig.WebACLId"),
 			Logging: cloudfront.Logging{
-				Bucket: r.GetStringProperty("DistributionConfig.Logging.Bucket"),
+				Metadata: r.Metadata(),
+				Bucket:   r.GetStringProperty("DistributionConfig.Logging.Bucket"),
 			},
 			DefaultCacheBehaviour:  getDefaultCacheBehaviour(r),
-			OrdererCacheBehaviours: nil,
+			OrdererCacheBehaviours: getOrderedCacheBehaviours(r),
 			ViewerCertificate: cloudfront.ViewerCertificate{
+				Metadata:               r.Metadata(),
 				MinimumProtocolVersion: r.GetStringProperty("DistributionConfig.ViewerCertificate.MinimumProtocolVersion"),
 			},
+			Tags: r.GetTags(),
+		}
+
+		if logging := r.GetProperty("DistributionConfig.Logging"); logging.IsNotNil() {
+			distribution.Logging.Metadata = logging.Metadata()
+		}
+		if certificate := r.GetProperty("DistributionConfig.ViewerCertificate"); certificate.IsNotNil() {
+			distribution.ViewerCertificate.Metadata = certificate.Metadata()
 		}
 
 		distributions = append(distributions, distribution)
@@ -51,3 +61,19 @@ func getDefaultCacheBehaviour(r *parser.Resource) cloudfront.CacheBehaviour {
 		ViewerProtocolPolicy: protoProp.AsStringValue(),
 	}
 }
+
+func getOrderedCacheBehaviours(r *parser.Resource) (behaviours []cloudfront.CacheBehaviour) {
+	cacheBehaviours := r.GetProperty("DistributionConfig.CacheBehaviors")
+	if cacheBehaviours.IsNil() || cacheBehaviours.IsNotList() {
+		return nil
+	}
+
+	for _, behaviour := range cacheBehaviours.AsList() {
+		behaviours = append(behaviours, cloudfront.CacheBehaviour{
+			Metadata:             behaviour.Metadata(),
+			ViewerProtocolPolicy: behaviour.GetStringProperty("ViewerProtocolPolicy", "allow-all"),
+		})
+	}
+
+	return behaviours
+}
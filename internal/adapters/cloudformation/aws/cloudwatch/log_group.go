@@ -15,6 +15,7 @@ func getLogGroups(ctx parser.FileContext) (logGroups []cloudwatch.LogGroup) {
 			Name:            r.GetStringProperty("LogGroupName"),
 			KMSKeyID:        r.GetStringProperty("KmsKeyId"),
 			RetentionInDays: r.GetIntProperty("RetentionInDays", 0),
+			Tags:            r.GetTags(),
 		}
 		logGroups = append(logGroups, group)
 	}
@@ -15,6 +15,7 @@ func getProjects(ctx parser.FileContext) (projects []codebuild.Project) {
 			Metadata:                  r.Metadata(),
 			ArtifactSettings:          getArtifactSettings(r),
 			SecondaryArtifactSettings: getSecondaryArtifactSettings(r),
+			Tags:                      r.GetTags(),
 		}
 
 		projects = append(projects, project)
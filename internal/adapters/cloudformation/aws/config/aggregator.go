@@ -15,6 +15,7 @@ func getConfiguraionAggregator(ctx parser.FileContext) (aggregator config.Config
 			Metadata:         types.NewUnmanagedMetadata(),
 			SourceAllRegions: types.BoolDefault(false, ctx.Metadata()),
 			IsDefined:        false,
+			Tags:             types.MapDefault(make(map[string]string), ctx.Metadata()),
 		}
 	}
 
@@ -22,6 +23,7 @@ func getConfiguraionAggregator(ctx parser.FileContext) (aggregator config.Config
 		Metadata:         aggregatorResources[0].Metadata(),
 		SourceAllRegions: isSourcingAllRegions(aggregatorResources[0]),
 		IsDefined:        true,
+		Tags:             aggregatorResources[0].GetTags(),
 	}
 }
 
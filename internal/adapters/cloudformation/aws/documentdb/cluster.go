@@ -18,6 +18,7 @@ func getClusters(ctx parser.FileContext) (clusters []documentdb.Cluster) {
 			Instances:         nil,
 			StorageEncrypted:  r.GetBoolProperty("StorageEncrypted"),
 			KMSKeyID:          r.GetStringProperty("KmsKeyId"),
+			Tags:              r.GetTags(),
 		}
 
 		updateInstancesOnCluster(&cluster, ctx)
@@ -37,6 +38,7 @@ func updateInstancesOnCluster(cluster *documentdb.Cluster, ctx parser.FileContex
 			cluster.Instances = append(cluster.Instances, documentdb.Instance{
 				Metadata: r.Metadata(),
 				KMSKeyID: cluster.KMSKeyID,
+				Tags:     r.GetTags(),
 			})
 		}
 	}
@@ -19,6 +19,7 @@ func getClusters(file parser.FileContext) (clusters []dynamodb.DAXCluster) {
 				KMSKeyID: types.StringDefault("", r.Metadata()),
 			},
 			PointInTimeRecovery: types.BoolUnresolvable(r.Metadata()),
+			Tags:                r.GetTags(),
 		}
 
 		if sseProp := r.GetProperty("SSESpecification"); sseProp.IsNotNil() {
@@ -9,6 +9,7 @@ import (
 func Adapt(cfFile parser.FileContext) (result dynamodb.DynamoDB) {
 
 	result.DAXClusters = getClusters(cfFile)
+	result.Tables = getTables(cfFile)
 	return result
 
 }
@@ -0,0 +1,37 @@
+package dynamodb
+
+import (
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/dynamodb"
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/parser"
+)
+
+func getTables(file parser.FileContext) (tables []dynamodb.Table) {
+
+	tableResources := file.GetResourcesByType("AWS::DynamoDB::Table")
+
+	for _, r := range tableResources {
+		table := dynamodb.Table{
+			Metadata: r.Metadata(),
+			ServerSideEncryption: dynamodb.ServerSideEncryption{
+				Metadata: r.Metadata(),
+				Enabled:  types.BoolDefault(false, r.Metadata()),
+				KMSKeyID: types.StringDefault("", r.Metadata()),
+			},
+			PointInTimeRecovery: r.GetBoolProperty("PointInTimeRecoverySpecification.PointInTimeRecoveryEnabled"),
+			Tags:                r.GetTags(),
+		}
+
+		if sseProp := r.GetProperty("SSESpecification"); sseProp.IsNotNil() {
+			table.ServerSideEncryption = dynamodb.ServerSideEncryption{
+				Metadata: sseProp.Metadata(),
+				Enabled:  r.GetBoolProperty("SSESpecification.SSEEnabled"),
+				KMSKeyID: r.GetStringProperty("SSESpecification.KMSMasterKeyId", dynamodb.DefaultKMSKeyID),
+			}
+		}
+
+		tables = append(tables, table)
+	}
+
+	return tables
+}
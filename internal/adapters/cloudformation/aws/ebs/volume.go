@@ -17,6 +17,7 @@ func getVolumes(ctx parser.FileContext) (volumes []ebs.Volume) {
 				Enabled:  r.GetBoolProperty("Encrypted"),
 				KMSKeyID: r.GetStringProperty("KmsKeyId"),
 			},
+			Tags: r.GetTags(),
 		}
 
 		volumes = append(volumes, volume)
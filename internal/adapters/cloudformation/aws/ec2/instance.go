@@ -24,6 +24,7 @@ func getInstances(ctx parser.FileContext) (instances []ec2.Instance) {
 			SecurityGroups:  nil,
 			RootBlockDevice: nil,
 			EBSBlockDevices: nil,
+			Tags:            r.GetTags(),
 		}
 		blockDevices := getBlockDevices(r)
 		for i, device := range blockDevices {
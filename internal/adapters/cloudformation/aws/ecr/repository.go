@@ -32,6 +32,7 @@ func getRepositories(ctx parser.FileContext) (repositories []ecr.Repository) {
 				Type:     types.StringDefault(ecr.EncryptionTypeAES256, r.Metadata()),
 				KMSKeyID: types.StringDefault("", r.Metadata()),
 			},
+			Tags: r.GetTags(),
 		}
 
 		if imageScanningProp := r.GetProperty("ImageScanningConfiguration"); imageScanningProp.IsNotNil() {
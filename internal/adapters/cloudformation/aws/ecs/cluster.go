@@ -15,6 +15,7 @@ func getClusters(ctx parser.FileContext) (clusters []ecs.Cluster) {
 		cluster := ecs.Cluster{
 			Metadata: r.Metadata(),
 			Settings: getClusterSettings(r),
+			Tags:     r.GetTags(),
 		}
 
 		clusters = append(clusters, cluster)
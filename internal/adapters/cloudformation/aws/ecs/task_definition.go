@@ -15,6 +15,7 @@ func getTaskDefinitions(ctx parser.FileContext) (taskDefinitions []ecs.TaskDefin
 			Metadata:             r.Metadata(),
 			Volumes:              getVolumes(r),
 			ContainerDefinitions: getContainerDefinitions(r),
+			Tags:                 r.GetTags(),
 		}
 
 		taskDefinitions = append(taskDefinitions, taskDef)
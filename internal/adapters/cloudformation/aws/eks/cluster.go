@@ -12,20 +12,24 @@ func getClusters(ctx parser.FileContext) (clusters []eks.Cluster) {
 
 	for _, r := range clusterResources {
 		cluster := eks.Cluster{
-			Metadata: r.Metadata(),
-			// Logging not supported for cloudformation https://github.com/aws/containers-roadmap/issues/242
-			Logging: eks.Logging{
-				Metadata:          r.Metadata(),
-				API:               types.BoolUnresolvable(r.Metadata()),
-				Audit:             types.BoolUnresolvable(r.Metadata()),
-				Authenticator:     types.BoolUnresolvable(r.Metadata()),
-				ControllerManager: types.BoolUnresolvable(r.Metadata()),
-				Scheduler:         types.BoolUnresolvable(r.Metadata()),
-			},
-			Encryption: getEncryptionConfig(r),
-			// endpoint protection not supported - https://github.com/aws/containers-roadmap/issues/242
-			PublicAccessEnabled: types.BoolUnresolvable(r.Metadata()),
+			Metadata:            r.Metadata(),
+			Logging:             getLogging(r),
+			Encryption:          getEncryptionConfig(r),
+			PublicAccessEnabled: types.BoolDefault(true, r.Metadata()),
 			PublicAccessCIDRs:   nil,
+			Tags:                r.GetTags(),
+		}
+
+		if vpcProp := r.GetProperty("ResourcesVpcConfig"); vpcProp.IsNotNil() {
+			cluster.PublicAccessEnabled = vpcProp.GetBoolProperty("EndpointPublicAccess", true)
+			if cidrsProp := vpcProp.GetProperty("PublicAccessCidrs"); cidrsProp.IsList() {
+				for _, cidr := range cidrsProp.AsList() {
+					cluster.PublicAccessCIDRs = append(cluster.PublicAccessCIDRs, cidr.AsStringValue())
+				}
+			}
+			if len(cluster.PublicAccessCIDRs) == 0 {
+				cluster.PublicAccessCIDRs = append(cluster.PublicAccessCIDRs, types.StringDefault("0.0.0.0/0", vpcProp.Metadata()))
+			}
 		}
 
 		clusters = append(clusters, cluster)
@@ -33,6 +37,43 @@ func getClusters(ctx parser.FileContext) (clusters []eks.Cluster) {
 	return clusters
 }
 
+func getLogging(r *parser.Resource) eks.Logging {
+
+	logging := eks.Logging{
+		Metadata:          r.Metadata(),
+		API:               types.BoolDefault(false, r.Metadata()),
+		Audit:             types.BoolDefault(false, r.Metadata()),
+		Authenticator:     types.BoolDefault(false, r.Metadata()),
+		ControllerManager: types.BoolDefault(false, r.Metadata()),
+		Scheduler:         types.BoolDefault(false, r.Metadata()),
+	}
+
+	enabledTypes := r.GetProperty("Logging.ClusterLogging.EnabledTypes")
+	if enabledTypes.IsNil() || enabledTypes.IsNotList() {
+		return logging
+	}
+
+	logging.Metadata = enabledTypes.Metadata()
+	for _, enabledType := range enabledTypes.AsList() {
+		logType := enabledType.GetStringProperty("Type")
+		enabled := types.Bool(true, enabledType.Metadata())
+		switch logType.Value() {
+		case "api":
+			logging.API = enabled
+		case "audit":
+			logging.Audit = enabled
+		case "authenticator":
+			logging.Authenticator = enabled
+		case "controllerManager":
+			logging.ControllerManager = enabled
+		case "scheduler":
+			logging.Scheduler = enabled
+		}
+	}
+
+	return logging
+}
+
 func getEncryptionConfig(r *parser.Resource) eks.Encryption {
 
 	encryption := eks.Encryption{
@@ -15,6 +15,7 @@ func getClusterGroups(ctx parser.FileContext) (clusters []elasticache.Cluster) {
 			Engine:                 r.GetStringProperty("Engine"),
 			NodeType:               r.GetStringProperty("CacheNodeType"),
 			SnapshotRetentionLimit: r.GetIntProperty("SnapshotRetentionLimit"),
+			Tags:                   r.GetTags(),
 		}
 
 		clusters = append(clusters, cluster)
@@ -14,6 +14,7 @@ func getReplicationGroups(ctx parser.FileContext) (replicationGroups []elasticac
 			Metadata:                 r.Metadata(),
 			TransitEncryptionEnabled: r.GetBoolProperty("TransitEncryptionEnabled"),
 			AtRestEncryptionEnabled:  r.GetBoolProperty("AtRestEncryptionEnabled"),
+			Tags:                     r.GetTags(),
 		}
 
 		replicationGroups = append(replicationGroups, replicationGroup)
@@ -32,6 +32,7 @@ func getDomains(ctx parser.FileContext) (domains []elasticsearch.Domain) {
 				EnforceHTTPS: types.BoolDefault(false, r.Metadata()),
 				TLSPolicy:    types.StringDefault("Policy-Min-TLS-1-0-2019-07", r.Metadata()),
 			},
+			Tags: r.GetTags(),
 		}
 
 		if prop := r.GetProperty("LogPublishingOptions"); prop.IsNotNil() {
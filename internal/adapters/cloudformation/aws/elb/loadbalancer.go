@@ -17,6 +17,7 @@ func getLoadBalancers(ctx parser.FileContext) (loadbalancers []elb.LoadBalancer)
 			DropInvalidHeaderFields: checkForDropInvalidHeaders(r),
 			Internal:                isInternal(r),
 			Listeners:               getListeners(r, ctx),
+			Tags:                    r.GetTags(),
 		}
 		loadbalancers = append(loadbalancers, lb)
 	}
@@ -30,10 +31,18 @@ func getListeners(lbr *parser.Resource, ctx parser.FileContext) (listeners []elb
 
 	for _, r := range listenerResources {
 		if r.GetStringProperty("LoadBalancerArn").Value() == lbr.ID() {
+			protocol := r.GetStringProperty("Protocol", "HTTP")
+
+			// a default policy is only used by listeners which terminate TLS
+			defaultPolicy := ""
+			if protocol.EqualTo("HTTPS") || protocol.EqualTo("TLS") {
+				defaultPolicy = "ELBSecurityPolicy-2016-08"
+			}
+
 			listener := elb.Listener{
 				Metadata:      r.Metadata(),
-				Protocol:      r.GetStringProperty("Protocol", "HTTP"),
-				TLSPolicy:     r.GetStringProperty("SslPolicy", "ELBSecurityPolicy-2016-08"),
+				Protocol:      protocol,
+				TLSPolicy:     r.GetStringProperty("SslPolicy", defaultPolicy),
 				DefaultAction: getDefaultListenerAction(r),
 			}
 
@@ -43,12 +52,18 @@ func getListeners(lbr *parser.Resource, ctx parser.FileContext) (listeners []elb
 	return listeners
 }
 
-func getDefaultListenerAction(r *parser.Resource) (action elb.Action) {
+func getDefaultListenerAction(r *parser.Resource) elb.Action {
+	action := elb.Action{
+		Metadata: r.Metadata(),
+		Type:     types.StringDefault("", r.Metadata()),
+	}
 	defaultActionsProp := r.GetProperty("DefaultActions")
 	if defaultActionsProp.IsNotList() || len(defaultActionsProp.AsList()) == 0 {
 		return action
 	}
-	action.Type = defaultActionsProp.AsList()[0].GetProperty("Type").AsStringValue()
+	defaultAction := defaultActionsProp.AsList()[0]
+	action.Metadata = defaultAction.Metadata()
+	action.Type = defaultAction.GetStringProperty("Type")
 	return action
 }
 
@@ -72,11 +87,7 @@ func checkForDropInvalidHeaders(r *parser.Resource) types.BoolValue {
 		}
 
 		if attr.AsMap()["Key"].AsString() == "routing.http.drop_invalid_header_fields.enabled" {
-			val := attr.AsMap()["Value"]
-			if val.IsBool() {
-				return val.AsBoolValue()
-			}
-
+			return attr.GetBoolProperty("Value")
 		}
 	}
 
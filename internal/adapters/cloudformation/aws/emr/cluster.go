@@ -0,0 +1,28 @@
+package emr
+
+import (
+	"github.com/aquasecurity/defsec/pkg/providers/aws/emr"
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/parser"
+)
+
+func getClusters(ctx parser.FileContext) (clusters []emr.Cluster) {
+
+	clusterResources := ctx.GetResourcesByType("AWS::EMR::Cluster")
+
+	for _, r := range clusterResources {
+		cluster := emr.Cluster{
+			Metadata: r.Metadata(),
+			Settings: emr.ClusterSettings{
+				Metadata:     r.Metadata(),
+				Name:         r.GetStringProperty("Name"),
+				ReleaseLabel: r.GetStringProperty("ReleaseLabel"),
+				ServiceRole:  r.GetStringProperty("ServiceRole"),
+			},
+			Tags: r.GetTags(),
+		}
+
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters
+}
@@ -0,0 +1,15 @@
+package emr
+
+import (
+	"github.com/aquasecurity/defsec/pkg/providers/aws/emr"
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/parser"
+)
+
+// Adapt ...
+func Adapt(cfFile parser.FileContext) (result emr.EMR) {
+
+	result.Clusters = getClusters(cfFile)
+	result.SecurityConfiguration = getSecurityConfigurations(cfFile)
+	return result
+
+}
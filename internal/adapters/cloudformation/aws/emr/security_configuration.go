@@ -0,0 +1,31 @@
+package emr
+
+import (
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/emr"
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/parser"
+)
+
+func getSecurityConfigurations(ctx parser.FileContext) (configurations []emr.SecurityConfiguration) {
+
+	configurationResources := ctx.GetResourcesByType("AWS::EMR::SecurityConfiguration")
+
+	for _, r := range configurationResources {
+		configuration := emr.SecurityConfiguration{
+			Metadata:      r.Metadata(),
+			Name:          r.GetStringProperty("Name"),
+			Configuration: types.StringDefault("", r.Metadata()),
+		}
+
+		// the configuration can be given either as a JSON string or as an object in the template
+		if configProp := r.GetProperty("SecurityConfiguration"); configProp.IsString() {
+			configuration.Configuration = configProp.AsStringValue()
+		} else if configProp.IsMap() {
+			configuration.Configuration = types.String(configProp.GetJsonBytesAsString(), configProp.Metadata())
+		}
+
+		configurations = append(configurations, configuration)
+	}
+
+	return configurations
+}
@@ -37,6 +37,7 @@ func getRoles(ctx parser.FileContext) (roles []iam.Role) {
 			Metadata: roleResource.Metadata(),
 			Name:     roleName,
 			Policies: getPoliciesDocs(policyProp),
+			Tags:     roleResource.GetTags(),
 		})
 	}
 	return roles
@@ -45,12 +46,13 @@ func getRoles(ctx parser.FileContext) (roles []iam.Role) {
 func getUsers(ctx parser.FileContext) (users []iam.User) {
 	for _, userResource := range ctx.GetResourcesByType("AWS::IAM::User") {
 		policyProp := userResource.GetProperty("Policies")
-		userName := userResource.GetStringProperty("GroupName")
+		userName := userResource.GetStringProperty("UserName")
 
 		users = append(users, iam.User{
 			Metadata: userResource.Metadata(),
 			Name:     userName,
 			Policies: getPoliciesDocs(policyProp),
+			Tags:     userResource.GetTags(),
 		})
 	}
 	return users
@@ -19,6 +19,7 @@ func getStreams(ctx parser.FileContext) (streams []kinesis.Stream) {
 				Type:     types.StringDefault("KMS", r.Metadata()),
 				KMSKeyID: types.StringDefault("", r.Metadata()),
 			},
+			Tags: r.GetTags(),
 		}
 
 		if prop := r.GetProperty("StreamEncryption"); prop.IsNotNil() {
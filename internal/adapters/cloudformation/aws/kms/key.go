@@ -0,0 +1,24 @@
+package kms
+
+import (
+	"github.com/aquasecurity/defsec/pkg/providers/aws/kms"
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/parser"
+)
+
+func getKeys(ctx parser.FileContext) (keys []kms.Key) {
+
+	keyResources := ctx.GetResourcesByType("AWS::KMS::Key")
+
+	for _, r := range keyResources {
+		key := kms.Key{
+			Metadata:        r.Metadata(),
+			Usage:           r.GetStringProperty("KeyUsage", "ENCRYPT_DECRYPT"),
+			RotationEnabled: r.GetBoolProperty("EnableKeyRotation"),
+			Tags:            r.GetTags(),
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys
+}
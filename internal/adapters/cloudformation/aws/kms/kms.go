@@ -0,0 +1,14 @@
+package kms
+
+import (
+	"github.com/aquasecurity/defsec/pkg/providers/aws/kms"
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/parser"
+)
+
+// Adapt ...
+func Adapt(cfFile parser.FileContext) (result kms.KMS) {
+
+	result.Keys = getKeys(cfFile)
+	return result
+
+}
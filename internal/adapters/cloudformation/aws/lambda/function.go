@@ -19,6 +19,7 @@ func getFunctions(ctx parser.FileContext) (functions []lambda.Function) {
 				Mode:     types.StringDefault("PassThrough", r.Metadata()),
 			},
 			Permissions: getPermissions(r, ctx),
+			Tags:        r.GetTags(),
 		}
 
 		if prop := r.GetProperty("TracingConfig"); prop.IsNotNil() {
@@ -17,6 +17,7 @@ func getBrokers(ctx parser.FileContext) (brokers []mq.Broker) {
 				General:  types.BoolDefault(false, r.Metadata()),
 				Audit:    types.BoolDefault(false, r.Metadata()),
 			},
+			Tags: r.GetTags(),
 		}
 
 		if prop := r.GetProperty("Logs"); prop.IsNotNil() {
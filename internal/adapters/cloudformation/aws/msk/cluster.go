@@ -33,6 +33,7 @@ func getClusters(ctx parser.FileContext) (clusters []msk.Cluster) {
 					},
 				},
 			},
+			Tags: r.GetTags(),
 		}
 
 		if encProp := r.GetProperty("EncryptionInfo.EncryptionInTransit"); encProp.IsNotNil() {
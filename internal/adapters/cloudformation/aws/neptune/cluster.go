@@ -17,6 +17,7 @@ func getClusters(ctx parser.FileContext) (clusters []neptune.Cluster) {
 			},
 			StorageEncrypted: r.GetBoolProperty("StorageEncrypted"),
 			KMSKeyID:         r.GetStringProperty("KmsKeyId"),
+			Tags:             r.GetTags(),
 		}
 		clusters = append(clusters, cluster)
 	}
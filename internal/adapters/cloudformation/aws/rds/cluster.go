@@ -24,13 +24,14 @@ func getClusters(ctx parser.FileContext) (clusters map[string]rds.Cluster) {
 				EncryptStorage: types.BoolDefault(false, clusterResource.Metadata()),
 				KMSKeyID:       types.StringDefault("", clusterResource.Metadata()),
 			},
+			Tags: clusterResource.GetTags(),
 		}
 
 		if backupProp := clusterResource.GetProperty("BackupRetentionPeriod"); backupProp.IsInt() {
 			cluster.BackupRetentionPeriodDays = backupProp.AsIntValue()
 		}
 
-		if replicaProp := clusterResource.GetProperty("SourceDBInstanceIdentifier"); replicaProp.IsString() {
+		if replicaProp := clusterResource.GetProperty("ReplicationSourceIdentifier"); replicaProp.IsString() {
 			cluster.ReplicationSourceARN = replicaProp.AsStringValue()
 		}
 
@@ -26,6 +26,7 @@ func getClustersAndInstances(ctx parser.FileContext) (clusters []rds.Cluster, or
 				KMSKeyID:       r.GetStringProperty("KmsKeyId"),
 			},
 			PublicAccess: r.GetBoolProperty("PubliclyAccessible", true),
+			Tags:         r.GetTags(),
 		}
 
 		if clusterID := r.GetProperty("DBClusterIdentifier"); clusterID.IsString() {
@@ -16,6 +16,7 @@ func getClusters(ctx parser.FileContext) (clusters []redshift.Cluster) {
 				KMSKeyID: r.GetStringProperty("KmsKeyId"),
 			},
 			SubnetGroupName: r.GetStringProperty("ClusterSubnetGroupName", ""),
+			Tags:            r.GetTags(),
 		}
 
 		clusters = append(clusters, cluster)
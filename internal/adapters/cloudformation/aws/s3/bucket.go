@@ -30,7 +30,8 @@ func getBuckets(cfFile parser.FileContext) []s3.Bucket {
 				Metadata: r.Metadata(),
 				Enabled:  hasLogging(r),
 			},
-			ACL: convertAclValue(r.GetStringProperty("AccessControl", "private")),
+			ACL:  convertAclValue(r.GetStringProperty("AccessControl", "private")),
+			Tags: r.GetTags(),
 		}
 
 		buckets = append(buckets, s3b)
@@ -17,6 +17,7 @@ func getApis(cfFile parser.FileContext) (apis []sam.API) {
 			DomainConfiguration: getDomainConfiguration(r),
 			AccessLogging:       getAccessLogging(r),
 			RESTMethodSettings:  getRestMethodSettings(r),
+			Tags:                r.GetTags(),
 		}
 
 		apis = append(apis, api)
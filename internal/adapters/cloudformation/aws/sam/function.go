@@ -18,6 +18,7 @@ func getFunctions(cfFile parser.FileContext) (functions []sam.Function) {
 			Tracing:         r.GetStringProperty("Tracing", sam.TracingModePassThrough),
 			ManagedPolicies: nil,
 			Policies:        nil,
+			Tags:            r.GetTags(),
 		}
 
 		setFunctionPolicies(r, &function)
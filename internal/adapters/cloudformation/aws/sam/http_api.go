@@ -16,6 +16,7 @@ func getHttpApis(cfFile parser.FileContext) (apis []sam.HttpAPI) {
 			DomainConfiguration:  getDomainConfiguration(r),
 			AccessLogging:        getAccessLogging(r),
 			DefaultRouteSettings: getRouteSettings(r),
+			Tags:                 r.GetTags(),
 		}
 
 		apis = append(apis, api)
@@ -1,6 +1,7 @@
 package sam
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws/sfn"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/iam"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/sam"
@@ -22,6 +23,8 @@ func getStateMachines(cfFile parser.FileContext) (stateMachines []sam.StateMachi
 			ManagedPolicies: nil,
 			Policies:        nil,
 			Tracing:         getTracingConfiguration(r),
+			Definition:      sfn.GetDefinition(&cfFile, r),
+			Tags:            r.GetTags(),
 		}
 
 		if logging := r.GetProperty("Logging"); logging.IsNotNil() {
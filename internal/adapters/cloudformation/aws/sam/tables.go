@@ -14,6 +14,7 @@ func getSimpleTables(cfFile parser.FileContext) (tables []sam.SimpleTable) {
 			Metadata:         r.Metadata(),
 			TableName:        r.GetStringProperty("TableName"),
 			SSESpecification: getSSESpecification(r),
+			Tags:             r.GetTags(),
 		}
 
 		tables = append(tables, table)
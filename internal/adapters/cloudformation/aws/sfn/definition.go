@@ -0,0 +1,87 @@
+package sfn
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/sfn"
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/parser"
+)
+
+// GetDefinition parses the definition of a AWS::StepFunctions::StateMachine or AWS::Serverless::StateMachine
+// resource. The definition is read from the inline Definition, the DefinitionString, or for serverless state
+// machines, a local file referred to by DefinitionUri. DefinitionSubstitutions are applied before parsing. Definitions
+// which can't be read or parsed are unresolvable.
+func GetDefinition(ctx *parser.FileContext, r *parser.Resource) sfn.Definition {
+	var content []byte
+	var metadata types.Metadata
+	var line int
+	if prop := r.GetProperty("Definition"); prop.IsMap() {
+		content, metadata = prop.GetJsonBytes(), prop.Metadata()
+	} else if prop := r.GetProperty("DefinitionString"); prop.IsString() {
+		content, metadata = []byte(prop.AsString()), prop.Metadata()
+	} else if prop := r.GetProperty("DefinitionUri"); prop.IsString() {
+		var err error
+		if content, metadata, err = readDefinitionFile(r, prop.AsString()); err != nil {
+			ctx.Debug("Could not read the definition of state machine '%s' from '%s': %s", r.ID(), prop.AsString(), err)
+			return sfn.UnresolvableDefinition(prop.Metadata())
+		}
+		// the file holds nothing but the definition, so the states can be located within it
+		line = 1
+	} else if prop := r.GetProperty("DefinitionUri"); prop.IsNotNil() {
+		return sfn.UnresolvableDefinition(prop.Metadata())
+	} else {
+		return sfn.Definition{
+			Metadata: r.Metadata(),
+			Comment:  r.StringDefault(""),
+			StartAt:  r.StringDefault(""),
+		}
+	}
+
+	content = substitute(content, r.GetProperty("DefinitionSubstitutions"))
+
+	definition, err := sfn.ParseDefinitionAt(content, metadata, line)
+	if err != nil {
+		ctx.Debug("Could not parse the definition of state machine '%s': %s", r.ID(), err)
+		return sfn.UnresolvableDefinition(metadata)
+	}
+	return definition
+}
+
+// readDefinitionFile reads a definition file next to the template, such as statemachine/definition.asl.json
+func readDefinitionFile(r *parser.Resource, uri string) ([]byte, types.Metadata, error) {
+	if uri == "" || strings.Contains(uri, "://") || path.IsAbs(uri) {
+		return nil, types.Metadata{}, fmt.Errorf("not a local file")
+	}
+
+	target := r.Range().GetFS()
+	if target == nil {
+		return nil, types.Metadata{}, fmt.Errorf("the template has no filesystem")
+	}
+
+	filename := path.Join(path.Dir(r.Range().GetLocalFilename()), uri)
+	content, err := fs.ReadFile(target, filename)
+	if err != nil {
+		return nil, types.Metadata{}, err
+	}
+
+	rng := types.NewRange(filename, 1, strings.Count(string(content), "\n")+1, "", target)
+	return content, types.NewMetadata(rng, parser.NewCFReference(r.ID(), rng)), nil
+}
+
+// substitute replaces the ${name} placeholders of DefinitionSubstitutions which have string values
+func substitute(content []byte, substitutions *parser.Property) []byte {
+	if !substitutions.IsMap() {
+		return content
+	}
+	replaced := string(content)
+	for name, value := range substitutions.AsMap() {
+		if value.IsString() {
+			replaced = strings.ReplaceAll(replaced, "${"+name+"}", value.AsString())
+		}
+	}
+	return []byte(replaced)
+}
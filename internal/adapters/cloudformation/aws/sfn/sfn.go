@@ -0,0 +1,14 @@
+package sfn
+
+import (
+	"github.com/aquasecurity/defsec/pkg/providers/aws/sfn"
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/parser"
+)
+
+// Adapt ...
+func Adapt(cfFile parser.FileContext) (result sfn.SFN) {
+
+	result.StateMachines = getStateMachines(cfFile)
+	return result
+
+}
@@ -0,0 +1,94 @@
+package sfn
+
+import (
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/iam"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/sfn"
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/parser"
+	"github.com/liamg/iamgo"
+)
+
+func getStateMachines(ctx parser.FileContext) (stateMachines []sfn.StateMachine) {
+
+	stateMachineResources := ctx.GetResourcesByType("AWS::StepFunctions::StateMachine")
+
+	for _, r := range stateMachineResources {
+		stateMachine := sfn.StateMachine{
+			Metadata: r.Metadata(),
+			Name:     r.GetStringProperty("StateMachineName"),
+			Logging: sfn.Logging{
+				Metadata:             r.Metadata(),
+				Level:                types.StringDefault(sfn.LoggingLevelOff, r.Metadata()),
+				IncludeExecutionData: types.BoolDefault(false, r.Metadata()),
+			},
+			Tracing: sfn.Tracing{
+				Metadata: r.Metadata(),
+				Enabled:  r.GetBoolProperty("TracingConfiguration.Enabled"),
+			},
+			Policies:   getRolePolicies(ctx, r.GetProperty("RoleArn")),
+			Definition: GetDefinition(&ctx, r),
+			Tags:       r.GetTags(),
+		}
+
+		if logging := r.GetProperty("LoggingConfiguration"); logging.IsNotNil() {
+			stateMachine.Logging = sfn.Logging{
+				Metadata:             logging.Metadata(),
+				Level:                logging.GetStringProperty("Level", sfn.LoggingLevelOff),
+				IncludeExecutionData: logging.GetBoolProperty("IncludeExecutionData"),
+			}
+		}
+
+		stateMachines = append(stateMachines, stateMachine)
+	}
+
+	return stateMachines
+}
+
+// getRolePolicies returns the inline policies of the execution role, and the policies attached to it, when the role
+// is defined in the same template. References to a role resolve to its logical ID.
+func getRolePolicies(ctx parser.FileContext, roleArn *parser.Property) (policies []iam.Policy) {
+	if !roleArn.IsString() {
+		return nil
+	}
+	role := ctx.GetResourceByLogicalID(roleArn.AsString())
+	if role == nil || role.Type() != "AWS::IAM::Role" {
+		return nil
+	}
+
+	for _, policy := range role.GetProperty("Policies").AsList() {
+		if parsed, ok := parsePolicy(policy.GetProperty("PolicyDocument"), policy.GetStringProperty("PolicyName")); ok {
+			policies = append(policies, parsed)
+		}
+	}
+
+	for _, policyResource := range ctx.GetResourcesByType("AWS::IAM::Policy") {
+		for _, attached := range policyResource.GetProperty("Roles").AsList() {
+			if attached.IsString() && attached.AsString() == role.ID() {
+				if parsed, ok := parsePolicy(policyResource.GetProperty("PolicyDocument"), policyResource.GetStringProperty("PolicyName")); ok {
+					policies = append(policies, parsed)
+				}
+				break
+			}
+		}
+	}
+
+	return policies
+}
+
+func parsePolicy(document *parser.Property, name types.StringValue) (iam.Policy, bool) {
+	if document.IsNil() {
+		return iam.Policy{}, false
+	}
+	doc, err := iamgo.Parse(document.GetJsonBytes())
+	if err != nil {
+		return iam.Policy{}, false
+	}
+	return iam.Policy{
+		Metadata: document.Metadata(),
+		Name:     name,
+		Document: iam.Document{
+			Metadata: document.Metadata(),
+			Parsed:   *doc,
+		},
+	}, true
+}
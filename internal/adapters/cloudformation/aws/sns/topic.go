@@ -14,6 +14,7 @@ func getTopics(ctx parser.FileContext) (topics []sns.Topic) {
 				Metadata: r.Metadata(),
 				KMSKeyID: r.GetStringProperty("KmsMasterKeyId"),
 			},
+			Tags: r.GetTags(),
 		}
 
 		topics = append(topics, topic)
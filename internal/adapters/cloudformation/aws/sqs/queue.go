@@ -22,6 +22,7 @@ func getQueues(ctx parser.FileContext) (queues []sqs.Queue) {
 				KMSKeyID:          r.GetStringProperty("KmsMasterKeyId"),
 			},
 			Policies: []iam.Policy{},
+			Tags:     r.GetTags(),
 		}
 		if policy, err := getPolicy(r.ID(), ctx); err == nil {
 			queue.Policies = append(queue.Policies, *policy)
@@ -10,6 +10,7 @@ func getSecrets(ctx parser.FileContext) (secrets []ssm.Secret) {
 		secret := ssm.Secret{
 			Metadata: r.Metadata(),
 			KMSKeyID: r.GetStringProperty("KmsKeyId"),
+			Tags:     r.GetTags(),
 		}
 
 		secrets = append(secrets, secret)
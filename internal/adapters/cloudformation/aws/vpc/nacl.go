@@ -15,6 +15,7 @@ func getNetworkACLs(ctx parser.FileContext) (acls []vpc.NetworkACL) {
 		acl := vpc.NetworkACL{
 			Metadata: aclResource.Metadata(),
 			Rules:    getRules(aclResource.ID(), ctx),
+			Tags:     aclResource.GetTags(),
 		}
 		acls = append(acls, acl)
 	}
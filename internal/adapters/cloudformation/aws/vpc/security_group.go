@@ -13,6 +13,7 @@ func getSecurityGroups(ctx parser.FileContext) (groups []vpc.SecurityGroup) {
 			Description:  r.GetStringProperty("GroupDescription"),
 			IngressRules: getIngressRules(r),
 			EgressRules:  getEgressRules(r),
+			Tags:         r.GetTags(),
 		}
 
 		groups = append(groups, group)
@@ -23,6 +23,7 @@ func getWorkSpaces(ctx parser.FileContext) (workSpaces []workspaces.WorkSpace) {
 					Enabled:  r.GetBoolProperty("UserVolumeEncryptionEnabled"),
 				},
 			},
+			Tags: r.GetTags(),
 		}
 
 		workSpaces = append(workSpaces, workspace)
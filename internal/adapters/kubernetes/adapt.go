@@ -0,0 +1,39 @@
+package kubernetes
+
+import (
+	"io/fs"
+
+	"github.com/aquasecurity/defsec/pkg/providers/kubernetes"
+	"github.com/aquasecurity/defsec/pkg/scanners/kubernetes/parser"
+	"github.com/aquasecurity/defsec/pkg/state"
+)
+
+// Adapt adapts Kubernetes manifests to the same model as the kubernetes_* Terraform resources
+func Adapt(fsys fs.FS, manifests []*parser.Manifest) *state.State {
+	var k8s kubernetes.Kubernetes
+	for _, manifest := range manifests {
+		obj := newObject(fsys, manifest)
+		if obj == nil {
+			continue
+		}
+		switch kind := obj.kind; kind {
+		case "NetworkPolicy":
+			k8s.NetworkPolicies = append(k8s.NetworkPolicies, adaptNetworkPolicy(obj))
+		case "Pod", "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "ReplicationController", "Job", "CronJob":
+			k8s.Workloads = append(k8s.Workloads, adaptWorkload(obj, kind))
+		case "Service":
+			k8s.Services = append(k8s.Services, adaptService(obj))
+		case "Ingress":
+			k8s.Ingresses = append(k8s.Ingresses, adaptIngress(obj))
+		case "Role", "ClusterRole":
+			k8s.Roles = append(k8s.Roles, adaptRole(obj, kind))
+		case "RoleBinding", "ClusterRoleBinding":
+			k8s.RoleBindings = append(k8s.RoleBindings, adaptRoleBinding(obj, kind))
+		case "ServiceAccount":
+			k8s.ServiceAccounts = append(k8s.ServiceAccounts, adaptServiceAccount(obj))
+		}
+	}
+	return &state.State{
+		Kubernetes: k8s,
+	}
+}
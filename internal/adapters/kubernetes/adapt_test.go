@@ -0,0 +1,277 @@
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aquasecurity/defsec/pkg/scanners/kubernetes/parser"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AdaptWorkloads(t *testing.T) {
+
+	manifests, err := parser.New().ParseManifests(strings.NewReader(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  labels:
+    app: web
+spec:
+  replicas: 3
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      hostNetwork: true
+      securityContext:
+        runAsNonRoot: true
+      containers:
+      - name: nginx
+        image: nginx:1.23
+        ports:
+        - containerPort: 80
+        securityContext:
+          privileged: true
+          capabilities:
+            add: ["NET_ADMIN"]
+        resources:
+          limits:
+            cpu: 500m
+      volumes:
+      - name: host
+        hostPath:
+          path: /var/run
+---
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: cleanup
+  namespace: jobs
+spec:
+  schedule: "0 * * * *"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          serviceAccountName: cleaner
+          containers:
+          - name: cleanup
+            image: busybox
+`), "workloads.yaml")
+	require.NoError(t, err)
+
+	workloads := Adapt(nil, manifests).Kubernetes.Workloads
+	require.Len(t, workloads, 2)
+
+	deployment := workloads[0]
+	assert.Equal(t, "Deployment", deployment.Kind.Value())
+	assert.Equal(t, "web", deployment.Name.Value())
+	assert.Equal(t, "default", deployment.Namespace.Value())
+	assert.True(t, deployment.Namespace.GetMetadata().IsDefault())
+	assert.Equal(t, 3, deployment.Replicas.Value())
+	assert.Equal(t, map[string]string{"app": "web"}, deployment.Selector.Value())
+	assert.Equal(t, 1, deployment.Metadata.Range().GetStartLine())
+	assert.Equal(t, 35, deployment.Metadata.Range().GetEndLine())
+	assert.Equal(t, "workloads.yaml", deployment.Metadata.Range().GetFilename())
+
+	pod := deployment.Pod
+	assert.Equal(t, map[string]string{"app": "web"}, pod.Labels.Value())
+	assert.True(t, pod.HostNetwork.IsTrue())
+	assert.Equal(t, 17, pod.HostNetwork.GetMetadata().Range().GetStartLine())
+	assert.True(t, pod.AutomountServiceAccountToken.IsTrue())
+	assert.True(t, pod.SecurityContext.RunAsNonRoot.IsTrue())
+
+	require.Len(t, pod.Containers, 1)
+	container := pod.Containers[0]
+	assert.Equal(t, "nginx:1.23", container.Image.Value())
+	assert.True(t, container.SecurityContext.Privileged.IsTrue())
+	assert.Equal(t, 26, container.SecurityContext.Privileged.GetMetadata().Range().GetStartLine())
+	assert.True(t, container.SecurityContext.AllowPrivilegeEscalation.IsTrue())
+	assert.False(t, container.SecurityContext.ReadOnlyRootFilesystem.IsTrue())
+	require.Len(t, container.SecurityContext.Capabilities.Add, 1)
+	assert.Equal(t, "NET_ADMIN", container.SecurityContext.Capabilities.Add[0].Value())
+	assert.Equal(t, map[string]string{"cpu": "500m"}, container.Resources.Limits.Value())
+	require.Len(t, container.Ports, 1)
+	assert.Equal(t, 80, container.Ports[0].ContainerPort.Value())
+	assert.Equal(t, "TCP", container.Ports[0].Protocol.Value())
+
+	require.Len(t, pod.Volumes, 1)
+	assert.Equal(t, "hostPath", pod.Volumes[0].Type.Value())
+	assert.Equal(t, "/var/run", pod.Volumes[0].HostPath.Value())
+
+	cronJob := workloads[1]
+	assert.Equal(t, "CronJob", cronJob.Kind.Value())
+	assert.Equal(t, "jobs", cronJob.Namespace.Value())
+	assert.Equal(t, "cleaner", cronJob.Pod.ServiceAccountName.Value())
+	require.Len(t, cronJob.Pod.Containers, 1)
+	assert.Equal(t, "busybox", cronJob.Pod.Containers[0].Image.Value())
+	assert.Equal(t, 50, cronJob.Pod.Containers[0].Metadata.Range().GetStartLine())
+}
+
+func Test_AdaptServicesAndIngresses(t *testing.T) {
+
+	manifests, err := parser.New().ParseManifests(strings.NewReader(`{
+  "apiVersion": "v1",
+  "kind": "List",
+  "items": [
+    {
+      "apiVersion": "v1",
+      "kind": "Service",
+      "metadata": {"name": "web"},
+      "spec": {
+        "type": "LoadBalancer",
+        "selector": {"app": "web"},
+        "ports": [{"port": 443, "targetPort": "https"}]
+      }
+    },
+    {
+      "apiVersion": "networking.k8s.io/v1",
+      "kind": "Ingress",
+      "metadata": {"name": "web"},
+      "spec": {
+        "rules": [{
+          "host": "example.com",
+          "http": {"paths": [{"path": "/", "backend": {"service": {"name": "web", "port": {"number": 443}}}}]}
+        }]
+      }
+    }
+  ]
+}`), "services.json")
+	require.NoError(t, err)
+
+	k8s := Adapt(nil, manifests).Kubernetes
+
+	require.Len(t, k8s.Services, 1)
+	service := k8s.Services[0]
+	assert.Equal(t, "LoadBalancer", service.Type.Value())
+	assert.Equal(t, map[string]string{"app": "web"}, service.Selector.Value())
+	require.Len(t, service.Ports, 1)
+	assert.Equal(t, 443, service.Ports[0].Port.Value())
+	assert.Equal(t, "https", service.Ports[0].TargetPort.Value())
+	assert.Equal(t, 5, service.Metadata.Range().GetStartLine())
+	assert.Equal(t, 14, service.Metadata.Range().GetEndLine())
+
+	require.Len(t, k8s.Ingresses, 1)
+	ingress := k8s.Ingresses[0]
+	assert.Empty(t, ingress.TLS)
+	require.Len(t, ingress.Rules, 1)
+	assert.Equal(t, "example.com", ingress.Rules[0].Host.Value())
+	require.Len(t, ingress.Rules[0].Paths, 1)
+	assert.Equal(t, "web", ingress.Rules[0].Paths[0].ServiceName.Value())
+	assert.Equal(t, "443", ingress.Rules[0].Paths[0].ServicePort.Value())
+}
+
+func Test_AdaptRBAC(t *testing.T) {
+
+	manifests, err := parser.New().ParseManifests(strings.NewReader(`apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: reader
+rules:
+- apiGroups: [""]
+  resources: ["secrets"]
+  verbs: ["get", "list"]
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: read-secrets
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: reader
+subjects:
+- kind: ServiceAccount
+  name: default
+  namespace: kube-system
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: builder
+  namespace: ci
+automountServiceAccountToken: false
+`), "rbac.yaml")
+	require.NoError(t, err)
+
+	k8s := Adapt(nil, manifests).Kubernetes
+
+	require.Len(t, k8s.Roles, 1)
+	role := k8s.Roles[0]
+	assert.Equal(t, "ClusterRole", role.Kind.Value())
+	assert.Equal(t, "", role.Namespace.Value())
+	require.Len(t, role.Rules, 1)
+	assert.Equal(t, "secrets", role.Rules[0].Resources[0].Value())
+	assert.Len(t, role.Rules[0].Verbs, 2)
+	assert.Equal(t, 6, role.Rules[0].Metadata.Range().GetStartLine())
+
+	require.Len(t, k8s.RoleBindings, 1)
+	binding := k8s.RoleBindings[0]
+	assert.Equal(t, "ClusterRoleBinding", binding.Kind.Value())
+	assert.Equal(t, "reader", binding.RoleRef.Name.Value())
+	require.Len(t, binding.Subjects, 1)
+	assert.Equal(t, "ServiceAccount", binding.Subjects[0].Kind.Value())
+	assert.Equal(t, "kube-system", binding.Subjects[0].Namespace.Value())
+	assert.Equal(t, "ClusterRoleBinding/read-secrets", binding.Metadata.Reference().String())
+
+	require.Len(t, k8s.ServiceAccounts, 1)
+	assert.Equal(t, "ci", k8s.ServiceAccounts[0].Namespace.Value())
+	assert.False(t, k8s.ServiceAccounts[0].AutomountServiceAccountToken.IsTrue())
+}
+
+func Test_AdaptNetworkPolicies(t *testing.T) {
+
+	manifests, err := parser.New().ParseManifests(strings.NewReader(`apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: web
+spec:
+  podSelector:
+    matchLabels:
+      app: web
+  ingress:
+  - from:
+    - ipBlock:
+        cidr: 10.0.0.0/16
+    - podSelector:
+        matchLabels:
+          app: proxy
+    ports:
+    - protocol: TCP
+      port: 80
+  egress:
+  - to:
+    - ipBlock:
+        cidr: 0.0.0.0/0
+    ports:
+    - port: 443
+  - to:
+    - ipBlock:
+        cidr: 10.1.0.0/16
+`), "network.yaml")
+	require.NoError(t, err)
+
+	policies := Adapt(nil, manifests).Kubernetes.NetworkPolicies
+	require.Len(t, policies, 1)
+	policy := policies[0]
+
+	require.Len(t, policy.Spec.Ingress.SourceCIDRs, 1)
+	assert.Equal(t, "10.0.0.0/16", policy.Spec.Ingress.SourceCIDRs[0].Value())
+	assert.Equal(t, 12, policy.Spec.Ingress.SourceCIDRs[0].GetMetadata().Range().GetStartLine())
+	require.Len(t, policy.Spec.Ingress.Ports, 1)
+	assert.Equal(t, "80", policy.Spec.Ingress.Ports[0].Number.Value())
+	assert.Equal(t, "TCP", policy.Spec.Ingress.Ports[0].Protocol.Value())
+
+	require.Len(t, policy.Spec.Egress.DestinationCIDRs, 2)
+	assert.Equal(t, "0.0.0.0/0", policy.Spec.Egress.DestinationCIDRs[0].Value())
+	assert.Equal(t, "10.1.0.0/16", policy.Spec.Egress.DestinationCIDRs[1].Value())
+	require.Len(t, policy.Spec.Egress.Ports, 1)
+	assert.Equal(t, "443", policy.Spec.Egress.Ports[0].Number.Value())
+	assert.True(t, policy.Spec.Egress.Ports[0].Protocol.GetMetadata().IsDefault())
+}
@@ -0,0 +1,57 @@
+package kubernetes
+
+import (
+	"github.com/aquasecurity/defsec/pkg/providers/kubernetes"
+)
+
+// adaptNetworkPolicy flattens the egress and ingress rules of a NetworkPolicy, as the model of the
+// kubernetes_network_policy Terraform resource does
+func adaptNetworkPolicy(obj *object) kubernetes.NetworkPolicy {
+	spec := obj.root.get("spec")
+	egress, ingress := spec.get("egress"), spec.get("ingress")
+
+	policy := kubernetes.NetworkPolicy{
+		Metadata: obj.root.metadata,
+		Spec: kubernetes.Spec{
+			Metadata: spec.metadata,
+			Egress: kubernetes.Egress{
+				Metadata: egress.metadata,
+			},
+			Ingress: kubernetes.Ingress{
+				Metadata: ingress.metadata,
+			},
+		},
+	}
+
+	for _, rule := range egress.list() {
+		policy.Spec.Egress.Ports = append(policy.Spec.Egress.Ports, adaptPorts(rule.get("ports"))...)
+		for _, to := range rule.get("to").list() {
+			if cidr := to.get("ipBlock").get("cidr"); !cidr.isNil() {
+				policy.Spec.Egress.DestinationCIDRs = append(policy.Spec.Egress.DestinationCIDRs, cidr.stringValue(""))
+			}
+		}
+	}
+
+	for _, rule := range ingress.list() {
+		policy.Spec.Ingress.Ports = append(policy.Spec.Ingress.Ports, adaptPorts(rule.get("ports"))...)
+		for _, from := range rule.get("from").list() {
+			if cidr := from.get("ipBlock").get("cidr"); !cidr.isNil() {
+				policy.Spec.Ingress.SourceCIDRs = append(policy.Spec.Ingress.SourceCIDRs, cidr.stringValue(""))
+			}
+		}
+	}
+
+	return policy
+}
+
+func adaptPorts(ports value) []kubernetes.Port {
+	var adapted []kubernetes.Port
+	for _, port := range ports.list() {
+		adapted = append(adapted, kubernetes.Port{
+			Metadata: port.metadata,
+			Number:   port.get("port").stringValue(""),
+			Protocol: port.get("protocol").stringValue("TCP"),
+		})
+	}
+	return adapted
+}
@@ -0,0 +1,62 @@
+package kubernetes
+
+import (
+	"github.com/aquasecurity/defsec/pkg/providers/kubernetes"
+)
+
+func adaptRole(obj *object, kind string) kubernetes.Role {
+	name, namespace, _, _ := obj.objectMeta(kind == "Role")
+	role := kubernetes.Role{
+		Metadata:  obj.root.metadata,
+		Kind:      obj.root.get("kind").stringValue(""),
+		Name:      name,
+		Namespace: namespace,
+	}
+	for _, rule := range obj.root.get("rules").list() {
+		role.Rules = append(role.Rules, kubernetes.PolicyRule{
+			Metadata:      rule.metadata,
+			APIGroups:     rule.get("apiGroups").stringValues(),
+			Resources:     rule.get("resources").stringValues(),
+			ResourceNames: rule.get("resourceNames").stringValues(),
+			Verbs:         rule.get("verbs").stringValues(),
+		})
+	}
+	return role
+}
+
+func adaptRoleBinding(obj *object, kind string) kubernetes.RoleBinding {
+	name, namespace, _, _ := obj.objectMeta(kind == "RoleBinding")
+	roleRef := obj.root.get("roleRef")
+	binding := kubernetes.RoleBinding{
+		Metadata:  obj.root.metadata,
+		Kind:      obj.root.get("kind").stringValue(""),
+		Name:      name,
+		Namespace: namespace,
+		RoleRef: kubernetes.RoleRef{
+			Metadata: roleRef.metadata,
+			APIGroup: roleRef.get("apiGroup").stringValue(""),
+			Kind:     roleRef.get("kind").stringValue(""),
+			Name:     roleRef.get("name").stringValue(""),
+		},
+	}
+	for _, subject := range obj.root.get("subjects").list() {
+		binding.Subjects = append(binding.Subjects, kubernetes.Subject{
+			Metadata:  subject.metadata,
+			Kind:      subject.get("kind").stringValue(""),
+			Name:      subject.get("name").stringValue(""),
+			Namespace: subject.get("namespace").stringValue(""),
+			APIGroup:  subject.get("apiGroup").stringValue(""),
+		})
+	}
+	return binding
+}
+
+func adaptServiceAccount(obj *object) kubernetes.ServiceAccount {
+	name, namespace, _, _ := obj.objectMeta(true)
+	return kubernetes.ServiceAccount{
+		Metadata:                     obj.root.metadata,
+		Name:                         name,
+		Namespace:                    namespace,
+		AutomountServiceAccountToken: obj.root.get("automountServiceAccountToken").boolValue(true),
+	}
+}
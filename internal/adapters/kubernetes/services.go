@@ -0,0 +1,81 @@
+package kubernetes
+
+import (
+	"github.com/aquasecurity/defsec/pkg/providers/kubernetes"
+)
+
+func adaptService(obj *object) kubernetes.Service {
+	name, namespace, labels, _ := obj.objectMeta(true)
+	spec := obj.root.get("spec")
+
+	service := kubernetes.Service{
+		Metadata:    obj.root.metadata,
+		Name:        name,
+		Namespace:   namespace,
+		Labels:      labels,
+		Type:        spec.get("type").stringValue("ClusterIP"),
+		Selector:    spec.get("selector").mapValue(),
+		ExternalIPs: spec.get("externalIPs").stringValues(),
+	}
+	for _, port := range spec.get("ports").list() {
+		service.Ports = append(service.Ports, kubernetes.ServicePort{
+			Metadata:   port.metadata,
+			Port:       port.get("port").intValue(0),
+			NodePort:   port.get("nodePort").intValue(0),
+			TargetPort: port.get("targetPort").stringValue(""),
+			Protocol:   port.get("protocol").stringValue("TCP"),
+		})
+	}
+	return service
+}
+
+func adaptIngress(obj *object) kubernetes.IngressResource {
+	name, namespace, labels, _ := obj.objectMeta(true)
+	spec := obj.root.get("spec")
+
+	ingress := kubernetes.IngressResource{
+		Metadata:  obj.root.metadata,
+		Name:      name,
+		Namespace: namespace,
+		Labels:    labels,
+	}
+	for _, rule := range spec.get("rules").list() {
+		adapted := kubernetes.IngressRule{
+			Metadata: rule.metadata,
+			Host:     rule.get("host").stringValue(""),
+		}
+		for _, path := range rule.get("http").get("paths").list() {
+			adapted.Paths = append(adapted.Paths, adaptIngressPath(path))
+		}
+		ingress.Rules = append(ingress.Rules, adapted)
+	}
+	for _, tls := range spec.get("tls").list() {
+		ingress.TLS = append(ingress.TLS, kubernetes.IngressTLS{
+			Metadata:   tls.metadata,
+			Hosts:      tls.get("hosts").stringValues(),
+			SecretName: tls.get("secretName").stringValue(""),
+		})
+	}
+	return ingress
+}
+
+// adaptIngressPath reads the backend of a path, which is a service in networking.k8s.io/v1, and a serviceName and
+// servicePort in earlier versions
+func adaptIngressPath(path value) kubernetes.IngressPath {
+	backend := path.get("backend")
+	adapted := kubernetes.IngressPath{
+		Metadata:    path.metadata,
+		Path:        path.get("path").stringValue(""),
+		ServiceName: backend.get("serviceName").stringValue(""),
+		ServicePort: backend.get("servicePort").stringValue(""),
+	}
+	if service := backend.get("service"); !service.isNil() {
+		adapted.ServiceName = service.get("name").stringValue("")
+		if port := service.get("port"); !port.get("number").isNil() {
+			adapted.ServicePort = port.get("number").stringValue("")
+		} else {
+			adapted.ServicePort = port.get("name").stringValue("")
+		}
+	}
+	return adapted
+}
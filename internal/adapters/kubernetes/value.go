@@ -0,0 +1,174 @@
+package kubernetes
+
+import (
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/scanners/kubernetes/parser"
+)
+
+// object is a manifest being adapted, which provides the file and reference for the metadata of its values
+type object struct {
+	fsys fs.FS
+	kind string
+	ref  types.Reference
+	root value
+}
+
+func newObject(fsys fs.FS, manifest *parser.Manifest) *object {
+	if manifest.Content == nil || manifest.Content.Type != parser.TagMap {
+		return nil
+	}
+	content := manifest.Content.Value.(map[string]parser.ManifestNode)
+	kind, _ := content["kind"].Value.(string)
+	var name string
+	if meta := content["metadata"]; meta.Type == parser.TagMap {
+		name, _ = meta.Value.(map[string]parser.ManifestNode)["name"].Value.(string)
+	}
+
+	obj := &object{
+		fsys: fsys,
+		kind: kind,
+		ref:  types.NewNamedReference(fmt.Sprintf("%s/%s", kind, name)),
+	}
+	obj.root = value{node: manifest.Content, metadata: obj.metadataOf(manifest.Content), obj: obj}
+	return obj
+}
+
+func (o *object) metadataOf(node *parser.ManifestNode) types.Metadata {
+	return types.NewMetadata(types.NewRange(node.Path, node.StartLine, node.EndLine, "", o.fsys), o.ref)
+}
+
+// objectMeta reads the metadata of the object. The namespace of namespaced objects defaults to "default".
+func (o *object) objectMeta(namespaced bool) (name types.StringValue, namespace types.StringValue, labels types.MapValue, annotations types.MapValue) {
+	defaultNamespace := ""
+	if namespaced {
+		defaultNamespace = "default"
+	}
+	meta := o.root.get("metadata")
+	return meta.get("name").stringValue(""),
+		meta.get("namespace").stringValue(defaultNamespace),
+		meta.get("labels").mapValue(),
+		meta.get("annotations").mapValue()
+}
+
+// value is a node of a manifest, which may be missing, in which case its metadata is that of its parent so that
+// defaults can be attributed to it
+type value struct {
+	node     *parser.ManifestNode
+	metadata types.Metadata
+	obj      *object
+}
+
+func (v value) isNil() bool {
+	return v.node == nil || v.node.Type == parser.TagNull
+}
+
+func (v value) get(key string) value {
+	if v.node != nil && v.node.Type == parser.TagMap {
+		if child, ok := v.node.Value.(map[string]parser.ManifestNode)[key]; ok {
+			return value{node: &child, metadata: v.obj.metadataOf(&child), obj: v.obj}
+		}
+	}
+	return value{metadata: v.metadata, obj: v.obj}
+}
+
+// fields returns the keys of a map in order
+func (v value) fields() []string {
+	if v.node == nil || v.node.Type != parser.TagMap {
+		return nil
+	}
+	var keys []string
+	for key := range v.node.Value.(map[string]parser.ManifestNode) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (v value) list() []value {
+	if v.node == nil || v.node.Type != parser.TagSlice {
+		return nil
+	}
+	var values []value
+	for _, child := range v.node.Value.([]parser.ManifestNode) {
+		child := child
+		values = append(values, value{node: &child, metadata: v.obj.metadataOf(&child), obj: v.obj})
+	}
+	return values
+}
+
+func (v value) stringValue(defaultValue string) types.StringValue {
+	if v.isNil() {
+		return types.StringDefault(defaultValue, v.metadata)
+	}
+	switch raw := v.node.Value.(type) {
+	case string:
+		return types.StringExplicit(raw, v.metadata)
+	case int:
+		return types.StringExplicit(strconv.Itoa(raw), v.metadata)
+	case bool:
+		return types.StringExplicit(strconv.FormatBool(raw), v.metadata)
+	}
+	return types.StringUnresolvable(v.metadata)
+}
+
+func (v value) stringValues() []types.StringValue {
+	var values []types.StringValue
+	for _, item := range v.list() {
+		values = append(values, item.stringValue(""))
+	}
+	return values
+}
+
+func (v value) boolValue(defaultValue bool) types.BoolValue {
+	if v.isNil() {
+		return types.BoolDefault(defaultValue, v.metadata)
+	}
+	switch raw := v.node.Value.(type) {
+	case bool:
+		return types.BoolExplicit(raw, v.metadata)
+	case string:
+		if parsed, err := strconv.ParseBool(raw); err == nil {
+			return types.BoolExplicit(parsed, v.metadata)
+		}
+	}
+	return types.BoolUnresolvable(v.metadata)
+}
+
+func (v value) intValue(defaultValue int) types.IntValue {
+	if v.isNil() {
+		return types.IntDefault(defaultValue, v.metadata)
+	}
+	switch raw := v.node.Value.(type) {
+	case int:
+		return types.IntExplicit(raw, v.metadata)
+	case float64:
+		return types.IntExplicit(int(raw), v.metadata)
+	case string:
+		if parsed, err := strconv.Atoi(raw); err == nil {
+			return types.IntExplicit(parsed, v.metadata)
+		}
+	}
+	return types.IntUnresolvable(v.metadata)
+}
+
+func (v value) mapValue() types.MapValue {
+	values := make(map[string]string)
+	if v.isNil() || v.node.Type != parser.TagMap {
+		return types.MapDefault(values, v.metadata)
+	}
+	for key, child := range v.node.Value.(map[string]parser.ManifestNode) {
+		switch raw := child.Value.(type) {
+		case string:
+			values[key] = raw
+		case nil:
+		default:
+			values[key] = fmt.Sprint(raw)
+		}
+	}
+	return types.MapExplicit(values, v.metadata)
+}
@@ -0,0 +1,136 @@
+package kubernetes
+
+import (
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/providers/kubernetes"
+)
+
+func adaptWorkload(obj *object, kind string) kubernetes.Workload {
+	name, namespace, labels, annotations := obj.objectMeta(true)
+	spec := obj.root.get("spec")
+
+	workload := kubernetes.Workload{
+		Metadata:    obj.root.metadata,
+		Kind:        obj.root.get("kind").stringValue(""),
+		Name:        name,
+		Namespace:   namespace,
+		Labels:      labels,
+		Annotations: annotations,
+		Replicas:    types.IntDefault(0, obj.root.metadata),
+		Selector:    types.MapDefault(make(map[string]string), obj.root.metadata),
+	}
+
+	switch kind {
+	case "Pod":
+		workload.Pod = adaptPodSpec(spec, labels)
+		return workload
+	case "Deployment", "StatefulSet", "ReplicaSet", "ReplicationController":
+		workload.Replicas = spec.get("replicas").intValue(1)
+	}
+
+	if kind == "ReplicationController" {
+		workload.Selector = spec.get("selector").mapValue()
+	} else {
+		workload.Selector = spec.get("selector").get("matchLabels").mapValue()
+	}
+
+	if kind == "CronJob" {
+		spec = spec.get("jobTemplate").get("spec")
+	}
+	template := spec.get("template")
+	workload.Pod = adaptPodSpec(template.get("spec"), template.get("metadata").get("labels").mapValue())
+	return workload
+}
+
+func adaptPodSpec(spec value, labels types.MapValue) kubernetes.PodSpec {
+	podSpec := kubernetes.PodSpec{
+		Metadata:                     spec.metadata,
+		Labels:                       labels,
+		ServiceAccountName:           spec.get("serviceAccountName").stringValue(""),
+		AutomountServiceAccountToken: spec.get("automountServiceAccountToken").boolValue(true),
+		HostNetwork:                  spec.get("hostNetwork").boolValue(false),
+		HostPID:                      spec.get("hostPID").boolValue(false),
+		HostIPC:                      spec.get("hostIPC").boolValue(false),
+		SecurityContext:              adaptPodSecurityContext(spec.get("securityContext")),
+	}
+	for _, container := range spec.get("containers").list() {
+		podSpec.Containers = append(podSpec.Containers, adaptContainer(container))
+	}
+	for _, container := range spec.get("initContainers").list() {
+		podSpec.InitContainers = append(podSpec.InitContainers, adaptContainer(container))
+	}
+	for _, volume := range spec.get("volumes").list() {
+		podSpec.Volumes = append(podSpec.Volumes, adaptVolume(volume))
+	}
+	return podSpec
+}
+
+func adaptPodSecurityContext(securityContext value) kubernetes.PodSecurityContext {
+	return kubernetes.PodSecurityContext{
+		Metadata:           securityContext.metadata,
+		RunAsNonRoot:       securityContext.get("runAsNonRoot").boolValue(false),
+		RunAsUser:          securityContext.get("runAsUser").intValue(0),
+		RunAsGroup:         securityContext.get("runAsGroup").intValue(0),
+		FSGroup:            securityContext.get("fsGroup").intValue(0),
+		SeccompProfileType: securityContext.get("seccompProfile").get("type").stringValue(""),
+	}
+}
+
+func adaptContainer(container value) kubernetes.Container {
+	securityContext := container.get("securityContext")
+	capabilities := securityContext.get("capabilities")
+	resources := container.get("resources")
+
+	adapted := kubernetes.Container{
+		Metadata:        container.metadata,
+		Name:            container.get("name").stringValue(""),
+		Image:           container.get("image").stringValue(""),
+		ImagePullPolicy: container.get("imagePullPolicy").stringValue(""),
+		SecurityContext: kubernetes.SecurityContext{
+			Metadata:                 securityContext.metadata,
+			Privileged:               securityContext.get("privileged").boolValue(false),
+			AllowPrivilegeEscalation: securityContext.get("allowPrivilegeEscalation").boolValue(true),
+			ReadOnlyRootFilesystem:   securityContext.get("readOnlyRootFilesystem").boolValue(false),
+			RunAsNonRoot:             securityContext.get("runAsNonRoot").boolValue(false),
+			RunAsUser:                securityContext.get("runAsUser").intValue(0),
+			RunAsGroup:               securityContext.get("runAsGroup").intValue(0),
+			SeccompProfileType:       securityContext.get("seccompProfile").get("type").stringValue(""),
+			Capabilities: kubernetes.Capabilities{
+				Metadata: capabilities.metadata,
+				Add:      capabilities.get("add").stringValues(),
+				Drop:     capabilities.get("drop").stringValues(),
+			},
+		},
+		Resources: kubernetes.Resources{
+			Metadata: resources.metadata,
+			Limits:   resources.get("limits").mapValue(),
+			Requests: resources.get("requests").mapValue(),
+		},
+	}
+	for _, port := range container.get("ports").list() {
+		adapted.Ports = append(adapted.Ports, kubernetes.ContainerPort{
+			Metadata:      port.metadata,
+			ContainerPort: port.get("containerPort").intValue(0),
+			HostPort:      port.get("hostPort").intValue(0),
+			Protocol:      port.get("protocol").stringValue("TCP"),
+		})
+	}
+	return adapted
+}
+
+func adaptVolume(volume value) kubernetes.Volume {
+	adapted := kubernetes.Volume{
+		Metadata: volume.metadata,
+		Name:     volume.get("name").stringValue(""),
+		Type:     types.StringDefault("", volume.metadata),
+		HostPath: volume.get("hostPath").get("path").stringValue(""),
+	}
+	// the volume source is the only field of the volume other than its name
+	for _, field := range volume.fields() {
+		if field != "name" {
+			adapted.Type = types.String(field, volume.get(field).metadata)
+			break
+		}
+	}
+	return adapted
+}
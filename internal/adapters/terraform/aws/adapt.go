@@ -31,6 +31,7 @@ import (
 	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/rds"
 	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/redshift"
 	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/s3"
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/sfn"
 	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/sns"
 	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/sqs"
 	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/ssm"
@@ -72,6 +73,7 @@ func Adapt(modules terraform.Modules) aws.AWS {
 		RDS:           rds.Adapt(modules),
 		Redshift:      redshift.Adapt(modules),
 		S3:            s3.Adapt(modules),
+		SFN:           sfn.Adapt(modules),
 		SNS:           sns.Adapt(modules),
 		SQS:           sqs.Adapt(modules),
 		SSM:           ssm.Adapt(modules),
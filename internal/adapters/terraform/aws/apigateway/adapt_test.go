@@ -69,6 +69,7 @@ resource "aws_apigatewayv2_domain_name" "example" {
 								APIKeyRequired:    Bool(false),
 							},
 						},
+						Tags: Map(map[string]string{}),
 					},
 					{
 						Name:         String("tfsec"),
@@ -85,8 +86,10 @@ resource "aws_apigatewayv2_domain_name" "example" {
 									CacheDataEncrypted: Bool(true),
 									CacheEnabled:       Bool(false),
 								},
+								Tags: Map(map[string]string{}),
 							},
 						},
+						Tags: Map(map[string]string{}),
 					},
 				},
 				DomainNames: []apigateway.DomainName{
@@ -94,11 +97,13 @@ resource "aws_apigatewayv2_domain_name" "example" {
 						Name:           String("v1.com"),
 						Version:        Int(1),
 						SecurityPolicy: String("TLS_1_0"),
+						Tags:           Map(map[string]string{}),
 					},
 					{
 						Name:           String("v2.com"),
 						Version:        Int(2),
 						SecurityPolicy: String("TLS_1_2"),
+						Tags:           Map(map[string]string{}),
 					},
 				},
 			},
@@ -125,3 +130,7 @@ func Bool(b bool) types.BoolValue {
 func String(s string) types.StringValue {
 	return types.String(s, types.NewTestMetadata())
 }
+
+func Map(m map[string]string) types.MapValue {
+	return types.Map(m, types.NewTestMetadata())
+}
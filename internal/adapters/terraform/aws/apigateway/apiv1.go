@@ -1,6 +1,7 @@
 package apigateway
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/apigateway"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -33,6 +34,7 @@ func adaptAPIsV1(modules terraform.Modules) []apigateway.API {
 			ProtocolType: types.StringDefault(apigateway.ProtocolTypeREST, apiBlock.GetMetadata()),
 			Stages:       nil,
 			RESTMethods:  adaptAPIMethodsV1(modules, apiBlock),
+			Tags:         tags.Resolve(modules, apiBlock),
 		}
 
 		var defaultCacheEncryption = types.BoolDefault(false, api.Metadata)
@@ -60,6 +62,7 @@ func adaptAPIsV1(modules terraform.Modules) []apigateway.API {
 			Name:         types.StringDefault("", types.NewUnmanagedMetadata()),
 			Version:      types.IntDefault(0, types.NewUnmanagedMetadata()),
 			ProtocolType: types.StringDefault(apigateway.ProtocolTypeREST, types.NewUnmanagedMetadata()),
+			Tags:         types.MapDefault(make(map[string]string), types.NewUnmanagedMetadata()),
 		}
 		for _, stage := range orphanResources {
 			orphanage.Stages = append(orphanage.Stages, adaptStageV1(stage, types.BoolDefault(false, stage.GetMetadata()), modules))
@@ -85,6 +88,7 @@ func adaptStageV1(stageBlock *terraform.Block, defaultCacheEncryption types.Bool
 			CacheEnabled:       types.BoolDefault(false, stageBlock.GetMetadata()),
 		},
 		XRayTracingEnabled: stageBlock.GetAttribute("xray_tracing_enabled").AsBoolValueOrDefault(false, stageBlock),
+		Tags:               tags.Resolve(modules, stageBlock),
 	}
 	for _, methodSettings := range modules.GetReferencingResources(stageBlock, "aws_api_gateway_method_settings", "stage_name") {
 		stage.RESTMethodSettings.Metadata = methodSettings.GetMetadata()
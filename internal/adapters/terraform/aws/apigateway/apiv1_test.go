@@ -91,6 +91,7 @@ resource "aws_api_gateway_rest_api" "example" {
 					Name:         String(""),
 					Version:      Int(1),
 					ProtocolType: String("REST"),
+					Tags:         Map(map[string]string{}),
 				},
 			},
 		},
@@ -106,6 +107,7 @@ resource "aws_api_gateway_rest_api" "example" {
 					Name:         String("tfsec"),
 					Version:      Int(1),
 					ProtocolType: String("REST"),
+					Tags:         Map(map[string]string{}),
 				},
 			},
 		},
@@ -1,6 +1,7 @@
 package apigateway
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/apigateway"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -20,12 +21,13 @@ func adaptAPIsV2(modules terraform.Modules) []apigateway.API {
 				ProtocolType: apiBlock.GetAttribute("protocol_type").AsStringValueOrDefault("", apiBlock),
 				Stages:       nil,
 				RESTMethods:  nil,
+				Tags:         tags.Resolve(modules, apiBlock),
 			}
 
 			for _, stageBlock := range module.GetReferencingResources(apiBlock, "aws_apigatewayv2_stage", "api_id") {
 				apiStageIDs.Resolve(stageBlock.ID())
 
-				stage := adaptStageV2(stageBlock)
+				stage := adaptStageV2(stageBlock, modules)
 
 				api.Stages = append(api.Stages, stage)
 			}
@@ -43,9 +45,10 @@ func adaptAPIsV2(modules terraform.Modules) []apigateway.API {
 			ProtocolType: types.StringUnresolvable(types.NewUnmanagedMetadata()),
 			Stages:       nil,
 			RESTMethods:  nil,
+			Tags:         types.MapDefault(make(map[string]string), types.NewUnmanagedMetadata()),
 		}
 		for _, stage := range orphanResources {
-			orphanage.Stages = append(orphanage.Stages, adaptStageV2(stage))
+			orphanage.Stages = append(orphanage.Stages, adaptStageV2(stage, modules))
 		}
 		apis = append(apis, orphanage)
 	}
@@ -53,7 +56,7 @@ func adaptAPIsV2(modules terraform.Modules) []apigateway.API {
 	return apis
 }
 
-func adaptStageV2(stageBlock *terraform.Block) apigateway.Stage {
+func adaptStageV2(stageBlock *terraform.Block, modules terraform.Modules) apigateway.Stage {
 	stage := apigateway.Stage{
 		Metadata: stageBlock.GetMetadata(),
 		Version:  types.Int(2, stageBlock.GetMetadata()),
@@ -66,6 +69,7 @@ func adaptStageV2(stageBlock *terraform.Block) apigateway.Stage {
 			Metadata:              stageBlock.GetMetadata(),
 			CloudwatchLogGroupARN: types.StringDefault("", stageBlock.GetMetadata()),
 		},
+		Tags: tags.Resolve(modules, stageBlock),
 	}
 	stage.Name = stageBlock.GetAttribute("name").AsStringValueOrDefault("", stageBlock)
 	if accessLogging := stageBlock.GetBlock("access_log_settings"); accessLogging.IsNotNil() {
@@ -28,6 +28,7 @@ resource "aws_apigatewayv2_api" "example" {
 					Name:         String(""),
 					Version:      Int(2),
 					ProtocolType: String("HTTP"),
+					Tags:         Map(map[string]string{}),
 				},
 			},
 		},
@@ -44,6 +45,7 @@ resource "aws_apigatewayv2_api" "example" {
 					Name:         String("tfsec"),
 					Version:      Int(2),
 					ProtocolType: String("HTTP"),
+					Tags:         Map(map[string]string{}),
 				},
 			},
 		},
@@ -81,6 +83,7 @@ resource "aws_apigatewayv2_stage" "example" {
 					CacheDataEncrypted: Bool(true),
 					CacheEnabled:       Bool(false),
 				},
+				Tags: Map(map[string]string{}),
 			},
 		},
 		{
@@ -103,6 +106,7 @@ resource "aws_apigatewayv2_stage" "example" {
 					CacheDataEncrypted: Bool(true),
 					CacheEnabled:       Bool(false),
 				},
+				Tags: Map(map[string]string{}),
 			},
 		},
 	}
@@ -110,7 +114,7 @@ resource "aws_apigatewayv2_stage" "example" {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptStageV2(modules.GetBlocks()[0])
+			adapted := adaptStageV2(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
@@ -1,6 +1,7 @@
 package apigateway
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/apigateway"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -17,6 +18,7 @@ func adaptDomainNamesV1(modules terraform.Modules) []apigateway.DomainName {
 				Name:           nameBlock.GetAttribute("domain_name").AsStringValueOrDefault("", nameBlock),
 				Version:        types.Int(1, nameBlock.GetMetadata()),
 				SecurityPolicy: nameBlock.GetAttribute("security_policy").AsStringValueOrDefault("TLS_1_0", nameBlock),
+				Tags:           tags.Resolve(modules, nameBlock),
 			}
 			domainNames = append(domainNames, domainName)
 		}
@@ -27,6 +27,7 @@ resource "aws_api_gateway_domain_name" "example" {
 					Name:           String(""),
 					Version:        Int(1),
 					SecurityPolicy: String("TLS_1_0"),
+					Tags:           Map(map[string]string{}),
 				},
 			},
 		},
@@ -43,6 +44,7 @@ resource "aws_api_gateway_domain_name" "example" {
 					Name:           String("testing.com"),
 					Version:        Int(1),
 					SecurityPolicy: String("TLS_1_2"),
+					Tags:           Map(map[string]string{}),
 				},
 			},
 		},
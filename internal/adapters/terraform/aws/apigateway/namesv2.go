@@ -1,6 +1,7 @@
 package apigateway
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/apigateway"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -17,6 +18,7 @@ func adaptDomainNamesV2(modules terraform.Modules) []apigateway.DomainName {
 				Name:           nameBlock.GetAttribute("domain_name").AsStringValueOrDefault("", nameBlock),
 				Version:        types.Int(2, nameBlock.GetMetadata()),
 				SecurityPolicy: types.StringDefault("TLS_1_0", nameBlock.GetMetadata()),
+				Tags:           tags.Resolve(modules, nameBlock),
 			}
 			if config := nameBlock.GetBlock("domain_name_configuration"); config.IsNotNil() {
 				domainName.SecurityPolicy = config.GetAttribute("security_policy").AsStringValueOrDefault("TLS_1_0", config)
@@ -27,6 +27,7 @@ resource "aws_apigatewayv2_domain_name" "example" {
 					Name:           String(""),
 					Version:        Int(2),
 					SecurityPolicy: String("TLS_1_0"),
+					Tags:           Map(map[string]string{}),
 				},
 			},
 		},
@@ -45,6 +46,7 @@ resource "aws_apigatewayv2_domain_name" "example" {
 					Name:           String("testing.com"),
 					Version:        Int(2),
 					SecurityPolicy: String("TLS_1_2"),
+					Tags:           Map(map[string]string{}),
 				},
 			},
 		},
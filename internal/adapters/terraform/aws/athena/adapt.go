@@ -1,6 +1,7 @@
 package athena
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/athena"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -27,7 +28,7 @@ func adaptWorkgroups(modules terraform.Modules) []athena.Workgroup {
 	var workgroups []athena.Workgroup
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_athena_workgroup") {
-			workgroups = append(workgroups, adaptWorkgroup(resource))
+			workgroups = append(workgroups, adaptWorkgroup(resource, modules))
 		}
 	}
 	return workgroups
@@ -51,7 +52,7 @@ func adaptDatabase(resource *terraform.Block) athena.Database {
 	return database
 }
 
-func adaptWorkgroup(resource *terraform.Block) athena.Workgroup {
+func adaptWorkgroup(resource *terraform.Block, modules terraform.Modules) athena.Workgroup {
 	workgroup := athena.Workgroup{
 		Metadata: resource.GetMetadata(),
 		Name:     resource.GetAttribute("name").AsStringValueOrDefault("", resource),
@@ -60,6 +61,7 @@ func adaptWorkgroup(resource *terraform.Block) athena.Workgroup {
 			Type:     types.StringDefault("", resource.GetMetadata()),
 		},
 		EnforceConfiguration: types.BoolDefault(false, resource.GetMetadata()),
+		Tags:                 tags.Resolve(modules, resource),
 	}
 
 	if configBlock := resource.GetBlock("configuration"); configBlock.IsNotNil() {
@@ -82,6 +82,7 @@ func Test_adaptWorkgroup(t *testing.T) {
 					Type:     types.String(athena.EncryptionTypeSSEKMS, types.NewTestMetadata()),
 				},
 				EnforceConfiguration: types.Bool(true, types.NewTestMetadata()),
+				Tags:                 types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -109,6 +110,7 @@ func Test_adaptWorkgroup(t *testing.T) {
 					Type:     types.String(athena.EncryptionTypeSSEKMS, types.NewTestMetadata()),
 				},
 				EnforceConfiguration: types.Bool(false, types.NewTestMetadata()),
+				Tags:                 types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -134,6 +136,7 @@ func Test_adaptWorkgroup(t *testing.T) {
 					Type:     types.String(athena.EncryptionTypeNone, types.NewTestMetadata()),
 				},
 				EnforceConfiguration: types.Bool(true, types.NewTestMetadata()),
+				Tags:                 types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -151,6 +154,7 @@ func Test_adaptWorkgroup(t *testing.T) {
 					Type:     types.String(athena.EncryptionTypeNone, types.NewTestMetadata()),
 				},
 				EnforceConfiguration: types.Bool(false, types.NewTestMetadata()),
+				Tags:                 types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -158,7 +162,7 @@ func Test_adaptWorkgroup(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptWorkgroup(modules.GetBlocks()[0])
+			adapted := adaptWorkgroup(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
@@ -3,6 +3,7 @@ package autoscaling
 import (
 	"encoding/base64"
 
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -36,6 +37,7 @@ func adaptLaunchTemplates(modules terraform.Modules) (templates []autoscaling.La
 				SecurityGroups:  nil,
 				RootBlockDevice: nil,
 				EBSBlockDevices: nil,
+				Tags:            tags.Resolve(modules, b),
 			},
 		})
 	}
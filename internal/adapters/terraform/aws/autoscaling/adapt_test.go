@@ -135,6 +135,7 @@ export AWS_DEFAULT_REGION=us-west-2
 								HttpTokens:   types.String("required", types.NewTestMetadata()),
 								HttpEndpoint: types.String("", types.NewTestMetadata()),
 							},
+							Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 						},
 					},
 				},
@@ -1,6 +1,7 @@
 package cloudfront
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/cloudfront"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -16,13 +17,13 @@ func adaptDistributions(modules terraform.Modules) []cloudfront.Distribution {
 	var distributions []cloudfront.Distribution
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_cloudfront_distribution") {
-			distributions = append(distributions, adaptDistribution(resource))
+			distributions = append(distributions, adaptDistribution(resource, modules))
 		}
 	}
 	return distributions
 }
 
-func adaptDistribution(resource *terraform.Block) cloudfront.Distribution {
+func adaptDistribution(resource *terraform.Block, modules terraform.Modules) cloudfront.Distribution {
 
 	distribution := cloudfront.Distribution{
 		Metadata: resource.GetMetadata(),
@@ -40,6 +41,7 @@ func adaptDistribution(resource *terraform.Block) cloudfront.Distribution {
 			Metadata:               resource.GetMetadata(),
 			MinimumProtocolVersion: types.StringDefault("TLSv1", resource.GetMetadata()),
 		},
+		Tags: tags.Resolve(modules, resource),
 	}
 
 	distribution.WAFID = resource.GetAttribute("web_acl_id").AsStringValueOrDefault("", resource)
@@ -65,6 +65,7 @@ func Test_adaptDistribution(t *testing.T) {
 					Metadata:               types.NewTestMetadata(),
 					MinimumProtocolVersion: types.String("TLSv1.2_2021", types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -89,6 +90,7 @@ func Test_adaptDistribution(t *testing.T) {
 					Metadata:               types.NewTestMetadata(),
 					MinimumProtocolVersion: types.String("TLSv1", types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -96,7 +98,7 @@ func Test_adaptDistribution(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptDistribution(modules.GetBlocks()[0])
+			adapted := adaptDistribution(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
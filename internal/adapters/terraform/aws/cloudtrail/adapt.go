@@ -1,6 +1,7 @@
 package cloudtrail
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/cloudtrail"
 	"github.com/aquasecurity/defsec/pkg/terraform"
 )
@@ -16,13 +17,13 @@ func adaptTrails(modules terraform.Modules) []cloudtrail.Trail {
 
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_cloudtrail") {
-			trails = append(trails, adaptTrail(resource))
+			trails = append(trails, adaptTrail(resource, modules))
 		}
 	}
 	return trails
 }
 
-func adaptTrail(resource *terraform.Block) cloudtrail.Trail {
+func adaptTrail(resource *terraform.Block, modules terraform.Modules) cloudtrail.Trail {
 	nameAttr := resource.GetAttribute("name")
 	nameVal := nameAttr.AsStringValueOrDefault("", resource)
 
@@ -41,5 +42,6 @@ func adaptTrail(resource *terraform.Block) cloudtrail.Trail {
 		EnableLogFileValidation: enableLogFileValidationVal,
 		IsMultiRegion:           isMultiRegionVal,
 		KMSKeyID:                KMSKeyIDVal,
+		Tags:                    tags.Resolve(modules, resource),
 	}
 }
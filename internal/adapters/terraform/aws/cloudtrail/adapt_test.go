@@ -37,6 +37,7 @@ func Test_adaptTrail(t *testing.T) {
 				EnableLogFileValidation: types.Bool(true, types.NewTestMetadata()),
 				IsMultiRegion:           types.Bool(true, types.NewTestMetadata()),
 				KMSKeyID:                types.String("kms-key", types.NewTestMetadata()),
+				Tags:                    types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -51,6 +52,7 @@ func Test_adaptTrail(t *testing.T) {
 				EnableLogFileValidation: types.Bool(false, types.NewTestMetadata()),
 				IsMultiRegion:           types.Bool(false, types.NewTestMetadata()),
 				KMSKeyID:                types.String("", types.NewTestMetadata()),
+				Tags:                    types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -58,7 +60,7 @@ func Test_adaptTrail(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptTrail(modules.GetBlocks()[0])
+			adapted := adaptTrail(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
@@ -1,6 +1,7 @@
 package cloudwatch
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/cloudwatch"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -16,13 +17,13 @@ func adaptLogGroups(modules terraform.Modules) []cloudwatch.LogGroup {
 	var logGroups []cloudwatch.LogGroup
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_cloudwatch_log_group") {
-			logGroups = append(logGroups, adaptLogGroup(resource, module))
+			logGroups = append(logGroups, adaptLogGroup(resource, module, modules))
 		}
 	}
 	return logGroups
 }
 
-func adaptLogGroup(resource *terraform.Block, module *terraform.Module) cloudwatch.LogGroup {
+func adaptLogGroup(resource *terraform.Block, module *terraform.Module, modules terraform.Modules) cloudwatch.LogGroup {
 	nameAttr := resource.GetAttribute("name")
 	nameVal := nameAttr.AsStringValueOrDefault("", resource)
 
@@ -41,5 +42,6 @@ func adaptLogGroup(resource *terraform.Block, module *terraform.Module) cloudwat
 		Name:            nameVal,
 		KMSKeyID:        KMSKeyIDVal,
 		RetentionInDays: retentionInDaysVal,
+		Tags:            tags.Resolve(modules, resource),
 	}
 }
@@ -37,6 +37,7 @@ func Test_adaptLogGroups(t *testing.T) {
 					Name:            types.String("my-group", types.NewTestMetadata()),
 					KMSKeyID:        types.String("aws_kms_key.log_key", types.NewTestMetadata()),
 					RetentionInDays: types.Int(0, types.NewTestMetadata()),
+					Tags:            types.Map(map[string]string{}, types.NewTestMetadata()),
 				},
 			},
 		},
@@ -54,6 +55,7 @@ func Test_adaptLogGroups(t *testing.T) {
 					Name:            types.String("my-group", types.NewTestMetadata()),
 					KMSKeyID:        types.String("key-as-string", types.NewTestMetadata()),
 					RetentionInDays: types.Int(0, types.NewTestMetadata()),
+					Tags:            types.Map(map[string]string{}, types.NewTestMetadata()),
 				},
 			},
 		},
@@ -71,6 +73,39 @@ func Test_adaptLogGroups(t *testing.T) {
 					Name:            types.String("my-group", types.NewTestMetadata()),
 					KMSKeyID:        types.String("", types.NewTestMetadata()),
 					RetentionInDays: types.Int(3, types.NewTestMetadata()),
+					Tags:            types.Map(map[string]string{}, types.NewTestMetadata()),
+				},
+			},
+		},
+		{
+			name: "tags merged with provider default_tags",
+			terraform: `
+			provider "aws" {
+				default_tags {
+					tags = {
+						owner = "platform"
+						env   = "dev"
+					}
+				}
+			}
+
+			resource "aws_cloudwatch_log_group" "my-group" {
+				name = "my-group"
+				tags = {
+					env = "prod"
+				}
+			}
+`,
+			expected: []cloudwatch.LogGroup{
+				{
+					Metadata:        types.NewTestMetadata(),
+					Name:            types.String("my-group", types.NewTestMetadata()),
+					KMSKeyID:        types.String("", types.NewTestMetadata()),
+					RetentionInDays: types.Int(0, types.NewTestMetadata()),
+					Tags: types.Map(map[string]string{
+						"owner": "platform",
+						"env":   "prod",
+					}, types.NewTestMetadata()),
 				},
 			},
 		},
@@ -1,6 +1,7 @@
 package codebuild
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/codebuild"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -16,13 +17,13 @@ func adaptProjects(modules terraform.Modules) []codebuild.Project {
 	var projects []codebuild.Project
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_codebuild_project") {
-			projects = append(projects, adaptProject(resource))
+			projects = append(projects, adaptProject(resource, modules))
 		}
 	}
 	return projects
 }
 
-func adaptProject(resource *terraform.Block) codebuild.Project {
+func adaptProject(resource *terraform.Block, modules terraform.Modules) codebuild.Project {
 
 	project := codebuild.Project{
 		Metadata: resource.GetMetadata(),
@@ -31,6 +32,7 @@ func adaptProject(resource *terraform.Block) codebuild.Project {
 			EncryptionEnabled: types.BoolDefault(true, resource.GetMetadata()),
 		},
 		SecondaryArtifactSettings: nil,
+		Tags:                      tags.Resolve(modules, resource),
 	}
 
 	var hasArtifacts bool
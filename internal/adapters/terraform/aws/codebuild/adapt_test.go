@@ -53,6 +53,7 @@ func Test_adaptProject(t *testing.T) {
 						EncryptionEnabled: types.Bool(false, types.NewTestMetadata()),
 					},
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -67,6 +68,7 @@ func Test_adaptProject(t *testing.T) {
 					Metadata:          types.NewTestMetadata(),
 					EncryptionEnabled: types.Bool(true, types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -74,7 +76,7 @@ func Test_adaptProject(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptProject(modules.GetBlocks()[0])
+			adapted := adaptProject(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/config"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -17,11 +18,13 @@ func adaptConfigurationAggregrator(modules terraform.Modules) config.Configurati
 		Metadata:         types.NewUnmanagedMetadata(),
 		SourceAllRegions: types.BoolDefault(false, types.NewUnmanagedMetadata()),
 		IsDefined:        false,
+		Tags:             types.MapDefault(make(map[string]string), types.NewUnmanagedMetadata()),
 	}
 
 	for _, resource := range modules.GetResourcesByType("aws_config_configuration_aggregator") {
 		configurationAggregrator.Metadata = resource.GetMetadata()
 		configurationAggregrator.IsDefined = true
+		configurationAggregrator.Tags = tags.Resolve(modules, resource)
 
 		aggregationBlock := resource.GetFirstMatchingBlock("account_aggregation_source", "organization_aggregation_source")
 		if aggregationBlock.IsNil() {
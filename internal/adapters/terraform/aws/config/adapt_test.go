@@ -35,6 +35,7 @@ func Test_adaptConfigurationAggregrator(t *testing.T) {
 				Metadata:         types.NewTestMetadata(),
 				SourceAllRegions: types.Bool(true, types.NewTestMetadata()),
 				IsDefined:        true,
+				Tags:             types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -47,6 +48,7 @@ func Test_adaptConfigurationAggregrator(t *testing.T) {
 				Metadata:         types.NewTestMetadata(),
 				SourceAllRegions: types.Bool(false, types.NewTestMetadata()),
 				IsDefined:        true,
+				Tags:             types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
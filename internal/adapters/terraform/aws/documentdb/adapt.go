@@ -1,6 +1,7 @@
 package documentdb
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/documentdb"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -16,13 +17,13 @@ func adaptClusters(modules terraform.Modules) []documentdb.Cluster {
 	var clusters []documentdb.Cluster
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_docdb_cluster") {
-			clusters = append(clusters, adaptCluster(resource, module))
+			clusters = append(clusters, adaptCluster(resource, module, modules))
 		}
 	}
 	return clusters
 }
 
-func adaptCluster(resource *terraform.Block, module *terraform.Module) documentdb.Cluster {
+func adaptCluster(resource *terraform.Block, module *terraform.Module, modules terraform.Modules) documentdb.Cluster {
 	identifierAttr := resource.GetAttribute("cluster_identifier")
 	identifierVal := identifierAttr.AsStringValueOrDefault("", resource)
 
@@ -42,6 +43,7 @@ func adaptCluster(resource *terraform.Block, module *terraform.Module) documentd
 		instances = append(instances, documentdb.Instance{
 			Metadata: instanceRes.GetMetadata(),
 			KMSKeyID: keyIDVal,
+			Tags:     tags.Resolve(modules, instanceRes),
 		})
 	}
 
@@ -58,5 +60,6 @@ func adaptCluster(resource *terraform.Block, module *terraform.Module) documentd
 		Instances:         instances,
 		StorageEncrypted:  storageEncryptedVal,
 		KMSKeyID:          KMSKeyIDVal,
+		Tags:              tags.Resolve(modules, resource),
 	}
 }
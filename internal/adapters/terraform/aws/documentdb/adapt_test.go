@@ -48,9 +48,11 @@ func Test_adaptCluster(t *testing.T) {
 					{
 						Metadata: types.NewTestMetadata(),
 						KMSKeyID: types.String("kms-key#1", types.NewTestMetadata()),
+						Tags:     types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 				StorageEncrypted: types.Bool(true, types.NewTestMetadata()),
+				Tags:             types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -64,6 +66,7 @@ func Test_adaptCluster(t *testing.T) {
 				Identifier:       types.String("", types.NewTestMetadata()),
 				StorageEncrypted: types.Bool(false, types.NewTestMetadata()),
 				KMSKeyID:         types.String("", types.NewTestMetadata()),
+				Tags:             types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -71,7 +74,7 @@ func Test_adaptCluster(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptCluster(modules.GetBlocks()[0], modules[0])
+			adapted := adaptCluster(modules.GetBlocks()[0], modules[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
@@ -1,6 +1,7 @@
 package dynamodb
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/dynamodb"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -17,7 +18,7 @@ func adaptClusters(modules terraform.Modules) []dynamodb.DAXCluster {
 	var clusters []dynamodb.DAXCluster
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_dax_cluster") {
-			clusters = append(clusters, adaptCluster(resource, module))
+			clusters = append(clusters, adaptCluster(resource, module, modules))
 		}
 	}
 	return clusters
@@ -27,13 +28,13 @@ func adaptTables(modules terraform.Modules) []dynamodb.Table {
 	var tables []dynamodb.Table
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_dynamodb_table") {
-			tables = append(tables, adaptTable(resource, module))
+			tables = append(tables, adaptTable(resource, module, modules))
 		}
 	}
 	return tables
 }
 
-func adaptCluster(resource *terraform.Block, module *terraform.Module) dynamodb.DAXCluster {
+func adaptCluster(resource *terraform.Block, module *terraform.Module, modules terraform.Modules) dynamodb.DAXCluster {
 
 	cluster := dynamodb.DAXCluster{
 		Metadata: resource.GetMetadata(),
@@ -43,6 +44,7 @@ func adaptCluster(resource *terraform.Block, module *terraform.Module) dynamodb.
 			KMSKeyID: types.StringDefault("", resource.GetMetadata()),
 		},
 		PointInTimeRecovery: types.BoolDefault(false, resource.GetMetadata()),
+		Tags:                tags.Resolve(modules, resource),
 	}
 
 	if ssEncryptionBlock := resource.GetBlock("server_side_encryption"); ssEncryptionBlock.IsNotNil() {
@@ -59,7 +61,7 @@ func adaptCluster(resource *terraform.Block, module *terraform.Module) dynamodb.
 	return cluster
 }
 
-func adaptTable(resource *terraform.Block, module *terraform.Module) dynamodb.Table {
+func adaptTable(resource *terraform.Block, module *terraform.Module, modules terraform.Modules) dynamodb.Table {
 
 	table := dynamodb.Table{
 		Metadata: resource.GetMetadata(),
@@ -69,6 +71,7 @@ func adaptTable(resource *terraform.Block, module *terraform.Module) dynamodb.Ta
 			KMSKeyID: types.StringDefault("", resource.GetMetadata()),
 		},
 		PointInTimeRecovery: types.BoolDefault(false, resource.GetMetadata()),
+		Tags:                tags.Resolve(modules, resource),
 	}
 
 	if ssEncryptionBlock := resource.GetBlock("server_side_encryption"); ssEncryptionBlock.IsNotNil() {
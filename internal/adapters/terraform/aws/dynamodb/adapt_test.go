@@ -37,6 +37,7 @@ func Test_adaptCluster(t *testing.T) {
 					KMSKeyID: types.String("", types.NewTestMetadata()),
 				},
 				PointInTimeRecovery: types.Bool(false, types.NewTestMetadata()),
+				Tags:                types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -44,7 +45,7 @@ func Test_adaptCluster(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptCluster(modules.GetBlocks()[0], modules[0])
+			adapted := adaptCluster(modules.GetBlocks()[0], modules[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
@@ -80,6 +81,7 @@ func Test_adaptTable(t *testing.T) {
 					KMSKeyID: types.String("key-string", types.NewTestMetadata()),
 				},
 				PointInTimeRecovery: types.Bool(true, types.NewTestMetadata()),
+				Tags:                types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -99,6 +101,7 @@ func Test_adaptTable(t *testing.T) {
 					KMSKeyID: types.String("alias/aws/dynamodb", types.NewTestMetadata()),
 				},
 				PointInTimeRecovery: types.Bool(false, types.NewTestMetadata()),
+				Tags:                types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -124,6 +127,7 @@ func Test_adaptTable(t *testing.T) {
 					KMSKeyID: types.String("aws_kms_key.a", types.NewTestMetadata()),
 				},
 				PointInTimeRecovery: types.Bool(false, types.NewTestMetadata()),
+				Tags:                types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -131,7 +135,7 @@ func Test_adaptTable(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptTable(modules.GetBlocks()[0], modules[0])
+			adapted := adaptTable(modules.GetBlocks()[0], modules[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
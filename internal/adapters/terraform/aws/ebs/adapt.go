@@ -1,6 +1,7 @@
 package ebs
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/ebs"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -16,13 +17,13 @@ func adaptVolumes(modules terraform.Modules) []ebs.Volume {
 	var volumes []ebs.Volume
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_ebs_volume") {
-			volumes = append(volumes, adaptVolume(resource, module))
+			volumes = append(volumes, adaptVolume(resource, module, modules))
 		}
 	}
 	return volumes
 }
 
-func adaptVolume(resource *terraform.Block, module *terraform.Module) ebs.Volume {
+func adaptVolume(resource *terraform.Block, module *terraform.Module, modules terraform.Modules) ebs.Volume {
 	encryptedAttr := resource.GetAttribute("encrypted")
 	encryptedVal := encryptedAttr.AsBoolValueOrDefault(false, resource)
 
@@ -42,5 +43,6 @@ func adaptVolume(resource *terraform.Block, module *terraform.Module) ebs.Volume
 			Enabled:  encryptedVal,
 			KMSKeyID: kmsKeyVal,
 		},
+		Tags: tags.Resolve(modules, resource),
 	}
 }
@@ -39,6 +39,7 @@ func Test_adaptVolume(t *testing.T) {
 					Enabled:  types.Bool(true, types.NewTestMetadata()),
 					KMSKeyID: types.String("aws_kms_key.ebs_encryption", types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -56,6 +57,7 @@ func Test_adaptVolume(t *testing.T) {
 					Enabled:  types.Bool(true, types.NewTestMetadata()),
 					KMSKeyID: types.String("string-key", types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -71,6 +73,7 @@ func Test_adaptVolume(t *testing.T) {
 					Enabled:  types.Bool(false, types.NewTestMetadata()),
 					KMSKeyID: types.String("", types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -78,7 +81,7 @@ func Test_adaptVolume(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptVolume(modules.GetBlocks()[0], modules[0])
+			adapted := adaptVolume(modules.GetBlocks()[0], modules[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
@@ -1,6 +1,7 @@
 package ec2
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/ec2"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -32,6 +33,7 @@ func getInstances(modules terraform.Modules) []ec2.Instance {
 				Encrypted: types.BoolDefault(false, b.GetMetadata()),
 			},
 			EBSBlockDevices: nil,
+			Tags:            tags.Resolve(modules, b),
 		}
 
 		if rootBlockDevice := b.GetBlock("root_block_device"); rootBlockDevice.IsNotNil() {
@@ -1,6 +1,7 @@
 package ecr
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/ecr"
 	iamp "github.com/aquasecurity/defsec/pkg/providers/aws/iam"
@@ -18,13 +19,13 @@ func adaptRepositories(modules terraform.Modules) []ecr.Repository {
 	var repositories []ecr.Repository
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_ecr_repository") {
-			repositories = append(repositories, adaptRepository(resource, module))
+			repositories = append(repositories, adaptRepository(resource, module, modules))
 		}
 	}
 	return repositories
 }
 
-func adaptRepository(resource *terraform.Block, module *terraform.Module) ecr.Repository {
+func adaptRepository(resource *terraform.Block, module *terraform.Module, modules terraform.Modules) ecr.Repository {
 	repo := ecr.Repository{
 		Metadata: resource.GetMetadata(),
 		ImageScanning: ecr.ImageScanning{
@@ -38,6 +39,7 @@ func adaptRepository(resource *terraform.Block, module *terraform.Module) ecr.Re
 			Type:     types.StringDefault("AES256", resource.GetMetadata()),
 			KMSKeyID: types.StringDefault("", resource.GetMetadata()),
 		},
+		Tags: tags.Resolve(modules, resource),
 	}
 
 	if imageScanningBlock := resource.GetBlock("image_scanning_configuration"); imageScanningBlock.IsNotNil() {
@@ -126,6 +126,7 @@ func Test_adaptRepository(t *testing.T) {
 						}(),
 					},
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -146,6 +147,7 @@ func Test_adaptRepository(t *testing.T) {
 					Type:     types.String("AES256", types.NewTestMetadata()),
 					KMSKeyID: types.String("", types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -153,7 +155,7 @@ func Test_adaptRepository(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptRepository(modules.GetBlocks()[0], modules[0])
+			adapted := adaptRepository(modules.GetBlocks()[0], modules[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
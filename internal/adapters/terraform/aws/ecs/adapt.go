@@ -1,6 +1,7 @@
 package ecs
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/ecs"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -17,16 +18,17 @@ func adaptClusters(modules terraform.Modules) []ecs.Cluster {
 	var clusters []ecs.Cluster
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_ecs_cluster") {
-			clusters = append(clusters, adaptClusterResource(resource))
+			clusters = append(clusters, adaptClusterResource(resource, modules))
 		}
 	}
 	return clusters
 }
 
-func adaptClusterResource(resourceBlock *terraform.Block) ecs.Cluster {
+func adaptClusterResource(resourceBlock *terraform.Block, modules terraform.Modules) ecs.Cluster {
 	return ecs.Cluster{
 		Metadata: resourceBlock.GetMetadata(),
 		Settings: adaptClusterSettings(resourceBlock),
+		Tags:     tags.Resolve(modules, resourceBlock),
 	}
 }
 
@@ -53,17 +55,18 @@ func adaptTaskDefinitions(modules terraform.Modules) []ecs.TaskDefinition {
 	var taskDefinitions []ecs.TaskDefinition
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_ecs_task_definition") {
-			taskDefinitions = append(taskDefinitions, adaptTaskDefinitionResource(resource))
+			taskDefinitions = append(taskDefinitions, adaptTaskDefinitionResource(resource, modules))
 		}
 	}
 	return taskDefinitions
 }
 
-func adaptTaskDefinitionResource(resourceBlock *terraform.Block) ecs.TaskDefinition {
+func adaptTaskDefinitionResource(resourceBlock *terraform.Block, modules terraform.Modules) ecs.TaskDefinition {
 	return ecs.TaskDefinition{
 		Metadata:             resourceBlock.GetMetadata(),
 		Volumes:              adaptVolumes(resourceBlock),
 		ContainerDefinitions: resourceBlock.GetAttribute("container_definitions").AsStringValueOrDefault("", resourceBlock),
+		Tags:                 tags.Resolve(modules, resourceBlock),
 	}
 }
 
@@ -133,6 +133,7 @@ func Test_adaptTaskDefinitionResource(t *testing.T) {
 ]
 `,
 					types.NewTestMetadata()),
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -160,6 +161,7 @@ func Test_adaptTaskDefinitionResource(t *testing.T) {
 					},
 				},
 				ContainerDefinitions: types.String("", types.NewTestMetadata()),
+				Tags:                 types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -167,7 +169,7 @@ func Test_adaptTaskDefinitionResource(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptTaskDefinitionResource(modules.GetBlocks()[0])
+			adapted := adaptTaskDefinitionResource(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
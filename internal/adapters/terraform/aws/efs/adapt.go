@@ -1,6 +1,7 @@
 package efs
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/efs"
 	"github.com/aquasecurity/defsec/pkg/terraform"
 )
@@ -15,18 +16,19 @@ func adaptFileSystems(modules terraform.Modules) []efs.FileSystem {
 	var filesystems []efs.FileSystem
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_efs_file_system") {
-			filesystems = append(filesystems, adaptFileSystem(resource))
+			filesystems = append(filesystems, adaptFileSystem(resource, modules))
 		}
 	}
 	return filesystems
 }
 
-func adaptFileSystem(resource *terraform.Block) efs.FileSystem {
+func adaptFileSystem(resource *terraform.Block, modules terraform.Modules) efs.FileSystem {
 	encryptedAttr := resource.GetAttribute("encrypted")
 	encryptedVal := encryptedAttr.AsBoolValueOrDefault(false, resource)
 
 	return efs.FileSystem{
 		Metadata:  resource.GetMetadata(),
 		Encrypted: encryptedVal,
+		Tags:      tags.Resolve(modules, resource),
 	}
 }
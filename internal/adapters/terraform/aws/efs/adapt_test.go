@@ -32,6 +32,7 @@ func Test_adaptFileSystem(t *testing.T) {
 			expected: efs.FileSystem{
 				Metadata:  types.NewTestMetadata(),
 				Encrypted: types.Bool(true, types.NewTestMetadata()),
+				Tags:      types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -43,6 +44,7 @@ func Test_adaptFileSystem(t *testing.T) {
 			expected: efs.FileSystem{
 				Metadata:  types.NewTestMetadata(),
 				Encrypted: types.Bool(false, types.NewTestMetadata()),
+				Tags:      types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -50,7 +52,7 @@ func Test_adaptFileSystem(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptFileSystem(modules.GetBlocks()[0])
+			adapted := adaptFileSystem(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
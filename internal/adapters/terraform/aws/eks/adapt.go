@@ -1,6 +1,7 @@
 package eks
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/eks"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -16,13 +17,13 @@ func adaptClusters(modules terraform.Modules) []eks.Cluster {
 	var clusters []eks.Cluster
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_eks_cluster") {
-			clusters = append(clusters, adaptCluster(resource))
+			clusters = append(clusters, adaptCluster(resource, modules))
 		}
 	}
 	return clusters
 }
 
-func adaptCluster(resource *terraform.Block) eks.Cluster {
+func adaptCluster(resource *terraform.Block, modules terraform.Modules) eks.Cluster {
 
 	cluster := eks.Cluster{
 		Metadata: resource.GetMetadata(),
@@ -41,6 +42,7 @@ func adaptCluster(resource *terraform.Block) eks.Cluster {
 		},
 		PublicAccessEnabled: types.BoolDefault(true, resource.GetMetadata()),
 		PublicAccessCIDRs:   nil,
+		Tags:                tags.Resolve(modules, resource),
 	}
 
 	if logTypesAttr := resource.GetAttribute("enabled_cluster_log_types"); logTypesAttr.IsNotNil() {
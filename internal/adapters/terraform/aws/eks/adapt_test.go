@@ -60,6 +60,7 @@ func Test_adaptCluster(t *testing.T) {
 				PublicAccessCIDRs: []types.StringValue{
 					types.String("10.2.0.0/8", types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -85,6 +86,7 @@ func Test_adaptCluster(t *testing.T) {
 				},
 				PublicAccessEnabled: types.Bool(true, types.NewTestMetadata()),
 				PublicAccessCIDRs:   nil,
+				Tags:                types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -92,7 +94,7 @@ func Test_adaptCluster(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptCluster(modules.GetBlocks()[0])
+			adapted := adaptCluster(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
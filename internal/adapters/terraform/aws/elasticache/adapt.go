@@ -1,6 +1,7 @@
 package elasticache
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/elasticache"
 	"github.com/aquasecurity/defsec/pkg/terraform"
 )
@@ -16,7 +17,7 @@ func adaptClusters(modules terraform.Modules) []elasticache.Cluster {
 	var clusters []elasticache.Cluster
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_elasticache_cluster") {
-			clusters = append(clusters, adaptCluster(resource))
+			clusters = append(clusters, adaptCluster(resource, modules))
 		}
 	}
 	return clusters
@@ -26,7 +27,7 @@ func adaptReplicationGroups(modules terraform.Modules) []elasticache.Replication
 	var replicationGroups []elasticache.ReplicationGroup
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_elasticache_replication_group") {
-			replicationGroups = append(replicationGroups, adaptReplicationGroup(resource))
+			replicationGroups = append(replicationGroups, adaptReplicationGroup(resource, modules))
 		}
 	}
 	return replicationGroups
@@ -42,7 +43,7 @@ func adaptSecurityGroups(modules terraform.Modules) []elasticache.SecurityGroup
 	return securityGroups
 }
 
-func adaptCluster(resource *terraform.Block) elasticache.Cluster {
+func adaptCluster(resource *terraform.Block, modules terraform.Modules) elasticache.Cluster {
 	engineAttr := resource.GetAttribute("engine")
 	engineVal := engineAttr.AsStringValueOrDefault("", resource)
 
@@ -57,10 +58,11 @@ func adaptCluster(resource *terraform.Block) elasticache.Cluster {
 		Engine:                 engineVal,
 		NodeType:               nodeTypeVal,
 		SnapshotRetentionLimit: snapshotRetentionVal,
+		Tags:                   tags.Resolve(modules, resource),
 	}
 }
 
-func adaptReplicationGroup(resource *terraform.Block) elasticache.ReplicationGroup {
+func adaptReplicationGroup(resource *terraform.Block, modules terraform.Modules) elasticache.ReplicationGroup {
 	transitEncryptionAttr := resource.GetAttribute("transit_encryption_enabled")
 	transitEncryptionVal := transitEncryptionAttr.AsBoolValueOrDefault(false, resource)
 
@@ -71,6 +73,7 @@ func adaptReplicationGroup(resource *terraform.Block) elasticache.ReplicationGro
 		Metadata:                 resource.GetMetadata(),
 		TransitEncryptionEnabled: transitEncryptionVal,
 		AtRestEncryptionEnabled:  atRestEncryptionVal,
+		Tags:                     tags.Resolve(modules, resource),
 	}
 }
 
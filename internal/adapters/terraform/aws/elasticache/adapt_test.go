@@ -40,6 +40,7 @@ func Test_adaptCluster(t *testing.T) {
 				Engine:                 types.String("redis", types.NewTestMetadata()),
 				NodeType:               types.String("cache.m4.large", types.NewTestMetadata()),
 				SnapshotRetentionLimit: types.Int(5, types.NewTestMetadata()),
+				Tags:                   types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -52,6 +53,7 @@ func Test_adaptCluster(t *testing.T) {
 				Engine:                 types.String("", types.NewTestMetadata()),
 				NodeType:               types.String("", types.NewTestMetadata()),
 				SnapshotRetentionLimit: types.Int(0, types.NewTestMetadata()),
+				Tags:                   types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -59,7 +61,7 @@ func Test_adaptCluster(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptCluster(modules.GetBlocks()[0])
+			adapted := adaptCluster(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
@@ -85,6 +87,7 @@ func Test_adaptReplicationGroup(t *testing.T) {
 				Metadata:                 types.NewTestMetadata(),
 				TransitEncryptionEnabled: types.Bool(true, types.NewTestMetadata()),
 				AtRestEncryptionEnabled:  types.Bool(true, types.NewTestMetadata()),
+				Tags:                     types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -97,6 +100,7 @@ func Test_adaptReplicationGroup(t *testing.T) {
 				Metadata:                 types.NewTestMetadata(),
 				TransitEncryptionEnabled: types.Bool(false, types.NewTestMetadata()),
 				AtRestEncryptionEnabled:  types.Bool(false, types.NewTestMetadata()),
+				Tags:                     types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -104,7 +108,7 @@ func Test_adaptReplicationGroup(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptReplicationGroup(modules.GetBlocks()[0])
+			adapted := adaptReplicationGroup(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
@@ -1,6 +1,7 @@
 package elasticsearch
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/elasticsearch"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -16,13 +17,13 @@ func adaptDomains(modules terraform.Modules) []elasticsearch.Domain {
 	var domains []elasticsearch.Domain
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_elasticsearch_domain") {
-			domains = append(domains, adaptDomain(resource))
+			domains = append(domains, adaptDomain(resource, modules))
 		}
 	}
 	return domains
 }
 
-func adaptDomain(resource *terraform.Block) elasticsearch.Domain {
+func adaptDomain(resource *terraform.Block, modules terraform.Modules) elasticsearch.Domain {
 	domain := elasticsearch.Domain{
 		Metadata:   resource.GetMetadata(),
 		DomainName: types.StringDefault("", resource.GetMetadata()),
@@ -43,6 +44,7 @@ func adaptDomain(resource *terraform.Block) elasticsearch.Domain {
 			EnforceHTTPS: types.BoolDefault(false, resource.GetMetadata()),
 			TLSPolicy:    types.StringDefault("", resource.GetMetadata()),
 		},
+		Tags: tags.Resolve(modules, resource),
 	}
 
 	nameAttr := resource.GetAttribute("domain_name")
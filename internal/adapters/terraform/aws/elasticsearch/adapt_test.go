@@ -66,6 +66,7 @@ func Test_adaptDomain(t *testing.T) {
 					EnforceHTTPS: types.Bool(true, types.NewTestMetadata()),
 					TLSPolicy:    types.String("Policy-Min-TLS-1-2-2019-07", types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -94,6 +95,7 @@ func Test_adaptDomain(t *testing.T) {
 					EnforceHTTPS: types.Bool(false, types.NewTestMetadata()),
 					TLSPolicy:    types.String("", types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -101,7 +103,7 @@ func Test_adaptDomain(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptDomain(modules.GetBlocks()[0])
+			adapted := adaptDomain(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
@@ -1,6 +1,7 @@
 package elb
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/elb"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -41,6 +42,7 @@ func (a *adapter) adaptLoadBalancers(modules terraform.Modules) []elb.LoadBalanc
 			DropInvalidHeaderFields: types.BoolDefault(false, types.NewUnmanagedMetadata()),
 			Internal:                types.BoolDefault(false, types.NewUnmanagedMetadata()),
 			Listeners:               nil,
+			Tags:                    types.MapDefault(make(map[string]string), types.NewUnmanagedMetadata()),
 		}
 		for _, listenerResource := range orphanResources {
 			orphanage.Listeners = append(orphanage.Listeners, adaptListener(listenerResource, "application"))
@@ -76,6 +78,7 @@ func (a *adapter) adaptLoadBalancer(resource *terraform.Block, module terraform.
 		DropInvalidHeaderFields: dropInvalidHeadersVal,
 		Internal:                internalVal,
 		Listeners:               listeners,
+		Tags:                    tags.Resolve(module, resource),
 	}
 }
 
@@ -89,6 +92,7 @@ func (a *adapter) adaptClassicLoadBalancer(resource *terraform.Block, module ter
 		DropInvalidHeaderFields: types.BoolDefault(false, resource.GetMetadata()),
 		Internal:                internalVal,
 		Listeners:               nil,
+		Tags:                    tags.Resolve(module, resource),
 	}
 }
 
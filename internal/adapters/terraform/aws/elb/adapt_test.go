@@ -65,6 +65,7 @@ func Test_Adapt(t *testing.T) {
 								},
 							},
 						},
+						Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 			},
@@ -83,6 +84,7 @@ func Test_Adapt(t *testing.T) {
 						DropInvalidHeaderFields: types.Bool(false, types.NewTestMetadata()),
 						Internal:                types.Bool(false, types.NewTestMetadata()),
 						Listeners:               nil,
+						Tags:                    types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 			},
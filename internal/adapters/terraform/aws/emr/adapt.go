@@ -1,6 +1,7 @@
 package emr
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/emr"
 	"github.com/aquasecurity/defsec/pkg/terraform"
 )
@@ -15,16 +16,17 @@ func adaptClusters(modules terraform.Modules) []emr.Cluster {
 	var clusters []emr.Cluster
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_emr_cluster") {
-			clusters = append(clusters, adaptCluster(resource))
+			clusters = append(clusters, adaptCluster(resource, modules))
 		}
 	}
 	return clusters
 }
 
-func adaptCluster(resource *terraform.Block) emr.Cluster {
+func adaptCluster(resource *terraform.Block, modules terraform.Modules) emr.Cluster {
 
 	return emr.Cluster{
 		Metadata: resource.GetMetadata(),
+		Tags:     tags.Resolve(modules, resource),
 	}
 }
 
@@ -101,7 +101,7 @@ func ConvertTerraformDocument(modules terraform.Modules, block *terraform.Block)
 	return &wrappedDocument{Document: builder.Build(), Source: block}, nil
 }
 
-//nolint
+// nolint
 func parseStatement(statementBlock *terraform.Block) iamgo.Statement {
 
 	metadata := statementBlock.GetMetadata()
@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/iam"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -8,6 +9,16 @@ import (
 )
 
 func adaptRoles(modules terraform.Modules) []iam.Role {
+	var output []iam.Role
+	for _, role := range AdaptRolesByID(modules) {
+		output = append(output, role)
+	}
+	return output
+}
+
+// AdaptRolesByID adapts the aws_iam_role resources along with their policies, keyed by the ID of the role block so
+// that resources which reference a role can find it
+func AdaptRolesByID(modules terraform.Modules) map[string]iam.Role {
 
 	roleMap, policyMap := mapRoles(modules)
 
@@ -32,11 +43,7 @@ func adaptRoles(modules terraform.Modules) []iam.Role {
 		roleMap[roleBlock.ID()] = role
 	}
 
-	var output []iam.Role
-	for _, role := range roleMap {
-		output = append(output, role)
-	}
-	return output
+	return roleMap
 }
 
 func mapRoles(modules terraform.Modules) (map[string]iam.Role, map[string]struct{}) {
@@ -47,6 +54,7 @@ func mapRoles(modules terraform.Modules) (map[string]iam.Role, map[string]struct
 			Metadata: roleBlock.GetMetadata(),
 			Name:     roleBlock.GetAttribute("name").AsStringValueOrDefault("", roleBlock),
 			Policies: nil,
+			Tags:     tags.Resolve(modules, roleBlock),
 		}
 		if inlineBlock := roleBlock.GetBlock("inline_policy"); inlineBlock.IsNotNil() {
 			policy := iam.Policy{
@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/iam"
 	"github.com/aquasecurity/defsec/pkg/terraform"
 )
@@ -44,6 +45,7 @@ func mapUsers(modules terraform.Modules) (map[string]iam.User, map[string]struct
 			Name:     userBlock.GetAttribute("name").AsStringValueOrDefault("", userBlock),
 			Groups:   nil,
 			Policies: nil,
+			Tags:     tags.Resolve(modules, userBlock),
 		}
 
 		for _, block := range modules.GetResourcesByType("aws_iam_user_policy") {
@@ -1,6 +1,7 @@
 package kinesis
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/kinesis"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -16,13 +17,13 @@ func adaptStreams(modules terraform.Modules) []kinesis.Stream {
 	var streams []kinesis.Stream
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_kinesis_stream") {
-			streams = append(streams, adaptStream(resource))
+			streams = append(streams, adaptStream(resource, modules))
 		}
 	}
 	return streams
 }
 
-func adaptStream(resource *terraform.Block) kinesis.Stream {
+func adaptStream(resource *terraform.Block, modules terraform.Modules) kinesis.Stream {
 
 	stream := kinesis.Stream{
 		Metadata: resource.GetMetadata(),
@@ -31,6 +32,7 @@ func adaptStream(resource *terraform.Block) kinesis.Stream {
 			Type:     types.StringDefault("NONE", resource.GetMetadata()),
 			KMSKeyID: types.StringDefault("", resource.GetMetadata()),
 		},
+		Tags: tags.Resolve(modules, resource),
 	}
 
 	encryptionTypeAttr := resource.GetAttribute("encryption_type")
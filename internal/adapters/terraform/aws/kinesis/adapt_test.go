@@ -35,6 +35,7 @@ func Test_adaptStream(t *testing.T) {
 					Type:     types.String("KMS", types.NewTestMetadata()),
 					KMSKeyID: types.String("my/special/key", types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -50,6 +51,7 @@ func Test_adaptStream(t *testing.T) {
 					Type:     types.String("NONE", types.NewTestMetadata()),
 					KMSKeyID: types.String("", types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -57,7 +59,7 @@ func Test_adaptStream(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptStream(modules.GetBlocks()[0])
+			adapted := adaptStream(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
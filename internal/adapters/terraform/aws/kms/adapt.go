@@ -1,6 +1,7 @@
 package kms
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/kms"
 	"github.com/aquasecurity/defsec/pkg/terraform"
 )
@@ -15,13 +16,13 @@ func adaptKeys(modules terraform.Modules) []kms.Key {
 	var keys []kms.Key
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_kms_key") {
-			keys = append(keys, adaptKey(resource))
+			keys = append(keys, adaptKey(resource, modules))
 		}
 	}
 	return keys
 }
 
-func adaptKey(resource *terraform.Block) kms.Key {
+func adaptKey(resource *terraform.Block, modules terraform.Modules) kms.Key {
 	usageAttr := resource.GetAttribute("key_usage")
 	usageVal := usageAttr.AsStringValueOrDefault("ENCRYPT_DECRYPT", resource)
 
@@ -32,5 +33,6 @@ func adaptKey(resource *terraform.Block) kms.Key {
 		Metadata:        resource.GetMetadata(),
 		Usage:           usageVal,
 		RotationEnabled: enableKeyRotationVal,
+		Tags:            tags.Resolve(modules, resource),
 	}
 }
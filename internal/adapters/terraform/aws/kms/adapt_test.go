@@ -31,6 +31,7 @@ func Test_adaptKey(t *testing.T) {
 			expected: kms.Key{
 				Usage:           types.String(kms.KeyUsageSignAndVerify, types.NewTestMetadata()),
 				RotationEnabled: types.Bool(true, types.NewTestMetadata()),
+				Tags:            types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -42,6 +43,7 @@ func Test_adaptKey(t *testing.T) {
 			expected: kms.Key{
 				Usage:           types.String("ENCRYPT_DECRYPT", types.NewTestMetadata()),
 				RotationEnabled: types.Bool(false, types.NewTestMetadata()),
+				Tags:            types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -49,7 +51,7 @@ func Test_adaptKey(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptKey(modules.GetBlocks()[0])
+			adapted := adaptKey(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
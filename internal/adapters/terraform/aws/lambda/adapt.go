@@ -1,6 +1,7 @@
 package lambda
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/lambda"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -40,6 +41,7 @@ func (a *adapter) adaptFunctions(modules terraform.Modules) []lambda.Function {
 				Mode:     types.StringDefault("", types.NewUnmanagedMetadata()),
 			},
 			Permissions: nil,
+			Tags:        types.MapDefault(make(map[string]string), types.NewUnmanagedMetadata()),
 		}
 		for _, permission := range orphanResources {
 			orphanage.Permissions = append(orphanage.Permissions, a.adaptPermission(permission))
@@ -65,6 +67,7 @@ func (a *adapter) adaptFunction(function *terraform.Block, modules terraform.Mod
 		Metadata:    function.GetMetadata(),
 		Tracing:     a.adaptTracing(function),
 		Permissions: permissions,
+		Tags:        tags.Resolve(modules, function),
 	}
 }
 
@@ -56,6 +56,7 @@ func Test_Adapt(t *testing.T) {
 								SourceARN: types.String("default", types.NewTestMetadata()),
 							},
 						},
+						Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 			},
@@ -79,6 +80,7 @@ func Test_Adapt(t *testing.T) {
 							Metadata: types.NewTestMetadata(),
 							Mode:     types.String("", types.NewTestMetadata()),
 						},
+						Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 					{
 						Metadata: types.NewTestMetadata(),
@@ -93,6 +95,7 @@ func Test_Adapt(t *testing.T) {
 								SourceARN: types.String("", types.NewTestMetadata()),
 							},
 						},
+						Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 			},
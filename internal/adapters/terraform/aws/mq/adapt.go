@@ -1,6 +1,7 @@
 package mq
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/mq"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -16,13 +17,13 @@ func adaptBrokers(modules terraform.Modules) []mq.Broker {
 	var brokers []mq.Broker
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_mq_broker") {
-			brokers = append(brokers, adaptBroker(resource))
+			brokers = append(brokers, adaptBroker(resource, modules))
 		}
 	}
 	return brokers
 }
 
-func adaptBroker(resource *terraform.Block) mq.Broker {
+func adaptBroker(resource *terraform.Block, modules terraform.Modules) mq.Broker {
 
 	broker := mq.Broker{
 		Metadata:     resource.GetMetadata(),
@@ -32,6 +33,7 @@ func adaptBroker(resource *terraform.Block) mq.Broker {
 			General:  types.BoolDefault(false, resource.GetMetadata()),
 			Audit:    types.BoolDefault(false, resource.GetMetadata()),
 		},
+		Tags: tags.Resolve(modules, resource),
 	}
 
 	publicAccessAttr := resource.GetAttribute("publicly_accessible")
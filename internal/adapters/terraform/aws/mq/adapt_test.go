@@ -39,6 +39,7 @@ func Test_adaptBroker(t *testing.T) {
 					General:  types.Bool(false, types.NewTestMetadata()),
 					Audit:    types.Bool(true, types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -60,6 +61,7 @@ func Test_adaptBroker(t *testing.T) {
 					General:  types.Bool(true, types.NewTestMetadata()),
 					Audit:    types.Bool(false, types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -76,6 +78,7 @@ func Test_adaptBroker(t *testing.T) {
 					General:  types.Bool(false, types.NewTestMetadata()),
 					Audit:    types.Bool(false, types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -83,7 +86,7 @@ func Test_adaptBroker(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptBroker(modules.GetBlocks()[0])
+			adapted := adaptBroker(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
@@ -1,6 +1,7 @@
 package msk
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/msk"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -16,13 +17,13 @@ func adaptClusters(modules terraform.Modules) []msk.Cluster {
 	var clusters []msk.Cluster
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_msk_cluster") {
-			clusters = append(clusters, adaptCluster(resource))
+			clusters = append(clusters, adaptCluster(resource, modules))
 		}
 	}
 	return clusters
 }
 
-func adaptCluster(resource *terraform.Block) msk.Cluster {
+func adaptCluster(resource *terraform.Block, modules terraform.Modules) msk.Cluster {
 	cluster := msk.Cluster{
 		Metadata: resource.GetMetadata(),
 		EncryptionInTransit: msk.EncryptionInTransit{
@@ -47,6 +48,7 @@ func adaptCluster(resource *terraform.Block) msk.Cluster {
 				},
 			},
 		},
+		Tags: tags.Resolve(modules, resource),
 	}
 
 	if encryptBlock := resource.GetBlock("encryption_info"); encryptBlock.IsNotNil() {
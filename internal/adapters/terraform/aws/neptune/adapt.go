@@ -1,6 +1,7 @@
 package neptune
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/neptune"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -16,13 +17,13 @@ func adaptClusters(modules terraform.Modules) []neptune.Cluster {
 	var clusters []neptune.Cluster
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_neptune_cluster") {
-			clusters = append(clusters, adaptCluster(resource))
+			clusters = append(clusters, adaptCluster(resource, modules))
 		}
 	}
 	return clusters
 }
 
-func adaptCluster(resource *terraform.Block) neptune.Cluster {
+func adaptCluster(resource *terraform.Block, modules terraform.Modules) neptune.Cluster {
 	cluster := neptune.Cluster{
 		Metadata: resource.GetMetadata(),
 		Logging: neptune.Logging{
@@ -31,6 +32,7 @@ func adaptCluster(resource *terraform.Block) neptune.Cluster {
 		},
 		StorageEncrypted: types.BoolDefault(false, resource.GetMetadata()),
 		KMSKeyID:         types.StringDefault("", resource.GetMetadata()),
+		Tags:             tags.Resolve(modules, resource),
 	}
 
 	if enableLogExportsAttr := resource.GetAttribute("enable_cloudwatch_logs_exports"); enableLogExportsAttr.IsNotNil() {
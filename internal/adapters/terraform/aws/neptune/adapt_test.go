@@ -37,6 +37,7 @@ func Test_adaptCluster(t *testing.T) {
 				},
 				StorageEncrypted: types.Bool(true, types.NewTestMetadata()),
 				KMSKeyID:         types.String("kms-key", types.NewTestMetadata()),
+				Tags:             types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -53,6 +54,7 @@ func Test_adaptCluster(t *testing.T) {
 				},
 				StorageEncrypted: types.Bool(false, types.NewTestMetadata()),
 				KMSKeyID:         types.String("", types.NewTestMetadata()),
+				Tags:             types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -60,7 +62,7 @@ func Test_adaptCluster(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptCluster(modules.GetBlocks()[0])
+			adapted := adaptCluster(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/rds"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -51,6 +52,7 @@ func getClusters(modules terraform.Modules) (clusters []rds.Cluster) {
 				EncryptStorage: types.BoolDefault(false, types.NewUnmanagedMetadata()),
 				KMSKeyID:       types.StringDefault("", types.NewUnmanagedMetadata()),
 			},
+			Tags: types.MapDefault(make(map[string]string), types.NewUnmanagedMetadata()),
 		}
 		for _, orphan := range orphanResources {
 			orphanage.Instances = append(orphanage.Instances, adaptClusterInstance(orphan, modules))
@@ -111,6 +113,7 @@ func adaptInstance(resource *terraform.Block, modules terraform.Modules) rds.Ins
 		PerformanceInsights:       adaptPerformanceInsights(resource),
 		Encryption:                adaptEncryption(resource),
 		PublicAccess:              resource.GetAttribute("publicly_accessible").AsBoolValueOrDefault(false, resource),
+		Tags:                      tags.Resolve(modules, resource),
 	}
 }
 
@@ -125,6 +128,7 @@ func adaptCluster(resource *terraform.Block, modules terraform.Modules) (rds.Clu
 		PerformanceInsights:       adaptPerformanceInsights(resource),
 		Instances:                 clusterInstances,
 		Encryption:                adaptEncryption(resource),
+		Tags:                      tags.Resolve(modules, resource),
 	}, ids
 }
 
@@ -70,6 +70,7 @@ func Test_Adapt(t *testing.T) {
 							KMSKeyID:       types.String("kms_key_2", types.NewTestMetadata()),
 						},
 						PublicAccess: types.Bool(true, types.NewTestMetadata()),
+						Tags:         types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 				Clusters: []rds.Cluster{
@@ -106,10 +107,12 @@ func Test_Adapt(t *testing.T) {
 										KMSKeyID:       types.String("kms_key_0", types.NewTestMetadata()),
 									},
 									PublicAccess: types.Bool(false, types.NewTestMetadata()),
+									Tags:         types.Map(map[string]string{}, types.NewTestMetadata()),
 								},
 								ClusterIdentifier: types.String("aws_rds_cluster.example", types.NewTestMetadata()),
 							},
 						},
+						Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 				Classic: rds.Classic{
@@ -160,6 +163,7 @@ func Test_adaptInstance(t *testing.T) {
 					KMSKeyID:       types.String("", types.NewTestMetadata()),
 				},
 				PublicAccess: types.Bool(false, types.NewTestMetadata()),
+				Tags:         types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -199,6 +203,7 @@ func Test_adaptCluster(t *testing.T) {
 					EncryptStorage: types.Bool(false, types.NewTestMetadata()),
 					KMSKeyID:       types.String("", types.NewTestMetadata()),
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
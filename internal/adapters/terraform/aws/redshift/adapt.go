@@ -1,6 +1,7 @@
 package redshift
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/redshift"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -17,7 +18,7 @@ func adaptClusters(modules terraform.Modules) []redshift.Cluster {
 	var clusters []redshift.Cluster
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_redshift_cluster") {
-			clusters = append(clusters, adaptCluster(resource, module))
+			clusters = append(clusters, adaptCluster(resource, module, modules))
 		}
 	}
 	return clusters
@@ -33,7 +34,7 @@ func adaptSecurityGroups(modules terraform.Modules) []redshift.SecurityGroup {
 	return securityGroups
 }
 
-func adaptCluster(resource *terraform.Block, module *terraform.Module) redshift.Cluster {
+func adaptCluster(resource *terraform.Block, module *terraform.Module, modules terraform.Modules) redshift.Cluster {
 	cluster := redshift.Cluster{
 		Metadata: resource.GetMetadata(),
 		Encryption: redshift.Encryption{
@@ -42,6 +43,7 @@ func adaptCluster(resource *terraform.Block, module *terraform.Module) redshift.
 			KMSKeyID: types.StringDefault("", resource.GetMetadata()),
 		},
 		SubnetGroupName: types.StringDefault("", resource.GetMetadata()),
+		Tags:            tags.Resolve(modules, resource),
 	}
 
 	encryptedAttr := resource.GetAttribute("encrypted")
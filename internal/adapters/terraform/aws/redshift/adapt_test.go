@@ -49,6 +49,7 @@ func Test_Adapt(t *testing.T) {
 							KMSKeyID: types.String("aws_kms_key.redshift", types.NewTestMetadata()),
 						},
 						SubnetGroupName: types.String("redshift_subnet", types.NewTestMetadata()),
+						Tags:            types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 				SecurityGroups: []redshift.SecurityGroup{
@@ -95,6 +96,7 @@ func Test_adaptCluster(t *testing.T) {
 					KMSKeyID: types.String("key-id", types.NewTestMetadata()),
 				},
 				SubnetGroupName: types.String("redshift_subnet", types.NewTestMetadata()),
+				Tags:            types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -111,6 +113,7 @@ func Test_adaptCluster(t *testing.T) {
 					KMSKeyID: types.String("", types.NewTestMetadata()),
 				},
 				SubnetGroupName: types.String("", types.NewTestMetadata()),
+				Tags:            types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -118,7 +121,7 @@ func Test_adaptCluster(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptCluster(modules.GetBlocks()[0], modules[0])
+			adapted := adaptCluster(modules.GetBlocks()[0], modules[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
@@ -1,6 +1,7 @@
 package s3
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/s3"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -22,6 +23,7 @@ func (a *adapter) adaptBuckets() []s3.Bucket {
 			Versioning:        getVersioning(block, a),
 			Logging:           getLogging(block, a),
 			ACL:               getBucketAcl(block, a),
+			Tags:              tags.Resolve(a.modules, block),
 		}
 		a.bucketMap[block.ID()] = bucket
 	}
@@ -0,0 +1,115 @@
+package sfn
+
+import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
+	"strings"
+
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/iam"
+	"github.com/aquasecurity/defsec/internal/types"
+	iamp "github.com/aquasecurity/defsec/pkg/providers/aws/iam"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/sfn"
+	"github.com/aquasecurity/defsec/pkg/terraform"
+)
+
+func Adapt(modules terraform.Modules) sfn.SFN {
+	adapter := adapter{
+		modules: modules,
+		roles:   iam.AdaptRolesByID(modules),
+	}
+	return sfn.SFN{
+		StateMachines: adapter.adaptStateMachines(),
+	}
+}
+
+type adapter struct {
+	modules terraform.Modules
+	roles   map[string]iamp.Role
+}
+
+func (a *adapter) adaptStateMachines() []sfn.StateMachine {
+	var stateMachines []sfn.StateMachine
+	for _, module := range a.modules {
+		for _, resource := range module.GetResourcesByType("aws_sfn_state_machine") {
+			stateMachines = append(stateMachines, a.adaptStateMachine(module, resource))
+		}
+	}
+	return stateMachines
+}
+
+func (a *adapter) adaptStateMachine(module *terraform.Module, resource *terraform.Block) sfn.StateMachine {
+	stateMachine := sfn.StateMachine{
+		Metadata: resource.GetMetadata(),
+		Name:     resource.GetAttribute("name").AsStringValueOrDefault("", resource),
+		Logging: sfn.Logging{
+			Metadata:             resource.GetMetadata(),
+			Level:                types.StringDefault(sfn.LoggingLevelOff, resource.GetMetadata()),
+			IncludeExecutionData: types.BoolDefault(false, resource.GetMetadata()),
+		},
+		Tracing: sfn.Tracing{
+			Metadata: resource.GetMetadata(),
+			Enabled:  types.BoolDefault(false, resource.GetMetadata()),
+		},
+		Definition: sfn.Definition{
+			Metadata: resource.GetMetadata(),
+			Comment:  types.StringDefault("", resource.GetMetadata()),
+			StartAt:  types.StringDefault("", resource.GetMetadata()),
+		},
+		Tags: tags.Resolve(a.modules, resource),
+	}
+
+	if loggingBlock := resource.GetBlock("logging_configuration"); loggingBlock.IsNotNil() {
+		stateMachine.Logging = sfn.Logging{
+			Metadata:             loggingBlock.GetMetadata(),
+			Level:                loggingBlock.GetAttribute("level").AsStringValueOrDefault(sfn.LoggingLevelOff, loggingBlock),
+			IncludeExecutionData: loggingBlock.GetAttribute("include_execution_data").AsBoolValueOrDefault(false, loggingBlock),
+		}
+	}
+
+	if tracingBlock := resource.GetBlock("tracing_configuration"); tracingBlock.IsNotNil() {
+		stateMachine.Tracing = sfn.Tracing{
+			Metadata: tracingBlock.GetMetadata(),
+			Enabled:  tracingBlock.GetAttribute("enabled").AsBoolValueOrDefault(false, tracingBlock),
+		}
+	}
+
+	if definitionAttr := resource.GetAttribute("definition"); definitionAttr.IsNotNil() {
+		stateMachine.Definition = adaptDefinition(module, resource, definitionAttr)
+	}
+
+	if roleAttr := resource.GetAttribute("role_arn"); roleAttr.IsNotNil() {
+		if roleBlock, err := a.modules.GetReferencedBlock(roleAttr, resource); err == nil {
+			if role, ok := a.roles[roleBlock.ID()]; ok {
+				stateMachine.Policies = role.Policies
+			}
+		}
+	}
+
+	return stateMachine
+}
+
+// adaptDefinition parses the definition of a state machine, which is unresolvable when it isn't a known string or
+// fails to parse
+func adaptDefinition(module *terraform.Module, resource *terraform.Block, definitionAttr *terraform.Attribute) sfn.Definition {
+	if !definitionAttr.IsString() {
+		return sfn.UnresolvableDefinition(definitionAttr.GetMetadata())
+	}
+
+	content := definitionAttr.Value().AsString()
+	definition, err := sfn.ParseDefinitionAt([]byte(content), definitionAttr.GetMetadata(), heredocLine(definitionAttr, content))
+	if err != nil {
+		module.Debug("Could not parse the definition of state machine '%s': %s", resource.FullName(), err)
+		return sfn.UnresolvableDefinition(definitionAttr.GetMetadata())
+	}
+	return definition
+}
+
+// heredocLine returns the line on which the content of the attribute starts when it is written out line for line as a
+// heredoc, or 0 when the content can't be located within the source
+func heredocLine(attr *terraform.Attribute, content string) int {
+	rng := attr.GetMetadata().Range()
+	// a heredoc has its opening and closing markers on the lines around the content, which ends with a newline
+	if rng.GetEndLine()-rng.GetStartLine() != strings.Count(content, "\n")+1 || !strings.HasSuffix(content, "\n") {
+		return 0
+	}
+	return rng.GetStartLine() + 1
+}
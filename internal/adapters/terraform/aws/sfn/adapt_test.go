@@ -0,0 +1,254 @@
+package sfn
+
+import (
+	"testing"
+
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/tftestutil"
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/sfn"
+	"github.com/aquasecurity/defsec/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Adapt(t *testing.T) {
+	tests := []struct {
+		name      string
+		terraform string
+		expected  sfn.SFN
+	}{
+		{
+			name: "configured",
+			terraform: `
+resource "aws_sfn_state_machine" "example" {
+  name     = "example"
+  role_arn = "arn:aws:iam::123456789012:role/example"
+
+  definition = <<EOF
+{
+  "StartAt": "Fetch",
+  "States": {
+    "Fetch": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:us-east-1:123456789012:function:fetch",
+      "Next": "Process"
+    },
+    "Process": {
+      "Type": "Parallel",
+      "Branches": [
+        {
+          "StartAt": "Store",
+          "States": {
+            "Store": {
+              "Type": "Task",
+              "Resource": "arn:aws:states:::dynamodb:putItem",
+              "End": true
+            }
+          }
+        }
+      ],
+      "End": true
+    }
+  }
+}
+EOF
+
+  logging_configuration {
+    level                  = "ALL"
+    include_execution_data = true
+  }
+
+  tracing_configuration {
+    enabled = true
+  }
+}
+`,
+			expected: sfn.SFN{
+				StateMachines: []sfn.StateMachine{
+					{
+						Metadata: types.NewTestMetadata(),
+						Name:     types.String("example", types.NewTestMetadata()),
+						Logging: sfn.Logging{
+							Metadata:             types.NewTestMetadata(),
+							Level:                types.String("ALL", types.NewTestMetadata()),
+							IncludeExecutionData: types.Bool(true, types.NewTestMetadata()),
+						},
+						Tracing: sfn.Tracing{
+							Metadata: types.NewTestMetadata(),
+							Enabled:  types.Bool(true, types.NewTestMetadata()),
+						},
+						Definition: sfn.Definition{
+							Metadata: types.NewTestMetadata(),
+							Comment:  types.String("", types.NewTestMetadata()),
+							StartAt:  types.String("Fetch", types.NewTestMetadata()),
+							States: []sfn.State{
+								{
+									Metadata: types.NewTestMetadata(),
+									Name:     types.String("Fetch", types.NewTestMetadata()),
+									Type:     types.String("Task", types.NewTestMetadata()),
+									Resource: types.String("arn:aws:lambda:us-east-1:123456789012:function:fetch", types.NewTestMetadata()),
+								},
+								{
+									Metadata: types.NewTestMetadata(),
+									Name:     types.String("Process", types.NewTestMetadata()),
+									Type:     types.String("Parallel", types.NewTestMetadata()),
+									Resource: types.String("", types.NewTestMetadata()),
+									Branches: []sfn.Definition{
+										{
+											Metadata: types.NewTestMetadata(),
+											Comment:  types.String("", types.NewTestMetadata()),
+											StartAt:  types.String("Store", types.NewTestMetadata()),
+											States: []sfn.State{
+												{
+													Metadata: types.NewTestMetadata(),
+													Name:     types.String("Store", types.NewTestMetadata()),
+													Type:     types.String("Task", types.NewTestMetadata()),
+													Resource: types.String("arn:aws:states:::dynamodb:putItem", types.NewTestMetadata()),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
+					},
+				},
+			},
+		},
+		{
+			name: "defaults",
+			terraform: `
+resource "aws_sfn_state_machine" "example" {
+}
+`,
+			expected: sfn.SFN{
+				StateMachines: []sfn.StateMachine{
+					{
+						Metadata: types.NewTestMetadata(),
+						Name:     types.String("", types.NewTestMetadata()),
+						Logging: sfn.Logging{
+							Metadata:             types.NewTestMetadata(),
+							Level:                types.String("OFF", types.NewTestMetadata()),
+							IncludeExecutionData: types.Bool(false, types.NewTestMetadata()),
+						},
+						Tracing: sfn.Tracing{
+							Metadata: types.NewTestMetadata(),
+							Enabled:  types.Bool(false, types.NewTestMetadata()),
+						},
+						Definition: sfn.Definition{
+							Metadata: types.NewTestMetadata(),
+							Comment:  types.String("", types.NewTestMetadata()),
+							StartAt:  types.String("", types.NewTestMetadata()),
+						},
+						Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
+			adapted := Adapt(modules)
+			testutil.AssertDefsecEqual(t, test.expected, adapted)
+		})
+	}
+}
+
+func Test_AdaptRolePolicies(t *testing.T) {
+	src := `
+resource "aws_iam_role" "example" {
+  name = "example"
+
+  inline_policy {
+    name   = "everything"
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [
+        {
+          Effect   = "Allow"
+          Action   = "*"
+          Resource = "*"
+        },
+      ]
+    })
+  }
+}
+
+resource "aws_sfn_state_machine" "example" {
+  name       = "example"
+  role_arn   = aws_iam_role.example.arn
+  definition = jsonencode({
+    StartAt = "Fetch"
+    States = {
+      Fetch = {
+        Type     = "Task"
+        Resource = "arn:aws:lambda:us-east-1:123456789012:function:*"
+        End      = true
+      }
+    }
+  })
+}
+`
+
+	modules := tftestutil.CreateModulesFromSource(t, src, ".tf")
+	adapted := Adapt(modules)
+
+	require.Len(t, adapted.StateMachines, 1)
+	stateMachine := adapted.StateMachines[0]
+
+	require.Len(t, stateMachine.Policies, 1)
+	assert.Equal(t, "everything", stateMachine.Policies[0].Name.Value())
+
+	states := stateMachine.Definition.AllStates()
+	require.Len(t, states, 1)
+	assert.Equal(t, "arn:aws:lambda:us-east-1:123456789012:function:*", states[0].Resource.Value())
+	assert.Equal(t, 23, states[0].Resource.GetMetadata().Range().GetStartLine())
+}
+
+func Test_AdaptDefinitionLocations(t *testing.T) {
+	src := `
+resource "aws_sfn_state_machine" "example" {
+  definition = <<EOF
+{
+  "StartAt": "Fetch",
+  "States": {
+    "Fetch": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:us-east-1:123456789012:function:fetch",
+      "End": true
+    }
+  }
+}
+EOF
+}
+`
+
+	modules := tftestutil.CreateModulesFromSource(t, src, ".tf")
+	adapted := Adapt(modules)
+
+	require.Len(t, adapted.StateMachines, 1)
+	states := adapted.StateMachines[0].Definition.AllStates()
+	require.Len(t, states, 1)
+	assert.Equal(t, 7, states[0].Resource.GetMetadata().Range().GetStartLine())
+	assert.Equal(t, 11, states[0].Resource.GetMetadata().Range().GetEndLine())
+}
+
+func Test_AdaptInvalidDefinition(t *testing.T) {
+	src := `
+resource "aws_sfn_state_machine" "example" {
+  definition = "{ not json"
+}
+`
+
+	modules := tftestutil.CreateModulesFromSource(t, src, ".tf")
+	adapted := Adapt(modules)
+
+	require.Len(t, adapted.StateMachines, 1)
+	definition := adapted.StateMachines[0].Definition
+	assert.False(t, definition.Metadata.IsResolvable())
+	assert.False(t, definition.StartAt.GetMetadata().IsResolvable())
+	assert.Empty(t, definition.States)
+}
@@ -1,6 +1,7 @@
 package sns
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/sns"
 	"github.com/aquasecurity/defsec/pkg/terraform"
 )
@@ -15,16 +16,17 @@ func adaptTopics(modules terraform.Modules) []sns.Topic {
 	var topics []sns.Topic
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_sns_topic") {
-			topics = append(topics, adaptTopic(resource))
+			topics = append(topics, adaptTopic(resource, modules))
 		}
 	}
 	return topics
 }
 
-func adaptTopic(resourceBlock *terraform.Block) sns.Topic {
+func adaptTopic(resourceBlock *terraform.Block, modules terraform.Modules) sns.Topic {
 	return sns.Topic{
 		Metadata:   resourceBlock.GetMetadata(),
 		Encryption: adaptEncryption(resourceBlock),
+		Tags:       tags.Resolve(modules, resourceBlock),
 	}
 }
 
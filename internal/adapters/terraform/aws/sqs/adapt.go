@@ -2,6 +2,7 @@ package sqs
 
 import (
 	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/iam"
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	iamp "github.com/aquasecurity/defsec/pkg/providers/aws/iam"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/sqs"
@@ -73,6 +74,7 @@ func (a *adapter) adaptQueues() []sqs.Queue {
 				KMSKeyID:          types.StringDefault("", types.NewUnmanagedMetadata()),
 			},
 			Policies: []iamp.Policy{policy},
+			Tags:     types.MapDefault(make(map[string]string), types.NewUnmanagedMetadata()),
 		}
 	}
 
@@ -124,5 +126,6 @@ func (a *adapter) adaptQueue(resource *terraform.Block) {
 			KMSKeyID:          kmsKeyIdVal,
 		},
 		Policies: policies,
+		Tags:     tags.Resolve(a.modules, resource),
 	}
 }
@@ -68,6 +68,7 @@ func Test_Adapt(t *testing.T) {
 								},
 							}
 						}(),
+						Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 			},
@@ -87,6 +88,7 @@ func Test_Adapt(t *testing.T) {
 							ManagedEncryption: types.Bool(false, types.NewTestMetadata()),
 							KMSKeyID:          types.String("/blah", types.NewTestMetadata()),
 						},
+						Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 			},
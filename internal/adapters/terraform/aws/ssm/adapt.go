@@ -1,6 +1,7 @@
 package ssm
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/ssm"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -16,13 +17,13 @@ func adaptSecrets(modules terraform.Modules) []ssm.Secret {
 	var secrets []ssm.Secret
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_secretsmanager_secret") {
-			secrets = append(secrets, adaptSecret(resource, module))
+			secrets = append(secrets, adaptSecret(resource, module, modules))
 		}
 	}
 	return secrets
 }
 
-func adaptSecret(resource *terraform.Block, module *terraform.Module) ssm.Secret {
+func adaptSecret(resource *terraform.Block, module *terraform.Module, modules terraform.Modules) ssm.Secret {
 	KMSKeyIDAttr := resource.GetAttribute("kms_key_id")
 	KMSKeyIDVal := KMSKeyIDAttr.AsStringValueOrDefault("alias/aws/secretsmanager", resource)
 
@@ -36,5 +37,6 @@ func adaptSecret(resource *terraform.Block, module *terraform.Module) ssm.Secret
 	return ssm.Secret{
 		Metadata: resource.GetMetadata(),
 		KMSKeyID: KMSKeyIDVal,
+		Tags:     tags.Resolve(modules, resource),
 	}
 }
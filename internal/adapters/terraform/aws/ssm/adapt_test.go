@@ -37,6 +37,7 @@ func Test_Adapt(t *testing.T) {
 					{
 						Metadata: types.NewTestMetadata(),
 						KMSKeyID: types.String("aws_kms_key.secrets", types.NewTestMetadata()),
+						Tags:     types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 			},
@@ -54,6 +55,7 @@ func Test_Adapt(t *testing.T) {
 					{
 						Metadata: types.NewTestMetadata(),
 						KMSKeyID: types.String("key_id", types.NewTestMetadata()),
+						Tags:     types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 			},
@@ -69,6 +71,7 @@ func Test_Adapt(t *testing.T) {
 					{
 						Metadata: types.NewTestMetadata(),
 						KMSKeyID: types.String("alias/aws/secretsmanager", types.NewTestMetadata()),
+						Tags:     types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 			},
@@ -0,0 +1,58 @@
+package tags
+
+import (
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/terraform"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+// Resolve returns the tags which will be applied to an AWS resource. These are the default_tags of the provider
+// configuration used by the resource, overridden by the resource's tags_all and then its own tags.
+func Resolve(modules terraform.Modules, resource *terraform.Block) types.MapValue {
+	merged := make(map[string]string)
+
+	var defaults bool
+	if provider := modules.GetProviderBlock(resource); provider != nil {
+		if attr := provider.GetBlock("default_tags").GetAttribute("tags"); attr.IsNotNil() {
+			defaults = mergeAttribute(merged, attr) > 0
+		}
+	}
+
+	var tagsAttr *terraform.Attribute
+	for _, name := range []string{"tags_all", "tags"} {
+		if attr := resource.GetAttribute(name); attr.IsNotNil() {
+			mergeAttribute(merged, attr)
+			tagsAttr = attr
+		}
+	}
+
+	switch {
+	case tagsAttr != nil:
+		return types.Map(merged, tagsAttr.GetMetadata())
+	case defaults:
+		return types.Map(merged, resource.GetMetadata())
+	default:
+		return types.MapDefault(merged, resource.GetMetadata())
+	}
+}
+
+func mergeAttribute(tags map[string]string, attr *terraform.Attribute) int {
+	var count int
+	_ = attr.Each(func(key, val cty.Value) {
+		if !key.IsKnown() || key.IsNull() || key.Type() != cty.String {
+			return
+		}
+		if !val.IsWhollyKnown() || val.IsNull() {
+			return
+		}
+		str, err := convert.Convert(val, cty.String)
+		if err != nil {
+			return
+		}
+		tags[key.AsString()] = str.AsString()
+		count++
+	})
+	return count
+}
@@ -0,0 +1,207 @@
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aquasecurity/defsec/pkg/scanners/terraform/parser"
+	"github.com/aquasecurity/defsec/pkg/terraform"
+	"github.com/aquasecurity/defsec/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseModules(t *testing.T, files map[string]string) terraform.Modules {
+	p := parser.New(testutil.CreateFS(t, files), "", parser.OptionStopOnHCLError(true))
+	require.NoError(t, p.ParseFS(context.TODO(), "code"))
+	modules, _, err := p.EvaluateAll(context.TODO())
+	require.NoError(t, err)
+	return modules
+}
+
+func resolveByName(t *testing.T, modules terraform.Modules) map[string]map[string]string {
+	resolved := make(map[string]map[string]string)
+	for _, block := range modules.GetBlocks() {
+		if block.Type() == "resource" {
+			resolved[block.FullName()] = Resolve(modules, block).Value()
+		}
+	}
+	return resolved
+}
+
+func Test_Resolve(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected map[string]map[string]string
+	}{
+		{
+			name: "resource tags override provider default tags",
+			files: map[string]string{
+				"code/main.tf": `
+provider "aws" {
+	default_tags {
+		tags = {
+			owner       = "platform"
+			cost-centre = 1234
+		}
+	}
+}
+
+resource "aws_s3_bucket" "defaults" {
+}
+
+resource "aws_s3_bucket" "overridden" {
+	tags = {
+		owner = "security"
+		data-classification = "restricted"
+	}
+}
+`,
+			},
+			expected: map[string]map[string]string{
+				"aws_s3_bucket.defaults": {
+					"owner":       "platform",
+					"cost-centre": "1234",
+				},
+				"aws_s3_bucket.overridden": {
+					"owner":               "security",
+					"cost-centre":         "1234",
+					"data-classification": "restricted",
+				},
+			},
+		},
+		{
+			name: "aliased providers",
+			files: map[string]string{
+				"code/main.tf": `
+provider "aws" {
+	default_tags {
+		tags = { region = "default" }
+	}
+}
+
+provider "aws" {
+	alias = "west"
+	default_tags {
+		tags = { region = "west" }
+	}
+}
+
+resource "aws_sqs_queue" "default" {
+}
+
+resource "aws_sqs_queue" "west" {
+	provider = aws.west
+}
+`,
+			},
+			expected: map[string]map[string]string{
+				"aws_sqs_queue.default": {"region": "default"},
+				"aws_sqs_queue.west":    {"region": "west"},
+			},
+		},
+		{
+			name: "modules inherit default providers and receive passed providers",
+			files: map[string]string{
+				"code/main.tf": `
+provider "aws" {
+	default_tags {
+		tags = { owner = "root" }
+	}
+}
+
+provider "aws" {
+	alias = "audit"
+	default_tags {
+		tags = { owner = "audit" }
+	}
+}
+
+module "implicit" {
+	source = "./modules/queue"
+}
+
+module "explicit" {
+	source = "./modules/queue"
+	providers = {
+		aws = aws.audit
+	}
+}
+`,
+				"code/modules/queue/main.tf": `
+resource "aws_sqs_queue" "this" {
+	tags = { module = "queue" }
+}
+`,
+			},
+			expected: map[string]map[string]string{
+				"module.implicit.aws_sqs_queue.this": {"owner": "root", "module": "queue"},
+				"module.explicit.aws_sqs_queue.this": {"owner": "audit", "module": "queue"},
+			},
+		},
+		{
+			name: "no tags",
+			files: map[string]string{
+				"code/main.tf": `
+provider "aws" {
+	region = "eu-west-1"
+}
+
+resource "aws_sns_topic" "untagged" {
+}
+`,
+			},
+			expected: map[string]map[string]string{
+				"aws_sns_topic.untagged": {},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			modules := parseModules(t, test.files)
+			assert.Equal(t, test.expected, resolveByName(t, modules))
+		})
+	}
+}
+
+func Test_ResolveMetadata(t *testing.T) {
+	modules := parseModules(t, map[string]string{
+		"code/main.tf": `
+provider "aws" {
+	default_tags {
+		tags = { owner = "platform" }
+	}
+}
+
+resource "aws_s3_bucket" "defaults" {
+}
+
+resource "aws_s3_bucket" "tagged" {
+	tags = { owner = "security" }
+}
+
+resource "aws_kms_key" "untagged" {
+	provider = aws.missing
+}
+`,
+	})
+
+	for _, block := range modules.GetBlocks() {
+		if block.Type() != "resource" {
+			continue
+		}
+		tags := Resolve(modules, block)
+		switch block.FullName() {
+		case "aws_s3_bucket.defaults":
+			assert.Equal(t, 8, tags.GetMetadata().Range().GetStartLine())
+			assert.False(t, tags.GetMetadata().IsDefault())
+		case "aws_s3_bucket.tagged":
+			assert.Equal(t, 12, tags.GetMetadata().Range().GetStartLine())
+		case "aws_kms_key.untagged":
+			assert.Equal(t, 0, tags.Len())
+			assert.True(t, tags.GetMetadata().IsDefault())
+		}
+	}
+}
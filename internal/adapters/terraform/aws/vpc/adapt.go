@@ -1,6 +1,7 @@
 package vpc
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/vpc"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -33,6 +34,7 @@ func adaptDefaultVPCs(modules terraform.Modules) []vpc.DefaultVPC {
 		for _, resource := range module.GetResourcesByType("aws_default_vpc") {
 			defaultVPCs = append(defaultVPCs, vpc.DefaultVPC{
 				Metadata: resource.GetMetadata(),
+				Tags:     tags.Resolve(modules, resource),
 			})
 		}
 	}
@@ -51,6 +53,7 @@ func (a *sgAdapter) adaptSecurityGroups(modules terraform.Modules) []vpc.Securit
 			Description:  types.StringDefault("", types.NewUnmanagedMetadata()),
 			IngressRules: nil,
 			EgressRules:  nil,
+			Tags:         types.MapDefault(make(map[string]string), types.NewUnmanagedMetadata()),
 		}
 		for _, sgRule := range orphanResources {
 			if sgRule.GetAttribute("type").Equals("ingress") {
@@ -69,7 +72,7 @@ func (a *naclAdapter) adaptNetworkACLs(modules terraform.Modules) []vpc.NetworkA
 	var networkACLs []vpc.NetworkACL
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_network_acl") {
-			networkACLs = append(networkACLs, a.adaptNetworkACL(resource, module))
+			networkACLs = append(networkACLs, a.adaptNetworkACL(resource, module, modules))
 		}
 	}
 
@@ -78,6 +81,7 @@ func (a *naclAdapter) adaptNetworkACLs(modules terraform.Modules) []vpc.NetworkA
 		orphanage := vpc.NetworkACL{
 			Metadata: types.NewUnmanagedMetadata(),
 			Rules:    nil,
+			Tags:     types.MapDefault(make(map[string]string), types.NewUnmanagedMetadata()),
 		}
 		for _, naclRule := range orphanResources {
 			orphanage.Rules = append(orphanage.Rules, adaptNetworkACLRule(naclRule))
@@ -120,6 +124,7 @@ func (a *sgAdapter) adaptSecurityGroup(resource *terraform.Block, module terrafo
 		Description:  descriptionVal,
 		IngressRules: ingressRules,
 		EgressRules:  egressRules,
+		Tags:         tags.Resolve(module, resource),
 	}
 }
 
@@ -157,7 +162,7 @@ func adaptSGRule(resource *terraform.Block, modules terraform.Modules) vpc.Secur
 	}
 }
 
-func (a *naclAdapter) adaptNetworkACL(resource *terraform.Block, module *terraform.Module) vpc.NetworkACL {
+func (a *naclAdapter) adaptNetworkACL(resource *terraform.Block, module *terraform.Module, modules terraform.Modules) vpc.NetworkACL {
 	var networkRules []vpc.NetworkACLRule
 	rulesBlocks := module.GetReferencingResources(resource, "aws_network_acl_rule", "network_acl_id")
 	for _, ruleBlock := range rulesBlocks {
@@ -167,6 +172,7 @@ func (a *naclAdapter) adaptNetworkACL(resource *terraform.Block, module *terrafo
 	return vpc.NetworkACL{
 		Metadata: resource.GetMetadata(),
 		Rules:    networkRules,
+		Tags:     tags.Resolve(modules, resource),
 	}
 }
 
@@ -75,6 +75,9 @@ func Test_Adapt(t *testing.T) {
 				DefaultVPCs: []vpc.DefaultVPC{
 					{
 						Metadata: types.NewTestMetadata(),
+						Tags: types.Map(map[string]string{
+							"Name": "Default VPC",
+						}, types.NewTestMetadata()),
 					},
 				},
 				SecurityGroups: []vpc.SecurityGroup{
@@ -110,6 +113,7 @@ func Test_Adapt(t *testing.T) {
 								},
 							},
 						},
+						Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 				NetworkACLs: []vpc.NetworkACL{
@@ -126,6 +130,7 @@ func Test_Adapt(t *testing.T) {
 								},
 							},
 						},
+						Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 			},
@@ -162,6 +167,7 @@ func Test_Adapt(t *testing.T) {
 								Description: types.String("", types.NewTestMetadata()),
 							},
 						},
+						Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 				NetworkACLs: []vpc.NetworkACL{
@@ -176,6 +182,7 @@ func Test_Adapt(t *testing.T) {
 								Protocol: types.String("-1", types.NewTestMetadata()),
 							},
 						},
+						Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 					},
 				},
 			},
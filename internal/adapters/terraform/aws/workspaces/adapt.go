@@ -1,6 +1,7 @@
 package workspaces
 
 import (
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/aws/tags"
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/workspaces"
 	"github.com/aquasecurity/defsec/pkg/terraform"
@@ -16,13 +17,13 @@ func adaptWorkspaces(modules terraform.Modules) []workspaces.WorkSpace {
 	var workspaces []workspaces.WorkSpace
 	for _, module := range modules {
 		for _, resource := range module.GetResourcesByType("aws_workspaces_workspace") {
-			workspaces = append(workspaces, adaptWorkspace(resource))
+			workspaces = append(workspaces, adaptWorkspace(resource, modules))
 		}
 	}
 	return workspaces
 }
 
-func adaptWorkspace(resource *terraform.Block) workspaces.WorkSpace {
+func adaptWorkspace(resource *terraform.Block, modules terraform.Modules) workspaces.WorkSpace {
 
 	workspace := workspaces.WorkSpace{
 		Metadata: resource.GetMetadata(),
@@ -40,6 +41,7 @@ func adaptWorkspace(resource *terraform.Block) workspaces.WorkSpace {
 				Enabled:  types.BoolDefault(false, resource.GetMetadata()),
 			},
 		},
+		Tags: tags.Resolve(modules, resource),
 	}
 	if rootVolumeEncryptAttr := resource.GetAttribute("root_volume_encryption_enabled"); rootVolumeEncryptAttr.IsNotNil() {
 		workspace.RootVolume.Metadata = rootVolumeEncryptAttr.GetMetadata()
@@ -44,6 +44,7 @@ func Test_adaptWorkspace(t *testing.T) {
 						Enabled:  types.Bool(true, types.NewTestMetadata()),
 					},
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 		{
@@ -68,6 +69,7 @@ func Test_adaptWorkspace(t *testing.T) {
 						Enabled:  types.Bool(false, types.NewTestMetadata()),
 					},
 				},
+				Tags: types.Map(map[string]string{}, types.NewTestMetadata()),
 			},
 		},
 	}
@@ -75,7 +77,7 @@ func Test_adaptWorkspace(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			modules := tftestutil.CreateModulesFromSource(t, test.terraform, ".tf")
-			adapted := adaptWorkspace(modules.GetBlocks()[0])
+			adapted := adaptWorkspace(modules.GetBlocks()[0], modules)
 			testutil.AssertDefsecEqual(t, test.expected, adapted)
 		})
 	}
@@ -81,7 +81,7 @@ func adaptInstance(resource *terraform.Block) sql.DatabaseInstance {
 	return instance
 }
 
-//nolint
+// nolint
 func adaptFlags(resources terraform.Blocks, flags *sql.Flags) {
 	for _, resource := range resources {
 
@@ -8,6 +8,12 @@ import (
 func Adapt(modules terraform.Modules) kubernetes.Kubernetes {
 	return kubernetes.Kubernetes{
 		NetworkPolicies: adaptNetworkPolicies(modules),
+		Workloads:       adaptWorkloads(modules),
+		Services:        adaptServices(modules),
+		Ingresses:       adaptIngresses(modules),
+		Roles:           adaptRoles(modules),
+		RoleBindings:    adaptRoleBindings(modules),
+		ServiceAccounts: adaptServiceAccounts(modules),
 	}
 }
 
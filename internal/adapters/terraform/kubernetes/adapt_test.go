@@ -0,0 +1,256 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/aquasecurity/defsec/internal/adapters/terraform/tftestutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AdaptWorkloads(t *testing.T) {
+
+	src := `
+resource "kubernetes_deployment" "web" {
+  metadata {
+    name = "web"
+    labels = {
+      app = "web"
+    }
+  }
+  spec {
+    replicas = 3
+    selector {
+      match_labels = {
+        app = "web"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          app = "web"
+        }
+      }
+      spec {
+        host_network = true
+        container {
+          name  = "nginx"
+          image = "nginx:1.23"
+          port {
+            container_port = 80
+          }
+          security_context {
+            privileged = true
+            capabilities {
+              add = ["NET_ADMIN"]
+            }
+          }
+          resources {
+            limits = {
+              cpu = "500m"
+            }
+          }
+        }
+        volume {
+          name = "host"
+          host_path {
+            path = "/var/run"
+          }
+        }
+      }
+    }
+  }
+}
+
+resource "kubernetes_cron_job_v1" "cleanup" {
+  metadata {
+    name      = "cleanup"
+    namespace = "jobs"
+  }
+  spec {
+    schedule = "0 * * * *"
+    job_template {
+      metadata {}
+      spec {
+        template {
+          metadata {}
+          spec {
+            service_account_name = "cleaner"
+            container {
+              name  = "cleanup"
+              image = "busybox"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`
+	modules := tftestutil.CreateModulesFromSource(t, src, ".tf")
+	workloads := Adapt(modules).Workloads
+	require.Len(t, workloads, 2)
+
+	deployment := workloads[0]
+	assert.Equal(t, "Deployment", deployment.Kind.Value())
+	assert.Equal(t, "web", deployment.Name.Value())
+	assert.Equal(t, "default", deployment.Namespace.Value())
+	assert.Equal(t, 3, deployment.Replicas.Value())
+	assert.Equal(t, map[string]string{"app": "web"}, deployment.Selector.Value())
+
+	pod := deployment.Pod
+	assert.Equal(t, map[string]string{"app": "web"}, pod.Labels.Value())
+	assert.True(t, pod.HostNetwork.IsTrue())
+	assert.Equal(t, 23, pod.HostNetwork.GetMetadata().Range().GetStartLine())
+	assert.True(t, pod.AutomountServiceAccountToken.IsTrue())
+
+	require.Len(t, pod.Containers, 1)
+	container := pod.Containers[0]
+	assert.Equal(t, "nginx:1.23", container.Image.Value())
+	assert.True(t, container.SecurityContext.Privileged.IsTrue())
+	assert.Equal(t, 31, container.SecurityContext.Privileged.GetMetadata().Range().GetStartLine())
+	require.Len(t, container.SecurityContext.Capabilities.Add, 1)
+	assert.Equal(t, "NET_ADMIN", container.SecurityContext.Capabilities.Add[0].Value())
+	assert.Equal(t, map[string]string{"cpu": "500m"}, container.Resources.Limits.Value())
+	require.Len(t, container.Ports, 1)
+	assert.Equal(t, 80, container.Ports[0].ContainerPort.Value())
+
+	require.Len(t, pod.Volumes, 1)
+	assert.Equal(t, "hostPath", pod.Volumes[0].Type.Value())
+	assert.Equal(t, "/var/run", pod.Volumes[0].HostPath.Value())
+
+	cronJob := workloads[1]
+	assert.Equal(t, "CronJob", cronJob.Kind.Value())
+	assert.Equal(t, "jobs", cronJob.Namespace.Value())
+	assert.Equal(t, "cleaner", cronJob.Pod.ServiceAccountName.Value())
+	require.Len(t, cronJob.Pod.Containers, 1)
+	assert.Equal(t, "busybox", cronJob.Pod.Containers[0].Image.Value())
+}
+
+func Test_AdaptServicesAndIngresses(t *testing.T) {
+
+	src := `
+resource "kubernetes_service_v1" "web" {
+  metadata {
+    name = "web"
+  }
+  spec {
+    type = "LoadBalancer"
+    selector = {
+      app = "web"
+    }
+    port {
+      port        = 443
+      target_port = "https"
+    }
+  }
+}
+
+resource "kubernetes_ingress_v1" "web" {
+  metadata {
+    name = "web"
+  }
+  spec {
+    rule {
+      host = "example.com"
+      http {
+        path {
+          path = "/"
+          backend {
+            service {
+              name = "web"
+              port {
+                number = 443
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`
+	modules := tftestutil.CreateModulesFromSource(t, src, ".tf")
+	k8s := Adapt(modules)
+
+	require.Len(t, k8s.Services, 1)
+	service := k8s.Services[0]
+	assert.Equal(t, "LoadBalancer", service.Type.Value())
+	assert.Equal(t, map[string]string{"app": "web"}, service.Selector.Value())
+	require.Len(t, service.Ports, 1)
+	assert.Equal(t, 443, service.Ports[0].Port.Value())
+	assert.Equal(t, "https", service.Ports[0].TargetPort.Value())
+
+	require.Len(t, k8s.Ingresses, 1)
+	ingress := k8s.Ingresses[0]
+	assert.Empty(t, ingress.TLS)
+	require.Len(t, ingress.Rules, 1)
+	assert.Equal(t, "example.com", ingress.Rules[0].Host.Value())
+	require.Len(t, ingress.Rules[0].Paths, 1)
+	assert.Equal(t, "web", ingress.Rules[0].Paths[0].ServiceName.Value())
+	assert.Equal(t, "443", ingress.Rules[0].Paths[0].ServicePort.Value())
+}
+
+func Test_AdaptRBAC(t *testing.T) {
+
+	src := `
+resource "kubernetes_cluster_role" "reader" {
+  metadata {
+    name = "reader"
+  }
+  rule {
+    api_groups = [""]
+    resources  = ["secrets"]
+    verbs      = ["get", "list"]
+  }
+}
+
+resource "kubernetes_cluster_role_binding" "read_secrets" {
+  metadata {
+    name = "read-secrets"
+  }
+  role_ref {
+    api_group = "rbac.authorization.k8s.io"
+    kind      = "ClusterRole"
+    name      = "reader"
+  }
+  subject {
+    kind      = "ServiceAccount"
+    name      = "default"
+    namespace = "kube-system"
+  }
+}
+
+resource "kubernetes_service_account" "builder" {
+  metadata {
+    name      = "builder"
+    namespace = "ci"
+  }
+  automount_service_account_token = false
+}
+`
+	modules := tftestutil.CreateModulesFromSource(t, src, ".tf")
+	k8s := Adapt(modules)
+
+	require.Len(t, k8s.Roles, 1)
+	role := k8s.Roles[0]
+	assert.Equal(t, "ClusterRole", role.Kind.Value())
+	assert.Equal(t, "", role.Namespace.Value())
+	require.Len(t, role.Rules, 1)
+	assert.Equal(t, "secrets", role.Rules[0].Resources[0].Value())
+	assert.Len(t, role.Rules[0].Verbs, 2)
+	assert.Equal(t, 6, role.Rules[0].Metadata.Range().GetStartLine())
+
+	require.Len(t, k8s.RoleBindings, 1)
+	binding := k8s.RoleBindings[0]
+	assert.Equal(t, "ClusterRoleBinding", binding.Kind.Value())
+	assert.Equal(t, "reader", binding.RoleRef.Name.Value())
+	require.Len(t, binding.Subjects, 1)
+	assert.Equal(t, "ServiceAccount", binding.Subjects[0].Kind.Value())
+	assert.Equal(t, "kube-system", binding.Subjects[0].Namespace.Value())
+
+	require.Len(t, k8s.ServiceAccounts, 1)
+	assert.Equal(t, "ci", k8s.ServiceAccounts[0].Namespace.Value())
+	assert.False(t, k8s.ServiceAccounts[0].AutomountServiceAccountToken.IsTrue())
+}
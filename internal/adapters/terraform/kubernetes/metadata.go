@@ -0,0 +1,101 @@
+package kubernetes
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/terraform"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+// objectMeta is the metadata block shared by all kubernetes_* resources
+type objectMeta struct {
+	name        types.StringValue
+	namespace   types.StringValue
+	labels      types.MapValue
+	annotations types.MapValue
+}
+
+// adaptObjectMeta reads the metadata block of the resource. The namespace of namespaced resources defaults to
+// "default", as it does in the provider.
+func adaptObjectMeta(resource *terraform.Block, namespaced bool) objectMeta {
+	defaultNamespace := ""
+	if namespaced {
+		defaultNamespace = "default"
+	}
+	metadataBlock := resource.GetBlock("metadata")
+	if metadataBlock.IsNil() {
+		return objectMeta{
+			name:        types.StringDefault("", resource.GetMetadata()),
+			namespace:   types.StringDefault(defaultNamespace, resource.GetMetadata()),
+			labels:      types.MapDefault(make(map[string]string), resource.GetMetadata()),
+			annotations: types.MapDefault(make(map[string]string), resource.GetMetadata()),
+		}
+	}
+	return objectMeta{
+		name:        metadataBlock.GetAttribute("name").AsStringValueOrDefault("", metadataBlock),
+		namespace:   metadataBlock.GetAttribute("namespace").AsStringValueOrDefault(defaultNamespace, metadataBlock),
+		labels:      adaptMap(metadataBlock.GetAttribute("labels"), metadataBlock),
+		annotations: adaptMap(metadataBlock.GetAttribute("annotations"), metadataBlock),
+	}
+}
+
+// adaptMap reads a map of strings, such as labels or a label selector
+func adaptMap(attr *terraform.Attribute, parent *terraform.Block) types.MapValue {
+	values := make(map[string]string)
+	if attr.IsNil() {
+		return types.MapDefault(values, parent.GetMetadata())
+	}
+	_ = attr.Each(func(key, val cty.Value) {
+		if !key.IsKnown() || key.IsNull() || key.Type() != cty.String {
+			return
+		}
+		if !val.IsWhollyKnown() || val.IsNull() {
+			return
+		}
+		str, err := convert.Convert(val, cty.String)
+		if err != nil {
+			return
+		}
+		values[key.AsString()] = str.AsString()
+	})
+	return types.MapExplicit(values, attr.GetMetadata())
+}
+
+// adaptInt reads a number, which the provider sometimes declares as a string, e.g. replicas
+func adaptInt(attr *terraform.Attribute, defaultValue int, parent *terraform.Block) types.IntValue {
+	if attr.IsNotNil() && attr.IsString() {
+		if value, err := strconv.Atoi(attr.Value().AsString()); err == nil {
+			return types.IntExplicit(value, attr.GetMetadata())
+		}
+	}
+	return attr.AsIntValueOrDefault(defaultValue, parent)
+}
+
+// adaptIntOrString reads a port which can be a number or a name, e.g. target_port
+func adaptIntOrString(attr *terraform.Attribute, parent *terraform.Block) types.StringValue {
+	if attr.IsNotNil() && attr.IsNumber() {
+		return types.StringExplicit(attr.Value().AsBigFloat().String(), attr.GetMetadata())
+	}
+	return attr.AsStringValueOrDefault("", parent)
+}
+
+// manifestName converts the name of a block to the name of the field in a manifest, e.g. host_path to hostPath
+func manifestName(blockName string) string {
+	switch blockName {
+	case "downward_api":
+		return "downwardAPI"
+	case "ceph_fs":
+		return "cephfs"
+	}
+	parts := strings.Split(blockName, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
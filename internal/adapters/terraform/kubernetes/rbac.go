@@ -0,0 +1,119 @@
+package kubernetes
+
+import (
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/providers/kubernetes"
+	"github.com/aquasecurity/defsec/pkg/terraform"
+)
+
+func adaptRoles(modules terraform.Modules) []kubernetes.Role {
+	var roles []kubernetes.Role
+	for _, module := range modules {
+		for _, roleResource := range []struct {
+			resourceType string
+			kind         string
+		}{
+			{"kubernetes_role", "Role"},
+			{"kubernetes_role_v1", "Role"},
+			{"kubernetes_cluster_role", "ClusterRole"},
+			{"kubernetes_cluster_role_v1", "ClusterRole"},
+		} {
+			for _, resource := range module.GetResourcesByType(roleResource.resourceType) {
+				roles = append(roles, adaptRole(resource, roleResource.kind))
+			}
+		}
+	}
+	return roles
+}
+
+func adaptRole(resource *terraform.Block, kind string) kubernetes.Role {
+	meta := adaptObjectMeta(resource, kind == "Role")
+	role := kubernetes.Role{
+		Metadata:  resource.GetMetadata(),
+		Kind:      types.String(kind, resource.GetMetadata()),
+		Name:      meta.name,
+		Namespace: meta.namespace,
+	}
+	for _, ruleBlock := range resource.GetBlocks("rule") {
+		role.Rules = append(role.Rules, kubernetes.PolicyRule{
+			Metadata:      ruleBlock.GetMetadata(),
+			APIGroups:     ruleBlock.GetAttribute("api_groups").AsStringValueSliceOrEmpty(ruleBlock),
+			Resources:     ruleBlock.GetAttribute("resources").AsStringValueSliceOrEmpty(ruleBlock),
+			ResourceNames: ruleBlock.GetAttribute("resource_names").AsStringValueSliceOrEmpty(ruleBlock),
+			Verbs:         ruleBlock.GetAttribute("verbs").AsStringValueSliceOrEmpty(ruleBlock),
+		})
+	}
+	return role
+}
+
+func adaptRoleBindings(modules terraform.Modules) []kubernetes.RoleBinding {
+	var bindings []kubernetes.RoleBinding
+	for _, module := range modules {
+		for _, bindingResource := range []struct {
+			resourceType string
+			kind         string
+		}{
+			{"kubernetes_role_binding", "RoleBinding"},
+			{"kubernetes_role_binding_v1", "RoleBinding"},
+			{"kubernetes_cluster_role_binding", "ClusterRoleBinding"},
+			{"kubernetes_cluster_role_binding_v1", "ClusterRoleBinding"},
+		} {
+			for _, resource := range module.GetResourcesByType(bindingResource.resourceType) {
+				bindings = append(bindings, adaptRoleBinding(resource, bindingResource.kind))
+			}
+		}
+	}
+	return bindings
+}
+
+func adaptRoleBinding(resource *terraform.Block, kind string) kubernetes.RoleBinding {
+	meta := adaptObjectMeta(resource, kind == "RoleBinding")
+	binding := kubernetes.RoleBinding{
+		Metadata:  resource.GetMetadata(),
+		Kind:      types.String(kind, resource.GetMetadata()),
+		Name:      meta.name,
+		Namespace: meta.namespace,
+		RoleRef: kubernetes.RoleRef{
+			Metadata: resource.GetMetadata(),
+			APIGroup: types.StringDefault("", resource.GetMetadata()),
+			Kind:     types.StringDefault("", resource.GetMetadata()),
+			Name:     types.StringDefault("", resource.GetMetadata()),
+		},
+	}
+	if refBlock := resource.GetBlock("role_ref"); refBlock.IsNotNil() {
+		binding.RoleRef = kubernetes.RoleRef{
+			Metadata: refBlock.GetMetadata(),
+			APIGroup: refBlock.GetAttribute("api_group").AsStringValueOrDefault("", refBlock),
+			Kind:     refBlock.GetAttribute("kind").AsStringValueOrDefault("", refBlock),
+			Name:     refBlock.GetAttribute("name").AsStringValueOrDefault("", refBlock),
+		}
+	}
+	for _, subjectBlock := range resource.GetBlocks("subject") {
+		binding.Subjects = append(binding.Subjects, kubernetes.Subject{
+			Metadata:  subjectBlock.GetMetadata(),
+			Kind:      subjectBlock.GetAttribute("kind").AsStringValueOrDefault("", subjectBlock),
+			Name:      subjectBlock.GetAttribute("name").AsStringValueOrDefault("", subjectBlock),
+			Namespace: subjectBlock.GetAttribute("namespace").AsStringValueOrDefault("", subjectBlock),
+			APIGroup:  subjectBlock.GetAttribute("api_group").AsStringValueOrDefault("", subjectBlock),
+		})
+	}
+	return binding
+}
+
+func adaptServiceAccounts(modules terraform.Modules) []kubernetes.ServiceAccount {
+	var serviceAccounts []kubernetes.ServiceAccount
+	for _, module := range modules {
+		for _, resourceType := range []string{"kubernetes_service_account", "kubernetes_service_account_v1"} {
+			for _, resource := range module.GetResourcesByType(resourceType) {
+				meta := adaptObjectMeta(resource, true)
+				serviceAccounts = append(serviceAccounts, kubernetes.ServiceAccount{
+					Metadata:                     resource.GetMetadata(),
+					Name:                         meta.name,
+					Namespace:                    meta.namespace,
+					AutomountServiceAccountToken: resource.GetAttribute("automount_service_account_token").AsBoolValueOrDefault(true, resource),
+				})
+			}
+		}
+	}
+	return serviceAccounts
+}
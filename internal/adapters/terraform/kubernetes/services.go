@@ -0,0 +1,120 @@
+package kubernetes
+
+import (
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/providers/kubernetes"
+	"github.com/aquasecurity/defsec/pkg/terraform"
+)
+
+func adaptServices(modules terraform.Modules) []kubernetes.Service {
+	var services []kubernetes.Service
+	for _, module := range modules {
+		for _, resourceType := range []string{"kubernetes_service", "kubernetes_service_v1"} {
+			for _, resource := range module.GetResourcesByType(resourceType) {
+				services = append(services, adaptService(resource))
+			}
+		}
+	}
+	return services
+}
+
+func adaptService(resource *terraform.Block) kubernetes.Service {
+	meta := adaptObjectMeta(resource, true)
+	service := kubernetes.Service{
+		Metadata:  resource.GetMetadata(),
+		Name:      meta.name,
+		Namespace: meta.namespace,
+		Labels:    meta.labels,
+		Type:      types.StringDefault("ClusterIP", resource.GetMetadata()),
+		Selector:  types.MapDefault(make(map[string]string), resource.GetMetadata()),
+	}
+
+	specBlock := resource.GetBlock("spec")
+	if specBlock.IsNil() {
+		return service
+	}
+	service.Type = specBlock.GetAttribute("type").AsStringValueOrDefault("ClusterIP", specBlock)
+	service.Selector = adaptMap(specBlock.GetAttribute("selector"), specBlock)
+	service.ExternalIPs = specBlock.GetAttribute("external_ips").AsStringValueSliceOrEmpty(specBlock)
+	for _, portBlock := range specBlock.GetBlocks("port") {
+		service.Ports = append(service.Ports, kubernetes.ServicePort{
+			Metadata:   portBlock.GetMetadata(),
+			Port:       adaptInt(portBlock.GetAttribute("port"), 0, portBlock),
+			NodePort:   adaptInt(portBlock.GetAttribute("node_port"), 0, portBlock),
+			TargetPort: adaptIntOrString(portBlock.GetAttribute("target_port"), portBlock),
+			Protocol:   portBlock.GetAttribute("protocol").AsStringValueOrDefault("TCP", portBlock),
+		})
+	}
+	return service
+}
+
+func adaptIngresses(modules terraform.Modules) []kubernetes.IngressResource {
+	var ingresses []kubernetes.IngressResource
+	for _, module := range modules {
+		for _, resourceType := range []string{"kubernetes_ingress", "kubernetes_ingress_v1"} {
+			for _, resource := range module.GetResourcesByType(resourceType) {
+				ingresses = append(ingresses, adaptIngress(resource))
+			}
+		}
+	}
+	return ingresses
+}
+
+func adaptIngress(resource *terraform.Block) kubernetes.IngressResource {
+	meta := adaptObjectMeta(resource, true)
+	ingress := kubernetes.IngressResource{
+		Metadata:  resource.GetMetadata(),
+		Name:      meta.name,
+		Namespace: meta.namespace,
+		Labels:    meta.labels,
+	}
+
+	specBlock := resource.GetBlock("spec")
+	for _, ruleBlock := range specBlock.GetBlocks("rule") {
+		rule := kubernetes.IngressRule{
+			Metadata: ruleBlock.GetMetadata(),
+			Host:     ruleBlock.GetAttribute("host").AsStringValueOrDefault("", ruleBlock),
+		}
+		for _, pathBlock := range ruleBlock.GetBlock("http").GetBlocks("path") {
+			rule.Paths = append(rule.Paths, adaptIngressPath(pathBlock))
+		}
+		ingress.Rules = append(ingress.Rules, rule)
+	}
+	for _, tlsBlock := range specBlock.GetBlocks("tls") {
+		ingress.TLS = append(ingress.TLS, kubernetes.IngressTLS{
+			Metadata:   tlsBlock.GetMetadata(),
+			Hosts:      tlsBlock.GetAttribute("hosts").AsStringValueSliceOrEmpty(tlsBlock),
+			SecretName: tlsBlock.GetAttribute("secret_name").AsStringValueOrDefault("", tlsBlock),
+		})
+	}
+	return ingress
+}
+
+// adaptIngressPath reads the backend of a path, which is a service_name and service_port in kubernetes_ingress, and
+// a service block in kubernetes_ingress_v1
+func adaptIngressPath(pathBlock *terraform.Block) kubernetes.IngressPath {
+	path := kubernetes.IngressPath{
+		Metadata:    pathBlock.GetMetadata(),
+		Path:        pathBlock.GetAttribute("path").AsStringValueOrDefault("", pathBlock),
+		ServiceName: types.StringDefault("", pathBlock.GetMetadata()),
+		ServicePort: types.StringDefault("", pathBlock.GetMetadata()),
+	}
+	backendBlock := pathBlock.GetBlock("backend")
+	if backendBlock.IsNil() {
+		return path
+	}
+	if serviceBlock := backendBlock.GetBlock("service"); serviceBlock.IsNotNil() {
+		path.ServiceName = serviceBlock.GetAttribute("name").AsStringValueOrDefault("", serviceBlock)
+		if portBlock := serviceBlock.GetBlock("port"); portBlock.IsNotNil() {
+			if number := portBlock.GetAttribute("number"); number.IsNotNil() {
+				path.ServicePort = adaptIntOrString(number, portBlock)
+			} else {
+				path.ServicePort = portBlock.GetAttribute("name").AsStringValueOrDefault("", portBlock)
+			}
+		}
+		return path
+	}
+	path.ServiceName = backendBlock.GetAttribute("service_name").AsStringValueOrDefault("", backendBlock)
+	path.ServicePort = adaptIntOrString(backendBlock.GetAttribute("service_port"), backendBlock)
+	return path
+}
@@ -0,0 +1,251 @@
+package kubernetes
+
+import (
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/providers/kubernetes"
+	"github.com/aquasecurity/defsec/pkg/terraform"
+)
+
+var workloadResources = []struct {
+	resourceType string
+	kind         string
+}{
+	{"kubernetes_pod", "Pod"},
+	{"kubernetes_pod_v1", "Pod"},
+	{"kubernetes_deployment", "Deployment"},
+	{"kubernetes_deployment_v1", "Deployment"},
+	{"kubernetes_stateful_set", "StatefulSet"},
+	{"kubernetes_stateful_set_v1", "StatefulSet"},
+	{"kubernetes_daemonset", "DaemonSet"},
+	{"kubernetes_daemon_set_v1", "DaemonSet"},
+	{"kubernetes_replication_controller", "ReplicationController"},
+	{"kubernetes_replication_controller_v1", "ReplicationController"},
+	{"kubernetes_job", "Job"},
+	{"kubernetes_job_v1", "Job"},
+	{"kubernetes_cron_job", "CronJob"},
+	{"kubernetes_cron_job_v1", "CronJob"},
+}
+
+func adaptWorkloads(modules terraform.Modules) []kubernetes.Workload {
+	var workloads []kubernetes.Workload
+	for _, module := range modules {
+		for _, workloadResource := range workloadResources {
+			for _, resource := range module.GetResourcesByType(workloadResource.resourceType) {
+				workloads = append(workloads, adaptWorkload(resource, workloadResource.kind))
+			}
+		}
+	}
+	return workloads
+}
+
+func adaptWorkload(resource *terraform.Block, kind string) kubernetes.Workload {
+	meta := adaptObjectMeta(resource, true)
+	workload := kubernetes.Workload{
+		Metadata:    resource.GetMetadata(),
+		Kind:        types.String(kind, resource.GetMetadata()),
+		Name:        meta.name,
+		Namespace:   meta.namespace,
+		Labels:      meta.labels,
+		Annotations: meta.annotations,
+		Replicas:    types.IntDefault(0, resource.GetMetadata()),
+		Selector:    types.MapDefault(make(map[string]string), resource.GetMetadata()),
+	}
+
+	specBlock := resource.GetBlock("spec")
+	if kind == "Pod" {
+		workload.Pod = adaptPodSpec(specBlock, meta.labels, resource)
+		return workload
+	}
+
+	switch kind {
+	case "Deployment", "StatefulSet", "ReplicationController":
+		workload.Replicas = types.IntDefault(1, resource.GetMetadata())
+		if specBlock.IsNotNil() {
+			workload.Replicas = adaptInt(specBlock.GetAttribute("replicas"), 1, specBlock)
+		}
+	}
+	if selectorBlock := specBlock.GetBlock("selector"); selectorBlock.IsNotNil() {
+		workload.Selector = adaptMap(selectorBlock.GetAttribute("match_labels"), selectorBlock)
+	} else if attr := specBlock.GetAttribute("selector"); attr.IsNotNil() {
+		// the selector of a replication controller is a map
+		workload.Selector = adaptMap(attr, specBlock)
+	}
+
+	if kind == "CronJob" {
+		specBlock = specBlock.GetBlock("job_template").GetBlock("spec")
+	}
+	templateBlock := specBlock.GetBlock("template")
+	templateLabels := types.MapDefault(make(map[string]string), resource.GetMetadata())
+	if templateMeta := templateBlock.GetBlock("metadata"); templateMeta.IsNotNil() {
+		templateLabels = adaptMap(templateMeta.GetAttribute("labels"), templateMeta)
+	}
+	workload.Pod = adaptPodSpec(templateBlock.GetBlock("spec"), templateLabels, resource)
+	return workload
+}
+
+func adaptPodSpec(specBlock *terraform.Block, labels types.MapValue, resource *terraform.Block) kubernetes.PodSpec {
+	if specBlock.IsNil() {
+		return kubernetes.PodSpec{
+			Metadata:                     resource.GetMetadata(),
+			Labels:                       labels,
+			ServiceAccountName:           types.StringDefault("", resource.GetMetadata()),
+			AutomountServiceAccountToken: types.BoolDefault(true, resource.GetMetadata()),
+			HostNetwork:                  types.BoolDefault(false, resource.GetMetadata()),
+			HostPID:                      types.BoolDefault(false, resource.GetMetadata()),
+			HostIPC:                      types.BoolDefault(false, resource.GetMetadata()),
+			SecurityContext:              adaptPodSecurityContext(nil, resource),
+		}
+	}
+
+	spec := kubernetes.PodSpec{
+		Metadata:                     specBlock.GetMetadata(),
+		Labels:                       labels,
+		ServiceAccountName:           specBlock.GetAttribute("service_account_name").AsStringValueOrDefault("", specBlock),
+		AutomountServiceAccountToken: specBlock.GetAttribute("automount_service_account_token").AsBoolValueOrDefault(true, specBlock),
+		HostNetwork:                  specBlock.GetAttribute("host_network").AsBoolValueOrDefault(false, specBlock),
+		HostPID:                      specBlock.GetAttribute("host_pid").AsBoolValueOrDefault(false, specBlock),
+		HostIPC:                      specBlock.GetAttribute("host_ipc").AsBoolValueOrDefault(false, specBlock),
+		SecurityContext:              adaptPodSecurityContext(specBlock.GetBlock("security_context"), specBlock),
+	}
+	for _, containerBlock := range specBlock.GetBlocks("container") {
+		spec.Containers = append(spec.Containers, adaptContainer(containerBlock))
+	}
+	for _, containerBlock := range specBlock.GetBlocks("init_container") {
+		spec.InitContainers = append(spec.InitContainers, adaptContainer(containerBlock))
+	}
+	for _, volumeBlock := range specBlock.GetBlocks("volume") {
+		spec.Volumes = append(spec.Volumes, adaptVolume(volumeBlock))
+	}
+	return spec
+}
+
+func adaptPodSecurityContext(contextBlock *terraform.Block, parent *terraform.Block) kubernetes.PodSecurityContext {
+	if contextBlock.IsNil() {
+		return kubernetes.PodSecurityContext{
+			Metadata:           parent.GetMetadata(),
+			RunAsNonRoot:       types.BoolDefault(false, parent.GetMetadata()),
+			RunAsUser:          types.IntDefault(0, parent.GetMetadata()),
+			RunAsGroup:         types.IntDefault(0, parent.GetMetadata()),
+			FSGroup:            types.IntDefault(0, parent.GetMetadata()),
+			SeccompProfileType: types.StringDefault("", parent.GetMetadata()),
+		}
+	}
+	return kubernetes.PodSecurityContext{
+		Metadata:           contextBlock.GetMetadata(),
+		RunAsNonRoot:       contextBlock.GetAttribute("run_as_non_root").AsBoolValueOrDefault(false, contextBlock),
+		RunAsUser:          adaptInt(contextBlock.GetAttribute("run_as_user"), 0, contextBlock),
+		RunAsGroup:         adaptInt(contextBlock.GetAttribute("run_as_group"), 0, contextBlock),
+		FSGroup:            adaptInt(contextBlock.GetAttribute("fs_group"), 0, contextBlock),
+		SeccompProfileType: adaptSeccompProfileType(contextBlock),
+	}
+}
+
+func adaptSeccompProfileType(contextBlock *terraform.Block) types.StringValue {
+	if profileBlock := contextBlock.GetBlock("seccomp_profile"); profileBlock.IsNotNil() {
+		return profileBlock.GetAttribute("type").AsStringValueOrDefault("", profileBlock)
+	}
+	return types.StringDefault("", contextBlock.GetMetadata())
+}
+
+func adaptContainer(containerBlock *terraform.Block) kubernetes.Container {
+	container := kubernetes.Container{
+		Metadata:        containerBlock.GetMetadata(),
+		Name:            containerBlock.GetAttribute("name").AsStringValueOrDefault("", containerBlock),
+		Image:           containerBlock.GetAttribute("image").AsStringValueOrDefault("", containerBlock),
+		ImagePullPolicy: containerBlock.GetAttribute("image_pull_policy").AsStringValueOrDefault("", containerBlock),
+		SecurityContext: adaptSecurityContext(containerBlock.GetBlock("security_context"), containerBlock),
+		Resources: kubernetes.Resources{
+			Metadata: containerBlock.GetMetadata(),
+			Limits:   types.MapDefault(make(map[string]string), containerBlock.GetMetadata()),
+			Requests: types.MapDefault(make(map[string]string), containerBlock.GetMetadata()),
+		},
+	}
+	for _, portBlock := range containerBlock.GetBlocks("port") {
+		container.Ports = append(container.Ports, kubernetes.ContainerPort{
+			Metadata:      portBlock.GetMetadata(),
+			ContainerPort: adaptInt(portBlock.GetAttribute("container_port"), 0, portBlock),
+			HostPort:      adaptInt(portBlock.GetAttribute("host_port"), 0, portBlock),
+			Protocol:      portBlock.GetAttribute("protocol").AsStringValueOrDefault("TCP", portBlock),
+		})
+	}
+	if resourcesBlock := containerBlock.GetBlock("resources"); resourcesBlock.IsNotNil() {
+		container.Resources = kubernetes.Resources{
+			Metadata: resourcesBlock.GetMetadata(),
+			Limits:   adaptResourceList(resourcesBlock, "limits"),
+			Requests: adaptResourceList(resourcesBlock, "requests"),
+		}
+	}
+	return container
+}
+
+// adaptResourceList reads the limits or requests of a container, which are maps in the current provider and blocks
+// in older versions
+func adaptResourceList(resourcesBlock *terraform.Block, name string) types.MapValue {
+	if listBlock := resourcesBlock.GetBlock(name); listBlock.IsNotNil() {
+		values := make(map[string]string)
+		for _, attr := range listBlock.GetAttributes() {
+			if attr.IsString() {
+				values[attr.Name()] = attr.Value().AsString()
+			}
+		}
+		return types.MapExplicit(values, listBlock.GetMetadata())
+	}
+	return adaptMap(resourcesBlock.GetAttribute(name), resourcesBlock)
+}
+
+func adaptSecurityContext(contextBlock *terraform.Block, parent *terraform.Block) kubernetes.SecurityContext {
+	if contextBlock.IsNil() {
+		return kubernetes.SecurityContext{
+			Metadata:                 parent.GetMetadata(),
+			Privileged:               types.BoolDefault(false, parent.GetMetadata()),
+			AllowPrivilegeEscalation: types.BoolDefault(true, parent.GetMetadata()),
+			ReadOnlyRootFilesystem:   types.BoolDefault(false, parent.GetMetadata()),
+			RunAsNonRoot:             types.BoolDefault(false, parent.GetMetadata()),
+			RunAsUser:                types.IntDefault(0, parent.GetMetadata()),
+			RunAsGroup:               types.IntDefault(0, parent.GetMetadata()),
+			SeccompProfileType:       types.StringDefault("", parent.GetMetadata()),
+			Capabilities: kubernetes.Capabilities{
+				Metadata: parent.GetMetadata(),
+			},
+		}
+	}
+	securityContext := kubernetes.SecurityContext{
+		Metadata:                 contextBlock.GetMetadata(),
+		Privileged:               contextBlock.GetAttribute("privileged").AsBoolValueOrDefault(false, contextBlock),
+		AllowPrivilegeEscalation: contextBlock.GetAttribute("allow_privilege_escalation").AsBoolValueOrDefault(true, contextBlock),
+		ReadOnlyRootFilesystem:   contextBlock.GetAttribute("read_only_root_filesystem").AsBoolValueOrDefault(false, contextBlock),
+		RunAsNonRoot:             contextBlock.GetAttribute("run_as_non_root").AsBoolValueOrDefault(false, contextBlock),
+		RunAsUser:                adaptInt(contextBlock.GetAttribute("run_as_user"), 0, contextBlock),
+		RunAsGroup:               adaptInt(contextBlock.GetAttribute("run_as_group"), 0, contextBlock),
+		SeccompProfileType:       adaptSeccompProfileType(contextBlock),
+		Capabilities: kubernetes.Capabilities{
+			Metadata: contextBlock.GetMetadata(),
+		},
+	}
+	if capabilitiesBlock := contextBlock.GetBlock("capabilities"); capabilitiesBlock.IsNotNil() {
+		securityContext.Capabilities = kubernetes.Capabilities{
+			Metadata: capabilitiesBlock.GetMetadata(),
+			Add:      capabilitiesBlock.GetAttribute("add").AsStringValueSliceOrEmpty(capabilitiesBlock),
+			Drop:     capabilitiesBlock.GetAttribute("drop").AsStringValueSliceOrEmpty(capabilitiesBlock),
+		}
+	}
+	return securityContext
+}
+
+func adaptVolume(volumeBlock *terraform.Block) kubernetes.Volume {
+	volume := kubernetes.Volume{
+		Metadata: volumeBlock.GetMetadata(),
+		Name:     volumeBlock.GetAttribute("name").AsStringValueOrDefault("", volumeBlock),
+		Type:     types.StringDefault("", volumeBlock.GetMetadata()),
+		HostPath: types.StringDefault("", volumeBlock.GetMetadata()),
+	}
+	// the volume source is the only block of the volume
+	if sourceBlocks := volumeBlock.AllBlocks(); len(sourceBlocks) > 0 {
+		sourceBlock := sourceBlocks[0]
+		volume.Type = types.String(manifestName(sourceBlock.Type()), sourceBlock.GetMetadata())
+		if sourceBlock.Type() == "host_path" {
+			volume.HostPath = sourceBlock.GetAttribute("path").AsStringValueOrDefault("", sourceBlock)
+		}
+	}
+	return volume
+}
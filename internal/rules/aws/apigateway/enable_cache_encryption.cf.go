@@ -0,0 +1,47 @@
+package apigateway
+
+var cloudFormationEnableCacheEncryptionGoodExamples = []string{
+	`---
+AWSTemplateFormatVersion: 2010-09-09
+Description: Good Example of ApiGateway
+Resources:
+  GoodApi:
+    Type: AWS::ApiGateway::RestApi
+  GoodApiStage:
+    Type: AWS::ApiGateway::Stage
+    Properties:
+      RestApiId: !Ref GoodApi
+      StageName: prod
+      MethodSettings:
+        - HttpMethod: GET
+          ResourcePath: /path1
+          CachingEnabled: true
+          CacheDataEncrypted: true
+`,
+}
+
+var cloudFormationEnableCacheEncryptionBadExamples = []string{
+	`---
+AWSTemplateFormatVersion: 2010-09-09
+Description: Bad Example of ApiGateway
+Resources:
+  BadApi:
+    Type: AWS::ApiGateway::RestApi
+  BadApiStage:
+    Type: AWS::ApiGateway::Stage
+    Properties:
+      RestApiId: !Ref BadApi
+      StageName: prod
+      MethodSettings:
+        - HttpMethod: GET
+          ResourcePath: /path1
+          CachingEnabled: true
+          CacheDataEncrypted: false
+`,
+}
+
+var cloudFormationEnableCacheEncryptionLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-apigateway-stage-methodsetting.html#cfn-apigateway-stage-methodsetting-cachedataencrypted`,
+}
+
+var cloudFormationEnableCacheEncryptionRemediationMarkdown = ``
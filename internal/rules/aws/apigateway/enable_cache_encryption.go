@@ -26,6 +26,12 @@ var CheckEnableCacheEncryption = rules.Register(
 			Links:               terraformEnableCacheEncryptionLinks,
 			RemediationMarkdown: terraformEnableCacheEncryptionRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationEnableCacheEncryptionGoodExamples,
+			BadExamples:         cloudFormationEnableCacheEncryptionBadExamples,
+			Links:               cloudFormationEnableCacheEncryptionLinks,
+			RemediationMarkdown: cloudFormationEnableCacheEncryptionRemediationMarkdown,
+		},
 		Severity: severity.Medium,
 	},
 	func(s *state.State) (results scan.Results) {
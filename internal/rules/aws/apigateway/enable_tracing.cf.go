@@ -0,0 +1,39 @@
+package apigateway
+
+var cloudFormationEnableTracingGoodExamples = []string{
+	`---
+AWSTemplateFormatVersion: 2010-09-09
+Description: Good Example of ApiGateway
+Resources:
+  GoodApi:
+    Type: AWS::ApiGateway::RestApi
+  GoodApiStage:
+    Type: AWS::ApiGateway::Stage
+    Properties:
+      RestApiId: !Ref GoodApi
+      StageName: prod
+      TracingEnabled: true
+`,
+}
+
+var cloudFormationEnableTracingBadExamples = []string{
+	`---
+AWSTemplateFormatVersion: 2010-09-09
+Description: Bad Example of ApiGateway
+Resources:
+  BadApi:
+    Type: AWS::ApiGateway::RestApi
+  BadApiStage:
+    Type: AWS::ApiGateway::Stage
+    Properties:
+      RestApiId: !Ref BadApi
+      StageName: prod
+      TracingEnabled: false
+`,
+}
+
+var cloudFormationEnableTracingLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-apigateway-stage.html#cfn-apigateway-stage-tracingenabled`,
+}
+
+var cloudFormationEnableTracingRemediationMarkdown = ``
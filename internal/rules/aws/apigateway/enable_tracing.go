@@ -26,6 +26,12 @@ var CheckEnableTracing = rules.Register(
 			Links:               terraformEnableTracingLinks,
 			RemediationMarkdown: terraformEnableTracingRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationEnableTracingGoodExamples,
+			BadExamples:         cloudFormationEnableTracingBadExamples,
+			Links:               cloudFormationEnableTracingLinks,
+			RemediationMarkdown: cloudFormationEnableTracingRemediationMarkdown,
+		},
 		Severity: severity.Low,
 	},
 	func(s *state.State) (results scan.Results) {
@@ -0,0 +1,56 @@
+package apigateway
+
+var cloudFormationNoPublicAccessGoodExamples = []string{
+	`---
+AWSTemplateFormatVersion: 2010-09-09
+Description: Good Example of ApiGateway
+Resources:
+  GoodApi:
+    Type: AWS::ApiGateway::RestApi
+  GoodMethod:
+    Type: AWS::ApiGateway::Method
+    Properties:
+      RestApiId: !Ref GoodApi
+      ResourceId: !GetAtt GoodApi.RootResourceId
+      HttpMethod: GET
+      AuthorizationType: AWS_IAM
+`,
+	`---
+AWSTemplateFormatVersion: 2010-09-09
+Description: Good Example of ApiGateway
+Resources:
+  GoodApi:
+    Type: AWS::ApiGateway::RestApi
+  GoodMethod:
+    Type: AWS::ApiGateway::Method
+    Properties:
+      RestApiId: !Ref GoodApi
+      ResourceId: !GetAtt GoodApi.RootResourceId
+      HttpMethod: GET
+      AuthorizationType: NONE
+      ApiKeyRequired: true
+`,
+}
+
+var cloudFormationNoPublicAccessBadExamples = []string{
+	`---
+AWSTemplateFormatVersion: 2010-09-09
+Description: Bad Example of ApiGateway
+Resources:
+  BadApi:
+    Type: AWS::ApiGateway::RestApi
+  BadMethod:
+    Type: AWS::ApiGateway::Method
+    Properties:
+      RestApiId: !Ref BadApi
+      ResourceId: !GetAtt BadApi.RootResourceId
+      HttpMethod: GET
+      AuthorizationType: NONE
+`,
+}
+
+var cloudFormationNoPublicAccessLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-apigateway-method.html#cfn-apigateway-method-authorizationtype`,
+}
+
+var cloudFormationNoPublicAccessRemediationMarkdown = ``
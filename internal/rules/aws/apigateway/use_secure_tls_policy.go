@@ -27,6 +27,12 @@ var CheckUseSecureTlsPolicy = rules.Register(
 			Links:               terraformUseSecureTlsPolicyLinks,
 			RemediationMarkdown: terraformUseSecureTlsPolicyRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationUseSecureTlsPolicyGoodExamples,
+			BadExamples:         cloudFormationUseSecureTlsPolicyBadExamples,
+			Links:               cloudFormationUseSecureTlsPolicyLinks,
+			RemediationMarkdown: cloudFormationUseSecureTlsPolicyRemediationMarkdown,
+		},
 		Severity: severity.High,
 	},
 	func(s *state.State) (results scan.Results) {
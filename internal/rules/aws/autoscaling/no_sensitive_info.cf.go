@@ -0,0 +1,33 @@
+package autoscaling
+
+var cloudFormationNoSensitiveInfoGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::AutoScaling::LaunchConfiguration
+    Properties:
+      LaunchConfigurationName: web_config
+      ImageId: ami-79fd7eee
+      InstanceType: t2.micro
+      UserData: export GREETING=hello
+`,
+}
+
+var cloudFormationNoSensitiveInfoBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::AutoScaling::LaunchConfiguration
+    Properties:
+      LaunchConfigurationName: web_config
+      ImageId: ami-79fd7eee
+      InstanceType: t2.micro
+      UserData: export DATABASE_PASSWORD=password1234
+`,
+}
+
+var cloudFormationNoSensitiveInfoLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-autoscaling-launchconfiguration.html#cfn-autoscaling-launchconfiguration-userdata`,
+}
+
+var cloudFormationNoSensitiveInfoRemediationMarkdown = ``
@@ -33,6 +33,12 @@ var CheckNoSensitiveInfo = rules.Register(
 			Links:               terraformNoSensitiveInfoLinks,
 			RemediationMarkdown: terraformNoSensitiveInfoRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationNoSensitiveInfoGoodExamples,
+			BadExamples:         cloudFormationNoSensitiveInfoBadExamples,
+			Links:               cloudFormationNoSensitiveInfoLinks,
+			RemediationMarkdown: cloudFormationNoSensitiveInfoRemediationMarkdown,
+		},
 		Severity: severity.High,
 	},
 	func(s *state.State) (results scan.Results) {
@@ -0,0 +1,29 @@
+package dynamodb
+
+var cloudFormationEnableRecoveryGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::DynamoDB::Table
+    Properties:
+      TableName: example
+      PointInTimeRecoverySpecification:
+        PointInTimeRecoveryEnabled: true
+`,
+}
+
+var cloudFormationEnableRecoveryBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::DynamoDB::Table
+    Properties:
+      TableName: example
+`,
+}
+
+var cloudFormationEnableRecoveryLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-dynamodb-table-pointintimerecoveryspecification.html`,
+}
+
+var cloudFormationEnableRecoveryRemediationMarkdown = ``
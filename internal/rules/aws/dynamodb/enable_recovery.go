@@ -29,6 +29,12 @@ By enabling point-in-time-recovery you can restore to a known point in the event
 			Links:               terraformEnableRecoveryLinks,
 			RemediationMarkdown: terraformEnableRecoveryRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationEnableRecoveryGoodExamples,
+			BadExamples:         cloudFormationEnableRecoveryBadExamples,
+			Links:               cloudFormationEnableRecoveryLinks,
+			RemediationMarkdown: cloudFormationEnableRecoveryRemediationMarkdown,
+		},
 		Severity: severity.Medium,
 	},
 	func(s *state.State) (results scan.Results) {
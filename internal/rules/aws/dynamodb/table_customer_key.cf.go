@@ -0,0 +1,36 @@
+package dynamodb
+
+var cloudFormationTableCustomerKeyGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::DynamoDB::Table
+    Properties:
+      TableName: example
+      SSESpecification:
+        SSEEnabled: true
+        SSEType: KMS
+        KMSMasterKeyId: !Ref TableKey
+  TableKey:
+    Type: AWS::KMS::Key
+`,
+}
+
+var cloudFormationTableCustomerKeyBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::DynamoDB::Table
+    Properties:
+      TableName: example
+      SSESpecification:
+        SSEEnabled: true
+        SSEType: KMS
+`,
+}
+
+var cloudFormationTableCustomerKeyLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-dynamodb-table-ssespecification.html#cfn-dynamodb-table-ssespecification-kmsmasterkeyid`,
+}
+
+var cloudFormationTableCustomerKeyRemediationMarkdown = ``
@@ -28,6 +28,12 @@ var CheckTableCustomerKey = rules.Register(
 			Links:               terraformTableCustomerKeyLinks,
 			RemediationMarkdown: terraformTableCustomerKeyRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationTableCustomerKeyGoodExamples,
+			BadExamples:         cloudFormationTableCustomerKeyBadExamples,
+			Links:               cloudFormationTableCustomerKeyLinks,
+			RemediationMarkdown: cloudFormationTableCustomerKeyRemediationMarkdown,
+		},
 		Severity: severity.Low,
 	},
 	func(s *state.State) (results scan.Results) {
@@ -0,0 +1,47 @@
+package eks
+
+var cloudFormationEnableControlPlaneLoggingGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::EKS::Cluster
+    Properties:
+      Name: good-example
+      RoleArn: arn:aws:iam::123456789012:role/eks-role
+      ResourcesVpcConfig:
+        SubnetIds:
+          - subnet-6782e71e
+      Logging:
+        ClusterLogging:
+          EnabledTypes:
+            - Type: api
+            - Type: audit
+            - Type: authenticator
+            - Type: controllerManager
+            - Type: scheduler
+`,
+}
+
+var cloudFormationEnableControlPlaneLoggingBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::EKS::Cluster
+    Properties:
+      Name: bad-example
+      RoleArn: arn:aws:iam::123456789012:role/eks-role
+      ResourcesVpcConfig:
+        SubnetIds:
+          - subnet-6782e71e
+      Logging:
+        ClusterLogging:
+          EnabledTypes:
+            - Type: api
+`,
+}
+
+var cloudFormationEnableControlPlaneLoggingLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-eks-cluster-logging.html`,
+}
+
+var cloudFormationEnableControlPlaneLoggingRemediationMarkdown = ``
@@ -27,6 +27,12 @@ var CheckEnableControlPlaneLogging = rules.Register(
 			Links:               terraformEnableControlPlaneLoggingLinks,
 			RemediationMarkdown: terraformEnableControlPlaneLoggingRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationEnableControlPlaneLoggingGoodExamples,
+			BadExamples:         cloudFormationEnableControlPlaneLoggingBadExamples,
+			Links:               cloudFormationEnableControlPlaneLoggingLinks,
+			RemediationMarkdown: cloudFormationEnableControlPlaneLoggingRemediationMarkdown,
+		},
 		Severity: severity.Medium,
 	},
 	func(s *state.State) (results scan.Results) {
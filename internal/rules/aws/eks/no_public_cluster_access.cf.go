@@ -0,0 +1,38 @@
+package eks
+
+var cloudFormationNoPublicClusterAccessGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::EKS::Cluster
+    Properties:
+      Name: good-example
+      RoleArn: arn:aws:iam::123456789012:role/eks-role
+      ResourcesVpcConfig:
+        EndpointPublicAccess: false
+        EndpointPrivateAccess: true
+        SubnetIds:
+          - subnet-6782e71e
+`,
+}
+
+var cloudFormationNoPublicClusterAccessBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::EKS::Cluster
+    Properties:
+      Name: bad-example
+      RoleArn: arn:aws:iam::123456789012:role/eks-role
+      ResourcesVpcConfig:
+        EndpointPublicAccess: true
+        SubnetIds:
+          - subnet-6782e71e
+`,
+}
+
+var cloudFormationNoPublicClusterAccessLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-eks-cluster-resourcesvpcconfig.html#cfn-eks-cluster-resourcesvpcconfig-endpointpublicaccess`,
+}
+
+var cloudFormationNoPublicClusterAccessRemediationMarkdown = ``
@@ -27,6 +27,12 @@ var CheckNoPublicClusterAccess = rules.Register(
 			Links:               terraformNoPublicClusterAccessLinks,
 			RemediationMarkdown: terraformNoPublicClusterAccessRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationNoPublicClusterAccessGoodExamples,
+			BadExamples:         cloudFormationNoPublicClusterAccessBadExamples,
+			Links:               cloudFormationNoPublicClusterAccessLinks,
+			RemediationMarkdown: cloudFormationNoPublicClusterAccessRemediationMarkdown,
+		},
 		Severity: severity.Critical,
 	},
 	func(s *state.State) (results scan.Results) {
@@ -0,0 +1,39 @@
+package eks
+
+var cloudFormationNoPublicClusterAccessToCidrGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::EKS::Cluster
+    Properties:
+      Name: good-example
+      RoleArn: arn:aws:iam::123456789012:role/eks-role
+      ResourcesVpcConfig:
+        EndpointPublicAccess: true
+        PublicAccessCidrs:
+          - 10.2.0.0/8
+        SubnetIds:
+          - subnet-6782e71e
+`,
+}
+
+var cloudFormationNoPublicClusterAccessToCidrBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::EKS::Cluster
+    Properties:
+      Name: bad-example
+      RoleArn: arn:aws:iam::123456789012:role/eks-role
+      ResourcesVpcConfig:
+        EndpointPublicAccess: true
+        SubnetIds:
+          - subnet-6782e71e
+`,
+}
+
+var cloudFormationNoPublicClusterAccessToCidrLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-eks-cluster-resourcesvpcconfig.html#cfn-eks-cluster-resourcesvpcconfig-publicaccesscidrs`,
+}
+
+var cloudFormationNoPublicClusterAccessToCidrRemediationMarkdown = ``
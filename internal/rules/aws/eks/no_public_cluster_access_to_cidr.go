@@ -35,6 +35,12 @@ var CheckNoPublicClusterAccessToCidr = rules.Register(
 			Links:               terraformNoPublicClusterAccessToCidrLinks,
 			RemediationMarkdown: terraformNoPublicClusterAccessToCidrRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationNoPublicClusterAccessToCidrGoodExamples,
+			BadExamples:         cloudFormationNoPublicClusterAccessToCidrBadExamples,
+			Links:               cloudFormationNoPublicClusterAccessToCidrLinks,
+			RemediationMarkdown: cloudFormationNoPublicClusterAccessToCidrRemediationMarkdown,
+		},
 		Severity: severity.Critical,
 	},
 	func(s *state.State) (results scan.Results) {
@@ -0,0 +1,34 @@
+package elasticache
+
+var cloudFormationEnableAtRestEncryptionGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::ElastiCache::ReplicationGroup
+    Properties:
+      ReplicationGroupDescription: example
+      Engine: redis
+      CacheNodeType: cache.m3.medium
+      NumCacheClusters: 1
+      AtRestEncryptionEnabled: true
+`,
+}
+
+var cloudFormationEnableAtRestEncryptionBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::ElastiCache::ReplicationGroup
+    Properties:
+      ReplicationGroupDescription: example
+      Engine: redis
+      CacheNodeType: cache.m3.medium
+      NumCacheClusters: 1
+`,
+}
+
+var cloudFormationEnableAtRestEncryptionLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-elasticache-replicationgroup.html#cfn-elasticache-replicationgroup-atrestencryptionenabled`,
+}
+
+var cloudFormationEnableAtRestEncryptionRemediationMarkdown = ``
@@ -0,0 +1,29 @@
+package elb
+
+var cloudFormationAlbNotPublicGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::ElasticLoadBalancingV2::LoadBalancer
+    Properties:
+      Type: application
+      Scheme: internal
+`,
+}
+
+var cloudFormationAlbNotPublicBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::ElasticLoadBalancingV2::LoadBalancer
+    Properties:
+      Type: application
+      Scheme: internet-facing
+`,
+}
+
+var cloudFormationAlbNotPublicLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-elasticloadbalancingv2-loadbalancer.html#cfn-elasticloadbalancingv2-loadbalancer-scheme`,
+}
+
+var cloudFormationAlbNotPublicRemediationMarkdown = ``
@@ -26,6 +26,12 @@ var CheckAlbNotPublic = rules.Register(
 			Links:               terraformAlbNotPublicLinks,
 			RemediationMarkdown: terraformAlbNotPublicRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationAlbNotPublicGoodExamples,
+			BadExamples:         cloudFormationAlbNotPublicBadExamples,
+			Links:               cloudFormationAlbNotPublicLinks,
+			RemediationMarkdown: cloudFormationAlbNotPublicRemediationMarkdown,
+		},
 		Severity: severity.High,
 	},
 	func(s *state.State) (results scan.Results) {
@@ -0,0 +1,35 @@
+package elb
+
+var cloudFormationDropInvalidHeadersGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::ElasticLoadBalancingV2::LoadBalancer
+    Properties:
+      Type: application
+      Scheme: internal
+      LoadBalancerAttributes:
+        - Key: routing.http.drop_invalid_header_fields.enabled
+          Value: "true"
+`,
+}
+
+var cloudFormationDropInvalidHeadersBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::ElasticLoadBalancingV2::LoadBalancer
+    Properties:
+      Type: application
+      Scheme: internal
+      LoadBalancerAttributes:
+        - Key: routing.http.drop_invalid_header_fields.enabled
+          Value: "false"
+`,
+}
+
+var cloudFormationDropInvalidHeadersLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-elasticloadbalancingv2-loadbalancer-loadbalancerattributes.html`,
+}
+
+var cloudFormationDropInvalidHeadersRemediationMarkdown = ``
@@ -30,6 +30,12 @@ By setting drop_invalid_header_fields to true, anything that doe not conform to
 			Links:               terraformDropInvalidHeadersLinks,
 			RemediationMarkdown: terraformDropInvalidHeadersRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationDropInvalidHeadersGoodExamples,
+			BadExamples:         cloudFormationDropInvalidHeadersBadExamples,
+			Links:               cloudFormationDropInvalidHeadersLinks,
+			RemediationMarkdown: cloudFormationDropInvalidHeadersRemediationMarkdown,
+		},
 		Severity: severity.High,
 	},
 	func(s *state.State) (results scan.Results) {
@@ -30,6 +30,12 @@ You should use HTTPS, which is HTTP over an encrypted (TLS) connection, meaning
 			Links:               terraformHttpNotUsedLinks,
 			RemediationMarkdown: terraformHttpNotUsedRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationHttpNotUsedGoodExamples,
+			BadExamples:         cloudFormationHttpNotUsedBadExamples,
+			Links:               cloudFormationHttpNotUsedLinks,
+			RemediationMarkdown: cloudFormationHttpNotUsedRemediationMarkdown,
+		},
 		Severity: severity.Critical,
 	},
 	func(s *state.State) (results scan.Results) {
@@ -32,6 +32,12 @@ var CheckUseSecureTlsPolicy = rules.Register(
 			Links:               terraformUseSecureTlsPolicyLinks,
 			RemediationMarkdown: terraformUseSecureTlsPolicyRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationUseSecureTlsPolicyGoodExamples,
+			BadExamples:         cloudFormationUseSecureTlsPolicyBadExamples,
+			Links:               cloudFormationUseSecureTlsPolicyLinks,
+			RemediationMarkdown: cloudFormationUseSecureTlsPolicyRemediationMarkdown,
+		},
 		Severity: severity.Critical,
 	},
 	func(s *state.State) (results scan.Results) {
@@ -29,6 +29,12 @@ var CheckEnableInTransitEncryption = rules.Register(
 			Links:               terraformEnableInTransitEncryptionLinks,
 			RemediationMarkdown: terraformEnableInTransitEncryptionRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationEnableInTransitEncryptionGoodExamples,
+			BadExamples:         cloudFormationEnableInTransitEncryptionBadExamples,
+			Links:               cloudFormationEnableInTransitEncryptionLinks,
+			RemediationMarkdown: cloudFormationEnableInTransitEncryptionRemediationMarkdown,
+		},
 		Severity: severity.High,
 	},
 	func(s *state.State) (results scan.Results) {
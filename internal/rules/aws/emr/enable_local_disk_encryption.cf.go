@@ -0,0 +1,44 @@
+package emr
+
+var cloudFormationEnableLocalDiskEncryptionGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::EMR::SecurityConfiguration
+    Properties:
+      Name: emrsc_other
+      SecurityConfiguration:
+        EncryptionConfiguration:
+          AtRestEncryptionConfiguration:
+            S3EncryptionConfiguration:
+              EncryptionMode: SSE-S3
+            LocalDiskEncryptionConfiguration:
+              EncryptionKeyProviderType: AwsKms
+              AwsKmsKey: arn:aws:kms:us-west-2:187416307283:alias/emr_key
+          EnableInTransitEncryption: true
+          EnableAtRestEncryption: true
+`,
+}
+
+var cloudFormationEnableLocalDiskEncryptionBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::EMR::SecurityConfiguration
+    Properties:
+      Name: emrsc_other
+      SecurityConfiguration:
+        EncryptionConfiguration:
+          AtRestEncryptionConfiguration:
+            S3EncryptionConfiguration:
+              EncryptionMode: SSE-S3
+          EnableInTransitEncryption: true
+          EnableAtRestEncryption: true
+`,
+}
+
+var cloudFormationEnableLocalDiskEncryptionLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-emr-securityconfiguration.html`,
+}
+
+var cloudFormationEnableLocalDiskEncryptionRemediationMarkdown = ``
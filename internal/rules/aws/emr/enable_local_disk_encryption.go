@@ -29,6 +29,12 @@ var CheckEnableLocalDiskEncryption = rules.Register(
 			Links:               terraformEnableLocalDiskEncryptionLinks,
 			RemediationMarkdown: terraformEnableLocalDiskEncryptionRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationEnableLocalDiskEncryptionGoodExamples,
+			BadExamples:         cloudFormationEnableLocalDiskEncryptionBadExamples,
+			Links:               cloudFormationEnableLocalDiskEncryptionLinks,
+			RemediationMarkdown: cloudFormationEnableLocalDiskEncryptionRemediationMarkdown,
+		},
 		Severity: severity.High,
 	},
 	func(s *state.State) (results scan.Results) {
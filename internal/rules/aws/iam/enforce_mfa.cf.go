@@ -0,0 +1,46 @@
+package iam
+
+var cloudFormationEnforceMfaGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::IAM::Group
+    Properties:
+      GroupName: support
+      Policies:
+        - PolicyName: support
+          PolicyDocument:
+            Version: "2012-10-17"
+            Statement:
+              - Effect: Allow
+                Action: ec2:*
+                Resource: "*"
+                Condition:
+                  Bool:
+                    aws:MultiFactorAuthPresent: "true"
+`,
+}
+
+var cloudFormationEnforceMfaBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::IAM::Group
+    Properties:
+      GroupName: support
+      Policies:
+        - PolicyName: support
+          PolicyDocument:
+            Version: "2012-10-17"
+            Statement:
+              - Effect: Allow
+                Action: ec2:*
+                Resource: "*"
+`,
+}
+
+var cloudFormationEnforceMfaLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-iam-group.html`,
+}
+
+var cloudFormationEnforceMfaRemediationMarkdown = ``
@@ -35,6 +35,12 @@ IAM user accounts should be protected with multi factor authentication to add sa
 			Links:               terraformEnforceMfaLinks,
 			RemediationMarkdown: terraformEnforceMfaRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationEnforceMfaGoodExamples,
+			BadExamples:         cloudFormationEnforceMfaBadExamples,
+			Links:               cloudFormationEnforceMfaLinks,
+			RemediationMarkdown: cloudFormationEnforceMfaRemediationMarkdown,
+		},
 		Severity: severity.Medium,
 	},
 	func(s *state.State) (results scan.Results) {
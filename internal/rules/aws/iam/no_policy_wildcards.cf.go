@@ -16,6 +16,31 @@ Resources:
             Action:
               - 's3:ListBuckets'
             Resource: 'specific-bucket'
+`,
+	`---
+AWSTemplateFormatVersion: 2010-09-09
+Description: Good example of role policy
+Resources:
+  GoodRole:
+    Type: AWS::IAM::Role
+    Properties:
+      RoleName: CFNRole
+      AssumeRolePolicyDocument:
+        Version: "2012-10-17"
+        Statement:
+          - Effect: Allow
+            Principal:
+              Service: lambda.amazonaws.com
+            Action: sts:AssumeRole
+      Policies:
+        - PolicyName: CFNRolePolicy
+          PolicyDocument:
+            Version: "2012-10-17"
+            Statement:
+              - Effect: Allow
+                Action:
+                  - 's3:GetObject'
+                Resource: 'arn:aws:s3:::specific-bucket/*'
 `,
 }
 
@@ -37,6 +62,31 @@ Resources:
               - 'cloudformation:List*'
               - 'cloudformation:Get*'
             Resource: '*'
+`,
+	`---
+AWSTemplateFormatVersion: 2010-09-09
+Description: Bad example of role policy
+Resources:
+  BadRole:
+    Type: AWS::IAM::Role
+    Properties:
+      RoleName: CFNRole
+      AssumeRolePolicyDocument:
+        Version: "2012-10-17"
+        Statement:
+          - Effect: Allow
+            Principal:
+              Service: lambda.amazonaws.com
+            Action: sts:AssumeRole
+      Policies:
+        - PolicyName: CFNRolePolicy
+          PolicyDocument:
+            Version: "2012-10-17"
+            Statement:
+              - Effect: Allow
+                Action:
+                  - 's3:*'
+                Resource: '*'
 `,
 }
 
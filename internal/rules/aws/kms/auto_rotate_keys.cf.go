@@ -0,0 +1,27 @@
+package kms
+
+var cloudFormationAutoRotateKeysGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::KMS::Key
+    Properties:
+      EnableKeyRotation: true
+`,
+}
+
+var cloudFormationAutoRotateKeysBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::KMS::Key
+    Properties:
+      EnableKeyRotation: false
+`,
+}
+
+var cloudFormationAutoRotateKeysLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-kms-key.html#cfn-kms-key-enablekeyrotation`,
+}
+
+var cloudFormationAutoRotateKeysRemediationMarkdown = ``
@@ -28,6 +28,12 @@ var CheckAutoRotateKeys = rules.Register(
 			Links:               terraformAutoRotateKeysLinks,
 			RemediationMarkdown: terraformAutoRotateKeysRemediationMarkdown,
 		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationAutoRotateKeysGoodExamples,
+			BadExamples:         cloudFormationAutoRotateKeysBadExamples,
+			Links:               cloudFormationAutoRotateKeysLinks,
+			RemediationMarkdown: cloudFormationAutoRotateKeysRemediationMarkdown,
+		},
 		Severity: severity.Medium,
 	},
 	func(s *state.State) (results scan.Results) {
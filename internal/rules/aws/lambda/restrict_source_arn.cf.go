@@ -24,10 +24,10 @@ Resources:
   GoodPermission:
     Type: AWS::Lambda::Permission
     Properties:
-      FunctionName: !Ref BadExample
+      FunctionName: !Ref GoodExample
       Action: lambda:InvokeFunction
       Principal: s3.amazonaws.com
-      SourceArn: "lambda.amazonaws.com"
+      SourceArn: "arn:aws:s3:::my-bucket"
   
 `,
 }
@@ -12,6 +12,8 @@ Resources:
         BrokerLogs:
           S3:
             Enabled: true
+      Tags:
+        foo: bar
 
 
 `,
@@ -10,6 +10,25 @@ Resources:
     Properties:
       BackupRetentionPeriod: 30
 
+`,
+	`---
+AWSTemplateFormatVersion: 2010-09-09
+Description: Good example
+Resources:
+  Cluster:
+    Type: AWS::RDS::DBCluster
+    Properties:
+      Engine: aurora-mysql
+      BackupRetentionPeriod: 30
+  Instance:
+    Type: AWS::RDS::DBInstance
+    Properties:
+      DBClusterIdentifier: !Ref Cluster
+      Engine: aurora-mysql
+  Replica:
+    Type: AWS::RDS::DBInstance
+    Properties:
+      SourceDBInstanceIdentifier: arn:aws:rds:us-east-1:123456789012:db:primary
 `,
 }
 
@@ -22,6 +41,16 @@ Resources:
     Type: AWS::RDS::DBInstance
     Properties:
 
+`,
+	`---
+AWSTemplateFormatVersion: 2010-09-09
+Description: Bad example
+Resources:
+  Cluster:
+    Type: AWS::RDS::DBCluster
+    Properties:
+      Engine: aurora-mysql
+      BackupRetentionPeriod: 1
 `,
 }
 
@@ -0,0 +1,39 @@
+package sfn
+
+var cloudFormationEnableLoggingGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::StepFunctions::StateMachine
+    Properties:
+      StateMachineName: GoodExample
+      RoleArn: arn:aws:iam::123456789012:role/state-machine
+      DefinitionString: '{"StartAt": "Succeed", "States": {"Succeed": {"Type": "Succeed"}}}'
+      LoggingConfiguration:
+        Level: ALL
+        IncludeExecutionData: true
+        Destinations:
+          - CloudWatchLogsLogGroup:
+              LogGroupArn: arn:aws:logs:us-east-1:123456789012:log-group:state-machine:*
+`,
+}
+
+var cloudFormationEnableLoggingBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::StepFunctions::StateMachine
+    Properties:
+      StateMachineName: BadExample
+      RoleArn: arn:aws:iam::123456789012:role/state-machine
+      DefinitionString: '{"StartAt": "Succeed", "States": {"Succeed": {"Type": "Succeed"}}}'
+      LoggingConfiguration:
+        Level: "OFF"
+`,
+}
+
+var cloudFormationEnableLoggingLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-stepfunctions-statemachine.html#cfn-stepfunctions-statemachine-loggingconfiguration`,
+}
+
+var cloudFormationEnableLoggingRemediationMarkdown = ``
@@ -0,0 +1,55 @@
+package sfn
+
+import (
+	"github.com/aquasecurity/defsec/internal/rules"
+	"github.com/aquasecurity/defsec/pkg/providers"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/sfn"
+	"github.com/aquasecurity/defsec/pkg/scan"
+	"github.com/aquasecurity/defsec/pkg/severity"
+	"github.com/aquasecurity/defsec/pkg/state"
+)
+
+var CheckEnableLogging = rules.Register(
+	scan.Rule{
+		AVDID:       "AVD-AWS-0140",
+		Provider:    providers.AWSProvider,
+		Service:     "sfn",
+		ShortCode:   "enable-logging",
+		Summary:     "Step Functions state machines should have logging enabled",
+		Impact:      "Without logging it is difficult to investigate failed or unexpected executions",
+		Resolution:  "Enable logging for the state machine",
+		Explanation: `Logging the execution history of a state machine to CloudWatch Logs allows failures and unexpected behaviour to be investigated.`,
+		Links: []string{
+			"https://docs.aws.amazon.com/step-functions/latest/dg/cw-logs.html",
+		},
+		Terraform: &scan.EngineMetadata{
+			GoodExamples:        terraformEnableLoggingGoodExamples,
+			BadExamples:         terraformEnableLoggingBadExamples,
+			Links:               terraformEnableLoggingLinks,
+			RemediationMarkdown: terraformEnableLoggingRemediationMarkdown,
+		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationEnableLoggingGoodExamples,
+			BadExamples:         cloudFormationEnableLoggingBadExamples,
+			Links:               cloudFormationEnableLoggingLinks,
+			RemediationMarkdown: cloudFormationEnableLoggingRemediationMarkdown,
+		},
+		Severity: severity.Medium,
+	},
+	func(s *state.State) (results scan.Results) {
+		for _, stateMachine := range s.AWS.SFN.StateMachines {
+			if stateMachine.IsUnmanaged() {
+				continue
+			}
+			if stateMachine.Logging.Level.EqualTo(sfn.LoggingLevelOff) {
+				results.Add(
+					"State machine does not have logging enabled.",
+					stateMachine.Logging.Level,
+				)
+			} else {
+				results.AddPassed(&stateMachine)
+			}
+		}
+		return
+	},
+)
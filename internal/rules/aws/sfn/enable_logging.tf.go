@@ -0,0 +1,51 @@
+package sfn
+
+var terraformEnableLoggingGoodExamples = []string{
+	`
+resource "aws_cloudwatch_log_group" "state_machine" {
+  name = "state-machine"
+}
+
+resource "aws_sfn_state_machine" "good_example" {
+  name     = "good-example"
+  role_arn = "arn:aws:iam::123456789012:role/state-machine"
+  definition = jsonencode({
+    StartAt = "Succeed"
+    States = {
+      Succeed = {
+        Type = "Succeed"
+      }
+    }
+  })
+
+  logging_configuration {
+    log_destination        = "${aws_cloudwatch_log_group.state_machine.arn}:*"
+    include_execution_data = true
+    level                  = "ALL"
+  }
+}
+`,
+}
+
+var terraformEnableLoggingBadExamples = []string{
+	`
+resource "aws_sfn_state_machine" "bad_example" {
+  name     = "bad-example"
+  role_arn = "arn:aws:iam::123456789012:role/state-machine"
+  definition = jsonencode({
+    StartAt = "Succeed"
+    States = {
+      Succeed = {
+        Type = "Succeed"
+      }
+    }
+  })
+}
+`,
+}
+
+var terraformEnableLoggingLinks = []string{
+	`https://registry.terraform.io/providers/hashicorp/aws/latest/docs/resources/sfn_state_machine#logging_configuration`,
+}
+
+var terraformEnableLoggingRemediationMarkdown = ``
@@ -0,0 +1,71 @@
+package sfn
+
+import (
+	"testing"
+
+	"github.com/aquasecurity/defsec/internal/types"
+
+	"github.com/aquasecurity/defsec/pkg/state"
+
+	"github.com/aquasecurity/defsec/pkg/providers/aws/sfn"
+	"github.com/aquasecurity/defsec/pkg/scan"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckEnableLogging(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    sfn.SFN
+		expected bool
+	}{
+		{
+			name: "State machine logging off",
+			input: sfn.SFN{
+				StateMachines: []sfn.StateMachine{
+					{
+						Metadata: types.NewTestMetadata(),
+						Logging: sfn.Logging{
+							Metadata: types.NewTestMetadata(),
+							Level:    types.String(sfn.LoggingLevelOff, types.NewTestMetadata()),
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "State machine logging errors",
+			input: sfn.SFN{
+				StateMachines: []sfn.StateMachine{
+					{
+						Metadata: types.NewTestMetadata(),
+						Logging: sfn.Logging{
+							Metadata: types.NewTestMetadata(),
+							Level:    types.String(sfn.LoggingLevelError, types.NewTestMetadata()),
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var testState state.State
+			testState.AWS.SFN = test.input
+			results := CheckEnableLogging.Evaluate(&testState)
+			var found bool
+			for _, result := range results {
+				if result.Status() == scan.StatusFailed && result.Rule().LongID() == CheckEnableLogging.Rule().LongID() {
+					found = true
+				}
+			}
+			if test.expected {
+				assert.True(t, found, "Rule should have been found")
+			} else {
+				assert.False(t, found, "Rule should not have been found")
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package sfn
+
+var cloudFormationEnableTracingGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::StepFunctions::StateMachine
+    Properties:
+      StateMachineName: GoodExample
+      RoleArn: arn:aws:iam::123456789012:role/state-machine
+      DefinitionString: '{"StartAt": "Succeed", "States": {"Succeed": {"Type": "Succeed"}}}'
+      TracingConfiguration:
+        Enabled: true
+`,
+}
+
+var cloudFormationEnableTracingBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::StepFunctions::StateMachine
+    Properties:
+      StateMachineName: BadExample
+      RoleArn: arn:aws:iam::123456789012:role/state-machine
+      DefinitionString: '{"StartAt": "Succeed", "States": {"Succeed": {"Type": "Succeed"}}}'
+`,
+}
+
+var cloudFormationEnableTracingLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-stepfunctions-statemachine.html#cfn-stepfunctions-statemachine-tracingconfiguration`,
+}
+
+var cloudFormationEnableTracingRemediationMarkdown = ``
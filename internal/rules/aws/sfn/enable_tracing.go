@@ -0,0 +1,54 @@
+package sfn
+
+import (
+	"github.com/aquasecurity/defsec/internal/rules"
+	"github.com/aquasecurity/defsec/pkg/providers"
+	"github.com/aquasecurity/defsec/pkg/scan"
+	"github.com/aquasecurity/defsec/pkg/severity"
+	"github.com/aquasecurity/defsec/pkg/state"
+)
+
+var CheckEnableTracing = rules.Register(
+	scan.Rule{
+		AVDID:       "AVD-AWS-0141",
+		Provider:    providers.AWSProvider,
+		Service:     "sfn",
+		ShortCode:   "enable-tracing",
+		Summary:     "Step Functions state machines should have X-Ray tracing enabled",
+		Impact:      "Without tracing it is difficult to follow requests through the services invoked by the state machine",
+		Resolution:  "Enable X-Ray tracing for the state machine",
+		Explanation: `X-Ray tracing enables end-to-end debugging and analysis of the executions of a state machine, including the services its tasks invoke.`,
+		Links: []string{
+			"https://docs.aws.amazon.com/step-functions/latest/dg/concepts-xray-tracing.html",
+		},
+		Terraform: &scan.EngineMetadata{
+			GoodExamples:        terraformEnableTracingGoodExamples,
+			BadExamples:         terraformEnableTracingBadExamples,
+			Links:               terraformEnableTracingLinks,
+			RemediationMarkdown: terraformEnableTracingRemediationMarkdown,
+		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationEnableTracingGoodExamples,
+			BadExamples:         cloudFormationEnableTracingBadExamples,
+			Links:               cloudFormationEnableTracingLinks,
+			RemediationMarkdown: cloudFormationEnableTracingRemediationMarkdown,
+		},
+		Severity: severity.Low,
+	},
+	func(s *state.State) (results scan.Results) {
+		for _, stateMachine := range s.AWS.SFN.StateMachines {
+			if stateMachine.IsUnmanaged() {
+				continue
+			}
+			if stateMachine.Tracing.Enabled.IsFalse() {
+				results.Add(
+					"State machine does not have X-Ray tracing enabled.",
+					stateMachine.Tracing.Enabled,
+				)
+			} else {
+				results.AddPassed(&stateMachine)
+			}
+		}
+		return
+	},
+)
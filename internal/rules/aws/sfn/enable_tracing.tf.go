@@ -0,0 +1,45 @@
+package sfn
+
+var terraformEnableTracingGoodExamples = []string{
+	`
+resource "aws_sfn_state_machine" "good_example" {
+  name     = "good-example"
+  role_arn = "arn:aws:iam::123456789012:role/state-machine"
+  definition = jsonencode({
+    StartAt = "Succeed"
+    States = {
+      Succeed = {
+        Type = "Succeed"
+      }
+    }
+  })
+
+  tracing_configuration {
+    enabled = true
+  }
+}
+`,
+}
+
+var terraformEnableTracingBadExamples = []string{
+	`
+resource "aws_sfn_state_machine" "bad_example" {
+  name     = "bad-example"
+  role_arn = "arn:aws:iam::123456789012:role/state-machine"
+  definition = jsonencode({
+    StartAt = "Succeed"
+    States = {
+      Succeed = {
+        Type = "Succeed"
+      }
+    }
+  })
+}
+`,
+}
+
+var terraformEnableTracingLinks = []string{
+	`https://registry.terraform.io/providers/hashicorp/aws/latest/docs/resources/sfn_state_machine#tracing_configuration`,
+}
+
+var terraformEnableTracingRemediationMarkdown = ``
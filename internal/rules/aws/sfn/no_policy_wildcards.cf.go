@@ -0,0 +1,69 @@
+package sfn
+
+var cloudFormationNoPolicyWildcardsGoodExamples = []string{
+	`---
+Resources:
+  StateMachineRole:
+    Type: AWS::IAM::Role
+    Properties:
+      AssumeRolePolicyDocument:
+        Version: "2012-10-17"
+        Statement:
+          - Effect: Allow
+            Action: sts:AssumeRole
+            Principal:
+              Service: states.amazonaws.com
+      Policies:
+        - PolicyName: invoke
+          PolicyDocument:
+            Version: "2012-10-17"
+            Statement:
+              - Effect: Allow
+                Action:
+                  - lambda:InvokeFunction
+                Resource: 'arn:aws:lambda:us-east-1:123456789012:function:process'
+  GoodExample:
+    Type: AWS::StepFunctions::StateMachine
+    Properties:
+      StateMachineName: GoodExample
+      RoleArn: !GetAtt StateMachineRole.Arn
+      DefinitionString: '{"StartAt": "Succeed", "States": {"Succeed": {"Type": "Succeed"}}}'
+`,
+}
+
+var cloudFormationNoPolicyWildcardsBadExamples = []string{
+	`---
+Resources:
+  StateMachineRole:
+    Type: AWS::IAM::Role
+    Properties:
+      AssumeRolePolicyDocument:
+        Version: "2012-10-17"
+        Statement:
+          - Effect: Allow
+            Action: sts:AssumeRole
+            Principal:
+              Service: states.amazonaws.com
+      Policies:
+        - PolicyName: invoke
+          PolicyDocument:
+            Version: "2012-10-17"
+            Statement:
+              - Effect: Allow
+                Action:
+                  - lambda:*
+                Resource: '*'
+  BadExample:
+    Type: AWS::StepFunctions::StateMachine
+    Properties:
+      StateMachineName: BadExample
+      RoleArn: !GetAtt StateMachineRole.Arn
+      DefinitionString: '{"StartAt": "Succeed", "States": {"Succeed": {"Type": "Succeed"}}}'
+`,
+}
+
+var cloudFormationNoPolicyWildcardsLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-iam-role.html#cfn-iam-role-policies`,
+}
+
+var cloudFormationNoPolicyWildcardsRemediationMarkdown = ``
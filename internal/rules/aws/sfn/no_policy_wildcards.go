@@ -0,0 +1,90 @@
+package sfn
+
+import (
+	"strings"
+
+	"github.com/aquasecurity/defsec/internal/rules"
+	"github.com/aquasecurity/defsec/pkg/providers"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/iam"
+	"github.com/aquasecurity/defsec/pkg/scan"
+	"github.com/aquasecurity/defsec/pkg/severity"
+	"github.com/aquasecurity/defsec/pkg/state"
+	"github.com/liamg/iamgo"
+)
+
+var CheckNoPolicyWildcards = rules.Register(
+	scan.Rule{
+		AVDID:       "AVD-AWS-0143",
+		Provider:    providers.AWSProvider,
+		Service:     "sfn",
+		ShortCode:   "no-policy-wildcards",
+		Summary:     "The execution role of a state machine should not use wildcards in its policies",
+		Impact:      "Overly permissive policies may allow the state machine to access resources it does not need",
+		Resolution:  "Specify the exact permissions required by the state machine, and the resources they apply to",
+		Explanation: `The execution role of a state machine should be granted only the permissions required by its tasks. Wildcarded actions, and sensitive actions on wildcarded resources, grant the state machine, and anyone able to start it, more access than it needs.`,
+		Links: []string{
+			"https://docs.aws.amazon.com/step-functions/latest/dg/procedure-create-iam-role.html",
+		},
+		Terraform: &scan.EngineMetadata{
+			GoodExamples:        terraformNoPolicyWildcardsGoodExamples,
+			BadExamples:         terraformNoPolicyWildcardsBadExamples,
+			Links:               terraformNoPolicyWildcardsLinks,
+			RemediationMarkdown: terraformNoPolicyWildcardsRemediationMarkdown,
+		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationNoPolicyWildcardsGoodExamples,
+			BadExamples:         cloudFormationNoPolicyWildcardsBadExamples,
+			Links:               cloudFormationNoPolicyWildcardsLinks,
+			RemediationMarkdown: cloudFormationNoPolicyWildcardsRemediationMarkdown,
+		},
+		Severity: severity.High,
+	},
+	func(s *state.State) (results scan.Results) {
+		for _, stateMachine := range s.AWS.SFN.StateMachines {
+			if stateMachine.IsUnmanaged() {
+				continue
+			}
+			for _, policy := range stateMachine.Policies {
+				statements, _ := policy.Document.Parsed.Statements()
+				for _, statement := range statements {
+					results = checkStatement(policy.Document, statement, results)
+				}
+			}
+		}
+		return
+	},
+)
+
+func checkStatement(document iam.Document, statement iamgo.Statement, results scan.Results) scan.Results {
+	effect, _ := statement.Effect()
+	if effect != iamgo.EffectAllow {
+		return results
+	}
+	actions, r := statement.Actions()
+	for _, action := range actions {
+		if strings.Contains(action, "*") {
+			results.Add(
+				"Execution role policy uses a wildcard action.",
+				document.MetadataFromIamGo(statement.Range(), r),
+			)
+		} else {
+			results.AddPassed(document)
+		}
+	}
+	resources, r := statement.Resources()
+	for _, resource := range resources {
+		if strings.Contains(resource, "*") {
+			if ok, _ := iam.IsWildcardAllowed(actions...); !ok {
+				results.Add(
+					"Execution role policy uses a wildcard resource for sensitive action(s).",
+					document.MetadataFromIamGo(statement.Range(), r),
+				)
+			} else {
+				results.AddPassed(document)
+			}
+		} else {
+			results.AddPassed(document)
+		}
+	}
+	return results
+}
@@ -0,0 +1,97 @@
+package sfn
+
+var terraformNoPolicyWildcardsGoodExamples = []string{
+	`
+resource "aws_iam_role" "state_machine" {
+  name = "state-machine"
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect    = "Allow"
+        Action    = "sts:AssumeRole"
+        Principal = { Service = "states.amazonaws.com" }
+      },
+    ]
+  })
+
+  inline_policy {
+    name = "invoke"
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [
+        {
+          Effect   = "Allow"
+          Action   = ["lambda:InvokeFunction"]
+          Resource = ["arn:aws:lambda:us-east-1:123456789012:function:process"]
+        },
+      ]
+    })
+  }
+}
+
+resource "aws_sfn_state_machine" "good_example" {
+  name     = "good-example"
+  role_arn = aws_iam_role.state_machine.arn
+  definition = jsonencode({
+    StartAt = "Succeed"
+    States = {
+      Succeed = {
+        Type = "Succeed"
+      }
+    }
+  })
+}
+`,
+}
+
+var terraformNoPolicyWildcardsBadExamples = []string{
+	`
+resource "aws_iam_role" "state_machine" {
+  name = "state-machine"
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect    = "Allow"
+        Action    = "sts:AssumeRole"
+        Principal = { Service = "states.amazonaws.com" }
+      },
+    ]
+  })
+
+  inline_policy {
+    name = "invoke"
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [
+        {
+          Effect   = "Allow"
+          Action   = ["lambda:*"]
+          Resource = ["*"]
+        },
+      ]
+    })
+  }
+}
+
+resource "aws_sfn_state_machine" "bad_example" {
+  name     = "bad-example"
+  role_arn = aws_iam_role.state_machine.arn
+  definition = jsonencode({
+    StartAt = "Succeed"
+    States = {
+      Succeed = {
+        Type = "Succeed"
+      }
+    }
+  })
+}
+`,
+}
+
+var terraformNoPolicyWildcardsLinks = []string{
+	`https://registry.terraform.io/providers/hashicorp/aws/latest/docs/resources/iam_role#inline_policy`,
+}
+
+var terraformNoPolicyWildcardsRemediationMarkdown = ``
@@ -0,0 +1,101 @@
+package sfn
+
+import (
+	"testing"
+
+	"github.com/aquasecurity/defsec/internal/types"
+
+	"github.com/aquasecurity/defsec/pkg/state"
+
+	"github.com/aquasecurity/defsec/pkg/providers/aws/iam"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/sfn"
+	"github.com/aquasecurity/defsec/pkg/scan"
+
+	"github.com/liamg/iamgo"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rolePolicies(actions []string, resources []string) []iam.Policy {
+	sb := iamgo.NewStatementBuilder()
+	sb.WithSid("invoke")
+	sb.WithEffect("Allow")
+	sb.WithActions(actions)
+	sb.WithResources(resources)
+
+	builder := iamgo.NewPolicyBuilder()
+	builder.WithVersion("2012-10-17")
+	builder.WithStatement(sb.Build())
+
+	return []iam.Policy{
+		{
+			Document: iam.Document{
+				Metadata: types.NewTestMetadata(),
+				Parsed:   builder.Build(),
+			},
+		},
+	}
+}
+
+func TestCheckNoPolicyWildcards(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    sfn.SFN
+		expected bool
+	}{
+		{
+			name: "Wildcard action in execution role policy",
+			input: sfn.SFN{
+				StateMachines: []sfn.StateMachine{
+					{
+						Metadata: types.NewTestMetadata(),
+						Policies: rolePolicies([]string{"lambda:*"}, []string{"arn:aws:lambda:us-east-1:123456789012:function:process"}),
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "Sensitive action on wildcard resource in execution role policy",
+			input: sfn.SFN{
+				StateMachines: []sfn.StateMachine{
+					{
+						Metadata: types.NewTestMetadata(),
+						Policies: rolePolicies([]string{"lambda:InvokeFunction"}, []string{"*"}),
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "Specific action and resource in execution role policy",
+			input: sfn.SFN{
+				StateMachines: []sfn.StateMachine{
+					{
+						Metadata: types.NewTestMetadata(),
+						Policies: rolePolicies([]string{"lambda:InvokeFunction"}, []string{"arn:aws:lambda:us-east-1:123456789012:function:process"}),
+					},
+				},
+			},
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var testState state.State
+			testState.AWS.SFN = test.input
+			results := CheckNoPolicyWildcards.Evaluate(&testState)
+			var found bool
+			for _, result := range results {
+				if result.Status() == scan.StatusFailed && result.Rule().LongID() == CheckNoPolicyWildcards.Rule().LongID() {
+					found = true
+				}
+			}
+			if test.expected {
+				assert.True(t, found, "Rule should have been found")
+			} else {
+				assert.False(t, found, "Rule should not have been found")
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package sfn
+
+var cloudFormationNoWildcardTaskResourcesGoodExamples = []string{
+	`---
+Resources:
+  GoodExample:
+    Type: AWS::StepFunctions::StateMachine
+    Properties:
+      StateMachineName: GoodExample
+      RoleArn: arn:aws:iam::123456789012:role/state-machine
+      Definition:
+        StartAt: Process
+        States:
+          Process:
+            Type: Task
+            Resource: arn:aws:lambda:us-east-1:123456789012:function:process
+            End: true
+`,
+}
+
+var cloudFormationNoWildcardTaskResourcesBadExamples = []string{
+	`---
+Resources:
+  BadExample:
+    Type: AWS::StepFunctions::StateMachine
+    Properties:
+      StateMachineName: BadExample
+      RoleArn: arn:aws:iam::123456789012:role/state-machine
+      Definition:
+        StartAt: Process
+        States:
+          Process:
+            Type: Task
+            Resource: arn:aws:lambda:us-east-1:123456789012:function:*
+            End: true
+`,
+}
+
+var cloudFormationNoWildcardTaskResourcesLinks = []string{
+	`https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-stepfunctions-statemachine.html#cfn-stepfunctions-statemachine-definition`,
+}
+
+var cloudFormationNoWildcardTaskResourcesRemediationMarkdown = ``
@@ -0,0 +1,75 @@
+package sfn
+
+import (
+	"strings"
+
+	"github.com/aquasecurity/defsec/internal/rules"
+	"github.com/aquasecurity/defsec/pkg/providers"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/sfn"
+	"github.com/aquasecurity/defsec/pkg/scan"
+	"github.com/aquasecurity/defsec/pkg/severity"
+	"github.com/aquasecurity/defsec/pkg/state"
+)
+
+var CheckNoWildcardTaskResources = rules.Register(
+	scan.Rule{
+		AVDID:      "AVD-AWS-0142",
+		Provider:   providers.AWSProvider,
+		Service:    "sfn",
+		ShortCode:  "no-wildcard-task-resources",
+		Summary:    "State machine tasks should not use wildcard resource ARNs",
+		Impact:     "The execution role must be granted access to every resource the wildcard could match",
+		Resolution: "Specify the exact ARN of the resource invoked by each task",
+		Explanation: `Task states invoke the resource identified by their ARN. A wildcard in the ARN makes it unclear which resource is invoked, and requires the execution role of the state machine to be granted access to every resource the wildcard matches.
+
+The definitions of both Step Functions and SAM state machines are checked, including the states nested in Parallel and Map states.`,
+		Links: []string{
+			"https://docs.aws.amazon.com/step-functions/latest/dg/amazon-states-language-task-state.html",
+		},
+		Terraform: &scan.EngineMetadata{
+			GoodExamples:        terraformNoWildcardTaskResourcesGoodExamples,
+			BadExamples:         terraformNoWildcardTaskResourcesBadExamples,
+			Links:               terraformNoWildcardTaskResourcesLinks,
+			RemediationMarkdown: terraformNoWildcardTaskResourcesRemediationMarkdown,
+		},
+		CloudFormation: &scan.EngineMetadata{
+			GoodExamples:        cloudFormationNoWildcardTaskResourcesGoodExamples,
+			BadExamples:         cloudFormationNoWildcardTaskResourcesBadExamples,
+			Links:               cloudFormationNoWildcardTaskResourcesLinks,
+			RemediationMarkdown: cloudFormationNoWildcardTaskResourcesRemediationMarkdown,
+		},
+		Severity: severity.Medium,
+	},
+	func(s *state.State) (results scan.Results) {
+		for _, stateMachine := range s.AWS.SFN.StateMachines {
+			if stateMachine.IsUnmanaged() {
+				continue
+			}
+			results = checkDefinition(stateMachine.Definition, results)
+		}
+		for _, stateMachine := range s.AWS.SAM.StateMachines {
+			if stateMachine.IsUnmanaged() {
+				continue
+			}
+			results = checkDefinition(stateMachine.Definition, results)
+		}
+		return
+	},
+)
+
+func checkDefinition(definition sfn.Definition, results scan.Results) scan.Results {
+	for _, state := range definition.AllStates() {
+		if state.Type.NotEqualTo(sfn.StateTypeTask) {
+			continue
+		}
+		if strings.Contains(state.Resource.Value(), "*") {
+			results.Add(
+				"Task state '"+state.Name.Value()+"' uses a wildcard resource ARN.",
+				state.Resource,
+			)
+		} else {
+			results.AddPassed(state)
+		}
+	}
+	return results
+}
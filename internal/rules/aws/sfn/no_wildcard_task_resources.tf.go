@@ -0,0 +1,45 @@
+package sfn
+
+var terraformNoWildcardTaskResourcesGoodExamples = []string{
+	`
+resource "aws_sfn_state_machine" "good_example" {
+  name     = "good-example"
+  role_arn = "arn:aws:iam::123456789012:role/state-machine"
+  definition = jsonencode({
+    StartAt = "Process"
+    States = {
+      Process = {
+        Type     = "Task"
+        Resource = "arn:aws:lambda:us-east-1:123456789012:function:process"
+        End      = true
+      }
+    }
+  })
+}
+`,
+}
+
+var terraformNoWildcardTaskResourcesBadExamples = []string{
+	`
+resource "aws_sfn_state_machine" "bad_example" {
+  name     = "bad-example"
+  role_arn = "arn:aws:iam::123456789012:role/state-machine"
+  definition = jsonencode({
+    StartAt = "Process"
+    States = {
+      Process = {
+        Type     = "Task"
+        Resource = "arn:aws:lambda:us-east-1:123456789012:function:*"
+        End      = true
+      }
+    }
+  })
+}
+`,
+}
+
+var terraformNoWildcardTaskResourcesLinks = []string{
+	`https://registry.terraform.io/providers/hashicorp/aws/latest/docs/resources/sfn_state_machine#definition`,
+}
+
+var terraformNoWildcardTaskResourcesRemediationMarkdown = ``
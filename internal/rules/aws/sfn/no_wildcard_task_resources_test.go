@@ -0,0 +1,119 @@
+package sfn
+
+import (
+	"testing"
+
+	"github.com/aquasecurity/defsec/internal/types"
+
+	"github.com/aquasecurity/defsec/pkg/state"
+
+	"github.com/aquasecurity/defsec/pkg/providers/aws/sam"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/sfn"
+	"github.com/aquasecurity/defsec/pkg/scan"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func taskDefinition(resource string) sfn.Definition {
+	return sfn.Definition{
+		Metadata: types.NewTestMetadata(),
+		States: []sfn.State{
+			{
+				Metadata: types.NewTestMetadata(),
+				Name:     types.String("Process", types.NewTestMetadata()),
+				Type:     types.String(sfn.StateTypeTask, types.NewTestMetadata()),
+				Resource: types.String(resource, types.NewTestMetadata()),
+			},
+		},
+	}
+}
+
+func TestCheckNoWildcardTaskResources(t *testing.T) {
+	tests := []struct {
+		name     string
+		sfn      sfn.SFN
+		sam      sam.SAM
+		expected bool
+	}{
+		{
+			name: "Task with wildcard function ARN",
+			sfn: sfn.SFN{
+				StateMachines: []sfn.StateMachine{
+					{
+						Metadata:   types.NewTestMetadata(),
+						Definition: taskDefinition("arn:aws:lambda:us-east-1:123456789012:function:*"),
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "Task with wildcard function ARN in a parallel branch",
+			sfn: sfn.SFN{
+				StateMachines: []sfn.StateMachine{
+					{
+						Metadata: types.NewTestMetadata(),
+						Definition: sfn.Definition{
+							Metadata: types.NewTestMetadata(),
+							States: []sfn.State{
+								{
+									Metadata: types.NewTestMetadata(),
+									Name:     types.String("Fan out", types.NewTestMetadata()),
+									Type:     types.String(sfn.StateTypeParallel, types.NewTestMetadata()),
+									Resource: types.String("", types.NewTestMetadata()),
+									Branches: []sfn.Definition{
+										taskDefinition("arn:aws:lambda:*:123456789012:function:process"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "SAM state machine task with wildcard function ARN",
+			sam: sam.SAM{
+				StateMachines: []sam.StateMachine{
+					{
+						Metadata:   types.NewTestMetadata(),
+						Definition: taskDefinition("arn:aws:lambda:us-east-1:123456789012:function:*"),
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "Task with exact function ARN",
+			sfn: sfn.SFN{
+				StateMachines: []sfn.StateMachine{
+					{
+						Metadata:   types.NewTestMetadata(),
+						Definition: taskDefinition("arn:aws:lambda:us-east-1:123456789012:function:process"),
+					},
+				},
+			},
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var testState state.State
+			testState.AWS.SFN = test.sfn
+			testState.AWS.SAM = test.sam
+			results := CheckNoWildcardTaskResources.Evaluate(&testState)
+			var found bool
+			for _, result := range results {
+				if result.Status() == scan.StatusFailed && result.Rule().LongID() == CheckNoWildcardTaskResources.Rule().LongID() {
+					found = true
+				}
+			}
+			if test.expected {
+				assert.True(t, found, "Rule should have been found")
+			} else {
+				assert.False(t, found, "Rule should not have been found")
+			}
+		})
+	}
+}
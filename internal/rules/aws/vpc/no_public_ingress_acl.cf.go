@@ -17,6 +17,7 @@ Resources:
       Protocol: 6
       CidrBlock: 10.0.0.0/8
       RuleAction: allow
+      Egress: false
 `,
 }
 
@@ -37,6 +38,7 @@ Resources:
       Protocol: 6
       CidrBlock: 0.0.0.0/0
       RuleAction: allow
+      Egress: false
 `,
 }
 
@@ -11,5 +11,21 @@ new(msg, cause) = result {
 		"managed": object.get(metadata, "managed", true),
 		"fskey": object.get(metadata, "fskey", ""),
 		"resource": object.get(metadata, "resource", ""),
+		"provenance": object.get(metadata, "provenance", []),
+		"unresolvable": object.get(metadata, "unresolvable", false),
 	}
 }
+
+# with_related adds a reference to another resource which contributed to the result, e.g. the role granted by a binding
+with_related(res, name, cause) = result {
+	metadata := object.get(cause, "__defsec_metadata", cause)
+	related := {
+		"resource": name,
+		"startline": object.get(metadata, "startline", object.get(metadata, "StartLine", 0)),
+		"endline": object.get(metadata, "endline", object.get(metadata, "EndLine", 0)),
+		"filepath": object.get(metadata, "filepath", object.get(metadata, "Path", "")),
+		"fskey": object.get(metadata, "fskey", ""),
+	}
+
+	result := object.union(res, {"related": array.concat(object.get(res, "related", []), [related])})
+}
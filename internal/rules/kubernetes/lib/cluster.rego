@@ -0,0 +1,101 @@
+package lib.cluster
+
+import data.lib.selectors
+
+# These rules provide a view of all of the manifests in a scan, for checks which use the combined input
+
+objects[obj] {
+	obj := input[_].contents
+}
+
+name(obj) = sprintf("%s/%s", [obj.kind, obj.metadata.name])
+
+# namespace is the namespace an object is applied to, which the scanner records in its metadata. It is empty for
+# cluster-scoped objects.
+namespace(obj) = ns {
+	ns := obj.__defsec_metadata.namespace
+} else = ns {
+	ns := obj.metadata.namespace
+} else = "default" {
+	true
+}
+
+same_namespace(a, b) {
+	namespace(a) == namespace(b)
+}
+
+namespaces[ns] {
+	ns := namespace(objects[_])
+	ns != ""
+}
+
+workload_kinds := {"Pod", "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "ReplicationController", "Job", "CronJob"}
+
+workloads[obj] {
+	obj := objects[_]
+	workload_kinds[obj.kind]
+}
+
+services[obj] {
+	obj := objects[_]
+	obj.kind == "Service"
+}
+
+ingresses[obj] {
+	obj := objects[_]
+	obj.kind == "Ingress"
+}
+
+network_policies[obj] {
+	obj := objects[_]
+	obj.kind == "NetworkPolicy"
+}
+
+# find returns the object of the given kind and name in the namespace of another object which refers to it
+find(kind, name, referrer) = obj {
+	obj := objects[_]
+	obj.kind == kind
+	obj.metadata.name == name
+	same_namespace(obj, referrer)
+}
+
+pod_template(workload) = workload {
+	workload.kind == "Pod"
+}
+
+pod_template(workload) = workload.spec.jobTemplate.spec.template {
+	workload.kind == "CronJob"
+}
+
+pod_template(workload) = workload.spec.template {
+	not {"Pod", "CronJob"}[workload.kind]
+}
+
+pod_labels(workload) = object.get(object.get(pod_template(workload), "metadata", {}), "labels", {})
+
+# service_selects is true if the service routes traffic to the pods of the workload
+service_selects(service, workload) {
+	same_namespace(service, workload)
+	selectors.matches_labels(service.spec.selector, pod_labels(workload))
+}
+
+# network_policy_selects is true if the network policy applies to the pods of the workload
+network_policy_selects(policy, workload) {
+	same_namespace(policy, workload)
+	selectors.matches_selector(object.get(policy.spec, "podSelector", {}), pod_labels(workload))
+}
+
+# Network policies restrict ingress unless their policyTypes are set without it
+restricts_ingress(policy) {
+	not policy.spec.policyTypes
+}
+
+restricts_ingress(policy) {
+	policy.spec.policyTypes[_] == "Ingress"
+}
+
+ingress_isolated(workload) {
+	policy := network_policies[_]
+	restricts_ingress(policy)
+	network_policy_selects(policy, workload)
+}
@@ -0,0 +1,35 @@
+package lib.cluster
+
+test_namespace {
+	namespace({"kind": "Pod", "metadata": {"name": "web", "namespace": "apps"}}) == "apps"
+	namespace({"kind": "Pod", "metadata": {"name": "web"}}) == "default"
+	namespace({"kind": "ClusterRole", "metadata": {"name": "reader"}, "__defsec_metadata": {"namespace": ""}}) == ""
+}
+
+test_pod_labels {
+	pod_labels({"kind": "Pod", "metadata": {"labels": {"app": "web"}}}) == {"app": "web"}
+	pod_labels({"kind": "Deployment", "spec": {"template": {"metadata": {"labels": {"app": "web"}}}}}) == {"app": "web"}
+	pod_labels({"kind": "CronJob", "spec": {"jobTemplate": {"spec": {"template": {"metadata": {"labels": {"app": "job"}}}}}}}) == {"app": "job"}
+}
+
+test_service_selects {
+	workloads := [
+		{"kind": "Pod", "metadata": {"name": "web", "labels": {"app": "web"}}},
+		{"kind": "Pod", "metadata": {"name": "other", "namespace": "other", "labels": {"app": "web"}}},
+		{"kind": "Pod", "metadata": {"name": "api", "labels": {"app": "api"}}},
+	]
+
+	service := {"kind": "Service", "metadata": {"name": "web"}, "spec": {"selector": {"app": "web"}}}
+
+	selected := {workload.metadata.name | workload := workloads[_]; service_selects(service, workload)}
+	selected == {"web"}
+}
+
+test_find {
+	found := find("Service", "web", {"kind": "Ingress", "metadata": {"name": "web", "namespace": "apps"}}) with input as [
+		{"contents": {"kind": "Service", "metadata": {"name": "web"}}},
+		{"contents": {"kind": "Service", "metadata": {"name": "web", "namespace": "apps"}, "spec": {"type": "ClusterIP"}}},
+	]
+
+	found.spec.type == "ClusterIP"
+}
@@ -0,0 +1,114 @@
+package lib.pss
+
+import data.lib.cluster
+
+# The checks of the pss/baseline and pss/restricted policies, which are evaluated against each workload to find the
+# highest Pod Security Standard it satisfies
+baseline_checks := {"KSV002", "KSV008", "KSV009", "KSV010", "KSV017", "KSV022", "KSV023", "KSV024", "KSV025", "KSV026", "KSV027"}
+
+restricted_checks := {"KSV001", "KSV012", "KSV028", "KSV029", "KSV030"}
+
+levels := {"privileged": 0, "baseline": 1, "restricted": 2}
+
+enforce_label := "pod-security.kubernetes.io/enforce"
+
+failed_checks(workload, checks) = {id |
+	id := checks[_]
+	fails(id) with input as workload
+}
+
+# The checks are referred to individually, as referring to data.builtin.kubernetes[id] would make the checks under it
+# which use this library recursive
+fails("KSV001") {
+	data.builtin.kubernetes.KSV001.deny[_]
+}
+
+fails("KSV002") {
+	data.builtin.kubernetes.KSV002.deny[_]
+}
+
+fails("KSV008") {
+	data.builtin.kubernetes.KSV008.deny[_]
+}
+
+fails("KSV009") {
+	data.builtin.kubernetes.KSV009.deny[_]
+}
+
+fails("KSV010") {
+	data.builtin.kubernetes.KSV010.deny[_]
+}
+
+fails("KSV012") {
+	data.builtin.kubernetes.KSV012.deny[_]
+}
+
+fails("KSV017") {
+	data.builtin.kubernetes.KSV017.deny[_]
+}
+
+fails("KSV022") {
+	data.builtin.kubernetes.KSV022.deny[_]
+}
+
+fails("KSV023") {
+	data.builtin.kubernetes.KSV023.deny[_]
+}
+
+fails("KSV024") {
+	data.builtin.kubernetes.KSV024.deny[_]
+}
+
+fails("KSV025") {
+	data.builtin.kubernetes.KSV025.deny[_]
+}
+
+fails("KSV026") {
+	data.builtin.kubernetes.KSV026.deny[_]
+}
+
+fails("KSV027") {
+	data.builtin.kubernetes.KSV027.deny[_]
+}
+
+fails("KSV028") {
+	data.builtin.kubernetes.KSV028.deny[_]
+}
+
+fails("KSV029") {
+	data.builtin.kubernetes.KSV029.deny[_]
+}
+
+fails("KSV030") {
+	data.builtin.kubernetes.KSV030.deny[_]
+}
+
+# level is the highest Pod Security Standard the workload satisfies
+level(workload) = "privileged" {
+	count(failed_checks(workload, baseline_checks)) > 0
+} else = "baseline" {
+	count(failed_checks(workload, restricted_checks)) > 0
+} else = "restricted" {
+	true
+}
+
+# checks_for returns the checks a workload must pass to satisfy the given level
+checks_for("privileged") = set()
+
+checks_for("baseline") = baseline_checks
+
+checks_for("restricted") = baseline_checks | restricted_checks
+
+namespaces[ns] {
+	ns := cluster.objects[_]
+	ns.kind == "Namespace"
+}
+
+# enforced_level is the level the Pod Security Admission controller enforces in the namespace of the workload, as
+# set by the labels of a Namespace in the scan
+enforced_level(workload) = [ns, level] {
+	ns := namespaces[_]
+	ns.metadata.name == cluster.namespace(workload)
+	level := ns.metadata.labels[enforce_label]
+	levels[level]
+}
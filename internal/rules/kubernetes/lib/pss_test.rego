@@ -0,0 +1,21 @@
+package lib.pss
+
+test_level {
+	level({"kind": "Pod", "metadata": {"name": "app"}, "spec": {"hostNetwork": true, "containers": [{"name": "app", "image": "app"}]}}) == "privileged"
+	level({"kind": "Pod", "metadata": {"name": "app"}, "spec": {"containers": [{"name": "app", "image": "app"}]}}) == "baseline"
+}
+
+test_enforced_level {
+	workload := {"kind": "Pod", "metadata": {"name": "app", "namespace": "apps"}}
+	enforced := enforced_level(workload) with input as [
+		{"contents": {"kind": "Namespace", "metadata": {"name": "apps", "labels": {"pod-security.kubernetes.io/enforce": "restricted"}}}},
+		{"contents": {"kind": "Namespace", "metadata": {"name": "other", "labels": {"pod-security.kubernetes.io/enforce": "baseline"}}}},
+	]
+
+	enforced[1] == "restricted"
+}
+
+test_enforced_level_ignores_unknown_levels {
+	workload := {"kind": "Pod", "metadata": {"name": "app", "namespace": "apps"}}
+	not enforced_level(workload) with input as [{"contents": {"kind": "Namespace", "metadata": {"name": "apps", "labels": {"pod-security.kubernetes.io/enforce": "strict"}}}}]
+}
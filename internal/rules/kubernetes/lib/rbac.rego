@@ -0,0 +1,103 @@
+package lib.rbac
+
+import data.lib.cluster
+
+# These rules analyse the combined input of all of the scanned manifests, so that roles can be matched to their bindings
+
+roles[role] {
+	role := cluster.objects[_]
+	role.kind == {"Role", "ClusterRole"}[_]
+}
+
+bindings[binding] {
+	binding := cluster.objects[_]
+	binding.kind == {"RoleBinding", "ClusterRoleBinding"}[_]
+}
+
+# bound holds each role and a binding which grants it. A RoleBinding can grant a Role in its own namespace, or a
+# ClusterRole, whereas a ClusterRoleBinding can only grant a ClusterRole.
+bound[[role, binding]] {
+	binding := bindings[_]
+	role := roles[_]
+	role.kind == binding.roleRef.kind
+	role.metadata.name == binding.roleRef.name
+	same_scope(role, binding)
+}
+
+same_scope(role, binding) {
+	role.kind == "ClusterRole"
+}
+
+same_scope(role, binding) {
+	role.kind == "Role"
+	binding.kind == "RoleBinding"
+	cluster.same_namespace(role, binding)
+}
+
+# builtin_bindings holds bindings of the ClusterRoles Kubernetes creates which grant broad access to the cluster
+builtin_bindings[binding] {
+	binding := bindings[_]
+	binding.roleRef.kind == "ClusterRole"
+	binding.roleRef.name == {"cluster-admin", "admin", "edit"}[_]
+	not bound_role(binding)
+}
+
+bound_role(binding) {
+	bound[[_, binding]]
+}
+
+wildcard_rule(rule) {
+	rule.verbs[_] == "*"
+}
+
+wildcard_rule(rule) {
+	rule.resources[_] == "*"
+}
+
+escalation_verbs := {"escalate", "bind", "impersonate"}
+
+escalation_verbs_of(rule) = {verb | verb := rule.verbs[_]; escalation_verbs[verb]}
+
+escalation_rule(rule) {
+	count(escalation_verbs_of(rule)) > 0
+}
+
+sensitive_resources := {"secrets", "pods/exec", "nodes/proxy"}
+
+sensitive_resources_of(rule) = {resource | resource := rule.resources[_]; sensitive_resources[resource]}
+
+sensitive_rule(rule) {
+	count(sensitive_resources_of(rule)) > 0
+}
+
+powerful_rule(rule) {
+	wildcard_rule(rule)
+}
+
+powerful_rule(rule) {
+	escalation_rule(rule)
+}
+
+powerful_rule(rule) {
+	sensitive_rule(rule)
+}
+
+powerful_role(role) {
+	powerful_rule(role.rules[_])
+}
+
+# public_subject is a subject which any user, or any workload using the default service account, belongs to
+public_subject(subject) {
+	subject.kind == "User"
+	subject.name == "system:anonymous"
+}
+
+public_subject(subject) {
+	subject.kind == "Group"
+	subject.name == {"system:anonymous", "system:authenticated", "system:unauthenticated"}[_]
+}
+
+public_subject(subject) {
+	subject.kind == "ServiceAccount"
+	subject.name == "default"
+}
@@ -0,0 +1,40 @@
+package lib.rbac
+
+test_role_bound_in_same_namespace {
+	r := bound with input as [
+		{"contents": {"kind": "Role", "metadata": {"name": "reader"}}},
+		{"contents": {
+			"kind": "RoleBinding",
+			"metadata": {"name": "reader-binding", "namespace": "default"},
+			"roleRef": {"kind": "Role", "name": "reader"},
+		}},
+	]
+
+	count(r) == 1
+}
+
+test_role_not_bound_by_cluster_role_binding {
+	r := bound with input as [
+		{"contents": {"kind": "Role", "metadata": {"name": "reader"}}},
+		{"contents": {
+			"kind": "ClusterRoleBinding",
+			"metadata": {"name": "reader-binding"},
+			"roleRef": {"kind": "Role", "name": "reader"},
+		}},
+	]
+
+	count(r) == 0
+}
+
+test_cluster_role_bound_by_role_binding {
+	r := bound with input as [
+		{"contents": {"kind": "ClusterRole", "metadata": {"name": "reader"}}},
+		{"contents": {
+			"kind": "RoleBinding",
+			"metadata": {"name": "reader-binding", "namespace": "apps"},
+			"roleRef": {"kind": "ClusterRole", "name": "reader"},
+		}},
+	]
+
+	count(r) == 1
+}
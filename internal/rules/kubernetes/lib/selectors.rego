@@ -0,0 +1,52 @@
+package lib.selectors
+
+# labels_of returns the labels of a map, without the metadata added to it by the scanner
+labels_of(labels) = {key: value | value := labels[key]; key != "__defsec_metadata"}
+
+# matches_labels is true if all of the labels of a map selector, such as the selector of a Service, are set on the
+# given labels. An empty map selector selects nothing.
+matches_labels(selector, labels) {
+	count(labels_of(selector)) > 0
+	not mismatched_label(selector, labels)
+}
+
+mismatched_label(selector, labels) {
+	value := labels_of(selector)[key]
+	not labels[key] == value
+}
+
+# matches_selector is true if a label selector, such as the podSelector of a NetworkPolicy, selects the given labels.
+# An empty label selector selects everything.
+matches_selector(selector, labels) {
+	not mismatched_label(object.get(selector, "matchLabels", {}), labels)
+	not mismatched_expression(selector, labels)
+}
+
+mismatched_expression(selector, labels) {
+	expression := selector.matchExpressions[_]
+	not matches_expression(expression, labels)
+}
+
+matches_expression(expression, labels) {
+	expression.operator == "In"
+	labels[expression.key] == expression.values[_]
+}
+
+matches_expression(expression, labels) {
+	expression.operator == "NotIn"
+	not has_value(labels, expression.key, expression.values)
+}
+
+matches_expression(expression, labels) {
+	expression.operator == "Exists"
+	_ = labels[expression.key]
+}
+
+matches_expression(expression, labels) {
+	expression.operator == "DoesNotExist"
+	not labels[expression.key]
+}
+
+has_value(labels, key, values) {
+	labels[key] == values[_]
+}
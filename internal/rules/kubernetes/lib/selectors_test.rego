@@ -0,0 +1,36 @@
+package lib.selectors
+
+test_matches_labels {
+	matches_labels({"app": "web"}, {"app": "web", "tier": "frontend"})
+	not matches_labels({"app": "web", "tier": "backend"}, {"app": "web", "tier": "frontend"})
+	not matches_labels({}, {"app": "web"})
+}
+
+test_matches_labels_ignores_metadata {
+	matches_labels(
+		{"app": "web", "__defsec_metadata": {"startline": 3}},
+		{"app": "web", "__defsec_metadata": {"startline": 10}},
+	)
+}
+
+test_matches_selector {
+	matches_selector({}, {"app": "web"})
+	matches_selector({"matchLabels": {"app": "web"}}, {"app": "web"})
+	not matches_selector({"matchLabels": {"app": "api"}}, {"app": "web"})
+}
+
+test_matches_selector_expressions {
+	labels := {"app": "web", "tier": "frontend"}
+	matches_selector({"matchExpressions": [{"key": "tier", "operator": "In", "values": ["frontend", "edge"]}]}, labels)
+	not matches_selector({"matchExpressions": [{"key": "tier", "operator": "NotIn", "values": ["frontend"]}]}, labels)
+	matches_selector({"matchExpressions": [{"key": "env", "operator": "NotIn", "values": ["prod"]}]}, labels)
+	matches_selector({"matchExpressions": [{"key": "app", "operator": "Exists"}]}, labels)
+	not matches_selector({"matchExpressions": [{"key": "app", "operator": "DoesNotExist"}]}, labels)
+	not matches_selector(
+		{
+			"matchLabels": {"app": "web"},
+			"matchExpressions": [{"key": "env", "operator": "Exists"}],
+		},
+		labels,
+	)
+}
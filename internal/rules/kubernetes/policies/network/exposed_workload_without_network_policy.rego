@@ -0,0 +1,34 @@
+package builtin.kubernetes.KSV045
+
+import data.lib.cluster
+import data.lib.result
+
+__rego_metadata__ := {
+	"id": "KSV045",
+	"avd_id": "AVD-KSV-0045",
+	"title": "Workloads exposed outside of the cluster should be selected by a network policy",
+	"short_code": "no-exposed-workload-without-network-policy",
+	"version": "v1.0.0",
+	"severity": "MEDIUM",
+	"type": "Kubernetes Security Check",
+	"description": "Pods which are exposed outside of the cluster by a LoadBalancer or NodePort Service accept traffic from any other pod in the cluster too, unless a network policy restricts ingress to them.",
+	"recommended_actions": "Add a NetworkPolicy in the namespace of the workload which selects its pods and restricts ingress to them.",
+	"url": "https://kubernetes.io/docs/concepts/services-networking/network-policies/",
+}
+
+__rego_input__ := {
+	"combine": true,
+	"selector": [{"type": "kubernetes"}],
+}
+
+exposed_types := {"LoadBalancer", "NodePort"}
+
+deny[res] {
+	service := cluster.services[_]
+	exposed_types[service.spec.type]
+	workload := cluster.workloads[_]
+	cluster.service_selects(service, workload)
+	not cluster.ingress_isolated(workload)
+	msg := sprintf("%s '%s' is exposed by %s Service '%s', but no NetworkPolicy restricts ingress to its pods", [workload.kind, workload.metadata.name, service.spec.type, service.metadata.name])
+	res := result.with_related(result.new(msg, workload), cluster.name(service), service)
+}
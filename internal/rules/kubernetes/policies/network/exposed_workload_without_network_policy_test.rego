@@ -0,0 +1,101 @@
+package builtin.kubernetes.KSV045
+
+deployment := {"path": "deployment.yaml", "contents": {
+	"kind": "Deployment",
+	"metadata": {"name": "web", "namespace": "apps"},
+	"spec": {
+		"selector": {"matchLabels": {"app": "web"}},
+		"template": {
+			"metadata": {"labels": {"app": "web", "tier": "frontend"}},
+			"spec": {"containers": [{"name": "web", "image": "nginx"}]},
+		},
+	},
+}}
+
+service(type) = {"path": "service.yaml", "contents": {
+	"kind": "Service",
+	"metadata": {"name": "web", "namespace": "apps"},
+	"spec": {
+		"type": type,
+		"selector": {"app": "web"},
+		"ports": [{"port": 80}],
+	},
+}}
+
+test_load_balancer_without_network_policy_denied {
+	r := deny with input as [deployment, service("LoadBalancer")]
+
+	count(r) == 1
+	r[_].msg == "Deployment 'web' is exposed by LoadBalancer Service 'web', but no NetworkPolicy restricts ingress to its pods"
+	r[_].related[_].resource == "Service/web"
+}
+
+test_node_port_without_network_policy_denied {
+	r := deny with input as [deployment, service("NodePort")]
+
+	count(r) == 1
+}
+
+test_cluster_ip_allowed {
+	r := deny with input as [deployment, service("ClusterIP")]
+
+	count(r) == 0
+}
+
+test_network_policy_selecting_pods_allowed {
+	r := deny with input as [
+		deployment,
+		service("LoadBalancer"),
+		{"path": "policy.yaml", "contents": {
+			"kind": "NetworkPolicy",
+			"metadata": {"name": "web", "namespace": "apps"},
+			"spec": {
+				"podSelector": {"matchExpressions": [{"key": "tier", "operator": "In", "values": ["frontend"]}]},
+				"ingress": [{"from": [{"namespaceSelector": {"matchLabels": {"name": "ingress"}}}]}],
+			},
+		}},
+	]
+
+	count(r) == 0
+}
+
+test_network_policy_in_other_namespace_denied {
+	r := deny with input as [
+		deployment,
+		service("LoadBalancer"),
+		{"path": "policy.yaml", "contents": {
+			"kind": "NetworkPolicy",
+			"metadata": {"name": "default-deny", "namespace": "other"},
+			"spec": {"podSelector": {}},
+		}},
+	]
+
+	count(r) == 1
+}
+
+test_egress_network_policy_denied {
+	r := deny with input as [
+		deployment,
+		service("LoadBalancer"),
+		{"path": "policy.yaml", "contents": {
+			"kind": "NetworkPolicy",
+			"metadata": {"name": "egress", "namespace": "apps"},
+			"spec": {"podSelector": {}, "policyTypes": ["Egress"]},
+		}},
+	]
+
+	count(r) == 1
+}
+
+test_service_in_other_namespace_allowed {
+	r := deny with input as [
+		deployment,
+		{"path": "service.yaml", "contents": {
+			"kind": "Service",
+			"metadata": {"name": "web"},
+			"spec": {"type": "LoadBalancer", "selector": {"app": "web"}},
+		}},
+	]
+
+	count(r) == 0
+}
@@ -0,0 +1,57 @@
+package builtin.kubernetes.KSV046
+
+import data.lib.cluster
+import data.lib.result
+
+__rego_metadata__ := {
+	"id": "KSV046",
+	"avd_id": "AVD-KSV-0046",
+	"title": "Ingress rules should use TLS for the services they route to",
+	"short_code": "use-ingress-tls",
+	"version": "v1.0.0",
+	"severity": "MEDIUM",
+	"type": "Kubernetes Security Check",
+	"description": "Traffic to the backend services of Ingress rules whose host is not covered by the TLS configuration of the Ingress is sent over plain HTTP, where it can be intercepted or modified.",
+	"recommended_actions": "Add the host of the rule to the 'spec.tls[].hosts' of the Ingress, along with the secret holding its certificate.",
+	"url": "https://kubernetes.io/docs/concepts/services-networking/ingress/#tls",
+}
+
+__rego_input__ := {
+	"combine": true,
+	"selector": [{"type": "kubernetes"}],
+}
+
+# A TLS entry without hosts applies to the default host of the ingress controller
+tls_covers(ingress, host) {
+	tls := ingress.spec.tls[_]
+	not tls.hosts
+}
+
+tls_covers(ingress, host) {
+	pattern := ingress.spec.tls[_].hosts[_]
+	glob.match(pattern, ["."], host)
+}
+
+backend_service(backend) = name {
+	name := backend.service.name
+} else = name {
+	name := backend.serviceName
+}
+
+with_service(res, ingress, name) = out {
+	service := cluster.find("Service", name, ingress)
+	out := result.with_related(res, cluster.name(service), service)
+} else = res {
+	true
+}
+
+deny[res] {
+	ingress := cluster.ingresses[_]
+	rule := ingress.spec.rules[_]
+	host := object.get(rule, "host", "")
+	not tls_covers(ingress, host)
+	path := rule.http.paths[_]
+	name := backend_service(path.backend)
+	msg := sprintf("Ingress '%s' should use TLS for host '%s', which routes to Service '%s'", [ingress.metadata.name, host, name])
+	res := with_service(result.new(msg, path), ingress, name)
+}
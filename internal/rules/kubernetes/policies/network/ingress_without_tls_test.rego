@@ -0,0 +1,62 @@
+package builtin.kubernetes.KSV046
+
+service := {"path": "service.yaml", "contents": {
+	"kind": "Service",
+	"metadata": {"name": "web", "namespace": "apps"},
+	"spec": {"selector": {"app": "web"}, "ports": [{"port": 80}]},
+}}
+
+ingress(tls) = {"path": "ingress.yaml", "contents": {
+	"kind": "Ingress",
+	"metadata": {"name": "web", "namespace": "apps"},
+	"spec": {
+		"tls": tls,
+		"rules": [{
+			"host": "web.example.com",
+			"http": {"paths": [{
+				"path": "/",
+				"pathType": "Prefix",
+				"backend": {"service": {"name": "web", "port": {"number": 80}}},
+			}]},
+		}],
+	},
+}}
+
+test_ingress_without_tls_denied {
+	r := deny with input as [service, ingress([])]
+
+	count(r) == 1
+	r[_].msg == "Ingress 'web' should use TLS for host 'web.example.com', which routes to Service 'web'"
+	r[_].related[_].resource == "Service/web"
+}
+
+test_ingress_with_tls_for_other_host_denied {
+	r := deny with input as [service, ingress([{"hosts": ["api.example.com"], "secretName": "api-tls"}])]
+
+	count(r) == 1
+}
+
+test_ingress_with_tls_allowed {
+	r := deny with input as [service, ingress([{"hosts": ["web.example.com"], "secretName": "web-tls"}])]
+
+	count(r) == 0
+}
+
+test_ingress_with_wildcard_tls_allowed {
+	r := deny with input as [service, ingress([{"hosts": ["*.example.com"], "secretName": "example-tls"}])]
+
+	count(r) == 0
+}
+
+test_ingress_without_service_in_scan_denied {
+	r := deny with input as [{"path": "ingress.yaml", "contents": {
+		"apiVersion": "networking.k8s.io/v1beta1",
+		"kind": "Ingress",
+		"metadata": {"name": "legacy"},
+		"spec": {"rules": [{"http": {"paths": [{"backend": {"serviceName": "legacy", "servicePort": 80}}]}}]},
+	}}]
+
+	count(r) == 1
+	r[_].msg == "Ingress 'legacy' should use TLS for host '', which routes to Service 'legacy'"
+	count({res | res := r[_]; res.related}) == 0
+}
@@ -0,0 +1,33 @@
+package builtin.kubernetes.KSV047
+
+import data.lib.cluster
+import data.lib.pss
+import data.lib.result
+
+__rego_metadata__ := {
+	"id": "KSV047",
+	"avd_id": "AVD-KSV-0047",
+	"title": "Workloads should satisfy the Pod Security Standard enforced by their namespace",
+	"short_code": "satisfy-enforced-pod-security-standard",
+	"version": "v1.0.0",
+	"severity": "HIGH",
+	"type": "Kubernetes Security Check",
+	"description": "The Pod Security Admission controller rejects pods which do not satisfy the Pod Security Standard set by the 'pod-security.kubernetes.io/enforce' label of their namespace, so workloads which would be rejected cannot run.",
+	"recommended_actions": "Fix the failed checks of the workload, or deploy it to a namespace which enforces a level it satisfies.",
+	"url": "https://kubernetes.io/docs/concepts/security/pod-security-admission/",
+}
+
+__rego_input__ := {
+	"combine": true,
+	"selector": [{"type": "kubernetes"}],
+}
+
+deny[res] {
+	workload := cluster.workloads[_]
+	[ns, enforced] := pss.enforced_level(workload)
+	level := pss.level(workload)
+	pss.levels[level] < pss.levels[enforced]
+	failed := concat(", ", sort(pss.failed_checks(workload, pss.checks_for(enforced))))
+	msg := sprintf("%s '%s' satisfies the '%s' Pod Security Standard, but namespace '%s' enforces '%s' (failed checks: %s)", [workload.kind, workload.metadata.name, level, ns.metadata.name, enforced, failed])
+	res := result.with_related(result.new(msg, workload), cluster.name(ns), ns)
+}
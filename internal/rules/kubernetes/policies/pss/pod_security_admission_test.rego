@@ -0,0 +1,94 @@
+package builtin.kubernetes.KSV047
+
+namespace(level) = {"path": "namespace.yaml", "contents": {
+	"kind": "Namespace",
+	"metadata": {
+		"name": "apps",
+		"labels": {"pod-security.kubernetes.io/enforce": level},
+	},
+}}
+
+privileged_pod := {"path": "pod.yaml", "contents": {
+	"kind": "Pod",
+	"metadata": {"name": "privileged", "namespace": "apps"},
+	"spec": {"containers": [{
+		"name": "app",
+		"image": "app",
+		"securityContext": {"privileged": true},
+	}]},
+}}
+
+baseline_pod := {"path": "pod.yaml", "contents": {
+	"kind": "Pod",
+	"metadata": {"name": "baseline", "namespace": "apps"},
+	"spec": {"containers": [{"name": "app", "image": "app"}]},
+}}
+
+restricted_deployment := {"path": "deployment.yaml", "contents": {
+	"kind": "Deployment",
+	"metadata": {"name": "restricted", "namespace": "apps"},
+	"spec": {"template": {"spec": {
+		"securityContext": {
+			"runAsNonRoot": true,
+			"runAsGroup": 1000,
+			"seccompProfile": {"type": "RuntimeDefault"},
+		},
+		"containers": [{
+			"name": "app",
+			"image": "app",
+			"securityContext": {
+				"allowPrivilegeEscalation": false,
+				"runAsNonRoot": true,
+				"runAsGroup": 1000,
+				"capabilities": {"drop": ["ALL"]},
+				"seccompProfile": {"type": "RuntimeDefault"},
+			},
+		}],
+	}}},
+}}
+
+test_privileged_pod_in_baseline_namespace_denied {
+	r := deny with input as [namespace("baseline"), privileged_pod]
+
+	count(r) == 1
+	r[_].msg == "Pod 'privileged' satisfies the 'privileged' Pod Security Standard, but namespace 'apps' enforces 'baseline' (failed checks: KSV017)"
+	r[_].related[_].resource == "Namespace/apps"
+}
+
+test_baseline_pod_in_restricted_namespace_denied {
+	r := deny with input as [namespace("restricted"), baseline_pod]
+
+	count(r) == 1
+	startswith(r[_].msg, "Pod 'baseline' satisfies the 'baseline' Pod Security Standard, but namespace 'apps' enforces 'restricted'")
+}
+
+test_baseline_pod_in_baseline_namespace_allowed {
+	r := deny with input as [namespace("baseline"), baseline_pod]
+
+	count(r) == 0
+}
+
+test_restricted_deployment_in_restricted_namespace_allowed {
+	r := deny with input as [namespace("restricted"), restricted_deployment]
+
+	count(r) == 0
+}
+
+test_privileged_namespace_allowed {
+	r := deny with input as [namespace("privileged"), privileged_pod]
+
+	count(r) == 0
+}
+
+test_workload_in_other_namespace_allowed {
+	r := deny with input as [
+		namespace("restricted"),
+		{"path": "pod.yaml", "contents": {
+			"kind": "Pod",
+			"metadata": {"name": "privileged"},
+			"spec": {"containers": [{"name": "app", "image": "app", "securityContext": {"privileged": true}}]},
+		}},
+	]
+
+	count(r) == 0
+}
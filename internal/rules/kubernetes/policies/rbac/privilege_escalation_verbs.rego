@@ -0,0 +1,32 @@
+package builtin.kubernetes.KSV042
+
+import data.lib.cluster
+import data.lib.rbac
+import data.lib.result
+
+__rego_metadata__ := {
+	"id": "KSV042",
+	"avd_id": "AVD-KSV-0042",
+	"title": "Bound roles should not allow the escalate, bind or impersonate verbs",
+	"short_code": "no-privilege-escalation-verbs-role",
+	"version": "v1.0.0",
+	"severity": "CRITICAL",
+	"type": "Kubernetes Security Check",
+	"description": "The escalate and bind verbs allow subjects to create roles and bindings with permissions they do not have themselves, and impersonate allows them to act as other users, groups or service accounts.",
+	"recommended_actions": "Remove the escalate, bind and impersonate verbs from the role.",
+	"url": "https://kubernetes.io/docs/concepts/security/rbac-good-practices/#escalate-verb",
+}
+
+__rego_input__ := {
+	"combine": true,
+	"selector": [{"type": "kubernetes"}],
+}
+
+deny[res] {
+	[role, binding] := rbac.bound[_]
+	rule := role.rules[_]
+	rbac.escalation_rule(rule)
+	verbs := concat("', '", sort(rbac.escalation_verbs_of(rule)))
+	msg := sprintf("%s '%s' bound by %s '%s' should not allow the verbs '%s'", [role.kind, role.metadata.name, binding.kind, binding.metadata.name, verbs])
+	res := result.with_related(result.new(msg, rule), cluster.name(binding), binding)
+}
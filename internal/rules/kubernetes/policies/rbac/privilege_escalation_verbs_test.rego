@@ -0,0 +1,56 @@
+package builtin.kubernetes.KSV042
+
+test_escalation_verbs_denied {
+	r := deny with input as [
+		{"path": "role.yaml", "contents": {
+			"kind": "ClusterRole",
+			"metadata": {"name": "role-admin"},
+			"rules": [{"apiGroups": ["rbac.authorization.k8s.io"], "resources": ["clusterroles"], "verbs": ["get", "escalate", "bind"]}],
+		}},
+		{"path": "binding.yaml", "contents": {
+			"kind": "RoleBinding",
+			"metadata": {"name": "role-admin-binding", "namespace": "apps"},
+			"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "role-admin"},
+			"subjects": [{"kind": "User", "name": "alice"}],
+		}},
+	]
+
+	count(r) == 1
+	r[_].msg == "ClusterRole 'role-admin' bound by RoleBinding 'role-admin-binding' should not allow the verbs 'bind', 'escalate'"
+}
+
+test_impersonate_denied {
+	r := deny with input as [
+		{"path": "role.yaml", "contents": {
+			"kind": "ClusterRole",
+			"metadata": {"name": "impersonator"},
+			"rules": [{"apiGroups": [""], "resources": ["users"], "verbs": ["impersonate"]}],
+		}},
+		{"path": "binding.yaml", "contents": {
+			"kind": "ClusterRoleBinding",
+			"metadata": {"name": "impersonator-binding"},
+			"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "impersonator"},
+			"subjects": [{"kind": "User", "name": "alice"}],
+		}},
+	]
+
+	count(r) == 1
+}
+
+test_read_only_verbs_allowed {
+	r := deny with input as [
+		{"path": "role.yaml", "contents": {
+			"kind": "ClusterRole",
+			"metadata": {"name": "reader"},
+			"rules": [{"apiGroups": ["rbac.authorization.k8s.io"], "resources": ["clusterroles"], "verbs": ["get", "list"]}],
+		}},
+		{"path": "binding.yaml", "contents": {
+			"kind": "ClusterRoleBinding",
+			"metadata": {"name": "reader-binding"},
+			"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "reader"},
+			"subjects": [{"kind": "User", "name": "alice"}],
+		}},
+	]
+
+	count(r) == 0
+}
@@ -0,0 +1,40 @@
+package builtin.kubernetes.KSV044
+
+import data.lib.cluster
+import data.lib.rbac
+import data.lib.result
+
+__rego_metadata__ := {
+	"id": "KSV044",
+	"avd_id": "AVD-KSV-0044",
+	"title": "Privileged roles should not be bound to anonymous, authenticated or default service account subjects",
+	"short_code": "no-public-subjects-privileged-role",
+	"version": "v1.0.0",
+	"severity": "CRITICAL",
+	"type": "Kubernetes Security Check",
+	"description": "Binding a role which uses wildcards, the escalate, bind or impersonate verbs, or grants access to secrets, pods/exec or nodes/proxy, to system:anonymous, system:authenticated, system:unauthenticated or a default service account grants it to every user of the cluster, or to every workload which does not set its own service account.",
+	"recommended_actions": "Bind the role to dedicated users, groups or service accounts instead.",
+	"url": "https://kubernetes.io/docs/concepts/security/rbac-good-practices/#least-privilege",
+}
+
+__rego_input__ := {
+	"combine": true,
+	"selector": [{"type": "kubernetes"}],
+}
+
+deny[res] {
+	[role, binding] := rbac.bound[_]
+	rbac.powerful_role(role)
+	subject := binding.subjects[_]
+	rbac.public_subject(subject)
+	msg := sprintf("%s '%s' should not bind the privileged %s '%s' to %s '%s'", [binding.kind, binding.metadata.name, role.kind, role.metadata.name, subject.kind, subject.name])
+	res := result.with_related(result.new(msg, subject), cluster.name(role), role)
+}
+
+deny[res] {
+	binding := rbac.builtin_bindings[_]
+	subject := binding.subjects[_]
+	rbac.public_subject(subject)
+	msg := sprintf("%s '%s' should not bind the built-in ClusterRole '%s' to %s '%s'", [binding.kind, binding.metadata.name, binding.roleRef.name, subject.kind, subject.name])
+	res := result.new(msg, subject)
+}
@@ -0,0 +1,90 @@
+package builtin.kubernetes.KSV044
+
+test_privileged_role_bound_to_anonymous_denied {
+	r := deny with input as [
+		{"path": "role.yaml", "contents": {
+			"kind": "ClusterRole",
+			"metadata": {"name": "secret-reader"},
+			"rules": [{"apiGroups": [""], "resources": ["secrets"], "verbs": ["get"]}],
+		}},
+		{"path": "binding.yaml", "contents": {
+			"kind": "ClusterRoleBinding",
+			"metadata": {"name": "secret-reader-binding"},
+			"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "secret-reader"},
+			"subjects": [
+				{"kind": "User", "name": "system:anonymous"},
+				{"kind": "User", "name": "alice"},
+			],
+		}},
+	]
+
+	count(r) == 1
+	r[_].msg == "ClusterRoleBinding 'secret-reader-binding' should not bind the privileged ClusterRole 'secret-reader' to User 'system:anonymous'"
+	r[_].related[_].resource == "ClusterRole/secret-reader"
+}
+
+test_privileged_role_bound_to_default_service_account_denied {
+	r := deny with input as [
+		{"path": "role.yaml", "contents": {
+			"kind": "Role",
+			"metadata": {"name": "deployer", "namespace": "apps"},
+			"rules": [{"apiGroups": ["*"], "resources": ["*"], "verbs": ["*"]}],
+		}},
+		{"path": "binding.yaml", "contents": {
+			"kind": "RoleBinding",
+			"metadata": {"name": "deployer-binding", "namespace": "apps"},
+			"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "Role", "name": "deployer"},
+			"subjects": [{"kind": "ServiceAccount", "name": "default", "namespace": "apps"}],
+		}},
+	]
+
+	count(r) == 1
+}
+
+test_builtin_role_bound_to_authenticated_denied {
+	r := deny with input as [{"path": "binding.yaml", "contents": {
+		"kind": "ClusterRoleBinding",
+		"metadata": {"name": "everyone-admin"},
+		"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "cluster-admin"},
+		"subjects": [{"kind": "Group", "name": "system:authenticated"}],
+	}}]
+
+	count(r) == 1
+	r[_].msg == "ClusterRoleBinding 'everyone-admin' should not bind the built-in ClusterRole 'cluster-admin' to Group 'system:authenticated'"
+}
+
+test_unprivileged_role_bound_to_authenticated_allowed {
+	r := deny with input as [
+		{"path": "role.yaml", "contents": {
+			"kind": "ClusterRole",
+			"metadata": {"name": "pod-reader"},
+			"rules": [{"apiGroups": [""], "resources": ["pods"], "verbs": ["get", "list"]}],
+		}},
+		{"path": "binding.yaml", "contents": {
+			"kind": "ClusterRoleBinding",
+			"metadata": {"name": "pod-reader-binding"},
+			"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "pod-reader"},
+			"subjects": [{"kind": "Group", "name": "system:authenticated"}],
+		}},
+	]
+
+	count(r) == 0
+}
+
+test_privileged_role_bound_to_named_service_account_allowed {
+	r := deny with input as [
+		{"path": "role.yaml", "contents": {
+			"kind": "ClusterRole",
+			"metadata": {"name": "secret-reader"},
+			"rules": [{"apiGroups": [""], "resources": ["secrets"], "verbs": ["get"]}],
+		}},
+		{"path": "binding.yaml", "contents": {
+			"kind": "ClusterRoleBinding",
+			"metadata": {"name": "secret-reader-binding"},
+			"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "secret-reader"},
+			"subjects": [{"kind": "ServiceAccount", "name": "vault", "namespace": "vault"}],
+		}},
+	]
+
+	count(r) == 0
+}
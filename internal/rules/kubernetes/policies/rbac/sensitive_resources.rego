@@ -0,0 +1,32 @@
+package builtin.kubernetes.KSV043
+
+import data.lib.cluster
+import data.lib.rbac
+import data.lib.result
+
+__rego_metadata__ := {
+	"id": "KSV043",
+	"avd_id": "AVD-KSV-0043",
+	"title": "Bound roles should not grant access to secrets, pods/exec or nodes/proxy",
+	"short_code": "no-sensitive-resources-role",
+	"version": "v1.0.0",
+	"severity": "HIGH",
+	"type": "Kubernetes Security Check",
+	"description": "Access to secrets exposes the credentials stored in the cluster, pods/exec allows commands to be run in any matching container, and nodes/proxy allows the kubelet API to be called directly.",
+	"recommended_actions": "Remove the secrets, pods/exec and nodes/proxy resources from the role, or restrict them to the named resources the subjects of the binding need.",
+	"url": "https://kubernetes.io/docs/concepts/security/rbac-good-practices/#kubernetes-rbac---privilege-escalation-risks",
+}
+
+__rego_input__ := {
+	"combine": true,
+	"selector": [{"type": "kubernetes"}],
+}
+
+deny[res] {
+	[role, binding] := rbac.bound[_]
+	rule := role.rules[_]
+	rbac.sensitive_rule(rule)
+	resources := concat("', '", sort(rbac.sensitive_resources_of(rule)))
+	msg := sprintf("%s '%s' bound by %s '%s' should not grant access to '%s'", [role.kind, role.metadata.name, binding.kind, binding.metadata.name, resources])
+	res := result.with_related(result.new(msg, rule), cluster.name(binding), binding)
+}
@@ -0,0 +1,59 @@
+package builtin.kubernetes.KSV043
+
+test_secrets_denied {
+	r := deny with input as [
+		{"path": "role.yaml", "contents": {
+			"kind": "Role",
+			"metadata": {"name": "secret-reader", "namespace": "apps"},
+			"rules": [{"apiGroups": [""], "resources": ["configmaps", "secrets"], "verbs": ["get"]}],
+		}},
+		{"path": "binding.yaml", "contents": {
+			"kind": "RoleBinding",
+			"metadata": {"name": "secret-reader-binding", "namespace": "apps"},
+			"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "Role", "name": "secret-reader"},
+			"subjects": [{"kind": "User", "name": "alice"}],
+		}},
+	]
+
+	count(r) == 1
+	r[_].msg == "Role 'secret-reader' bound by RoleBinding 'secret-reader-binding' should not grant access to 'secrets'"
+}
+
+test_exec_and_proxy_denied {
+	r := deny with input as [
+		{"path": "role.yaml", "contents": {
+			"kind": "ClusterRole",
+			"metadata": {"name": "debugger"},
+			"rules": [
+				{"apiGroups": [""], "resources": ["pods/exec"], "verbs": ["create"]},
+				{"apiGroups": [""], "resources": ["nodes/proxy"], "verbs": ["get"]},
+			],
+		}},
+		{"path": "binding.yaml", "contents": {
+			"kind": "ClusterRoleBinding",
+			"metadata": {"name": "debugger-binding"},
+			"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "debugger"},
+			"subjects": [{"kind": "User", "name": "alice"}],
+		}},
+	]
+
+	count(r) == 2
+}
+
+test_other_resources_allowed {
+	r := deny with input as [
+		{"path": "role.yaml", "contents": {
+			"kind": "Role",
+			"metadata": {"name": "reader"},
+			"rules": [{"apiGroups": [""], "resources": ["pods", "pods/log"], "verbs": ["get"]}],
+		}},
+		{"path": "binding.yaml", "contents": {
+			"kind": "RoleBinding",
+			"metadata": {"name": "reader-binding"},
+			"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "Role", "name": "reader"},
+			"subjects": [{"kind": "User", "name": "alice"}],
+		}},
+	]
+
+	count(r) == 0
+}
@@ -0,0 +1,31 @@
+package builtin.kubernetes.KSV041
+
+import data.lib.cluster
+import data.lib.rbac
+import data.lib.result
+
+__rego_metadata__ := {
+	"id": "KSV041",
+	"avd_id": "AVD-KSV-0041",
+	"title": "Bound roles should not use wildcards in verbs or resources",
+	"short_code": "no-wildcard-verb-resource-role",
+	"version": "v1.0.0",
+	"severity": "CRITICAL",
+	"type": "Kubernetes Security Check",
+	"description": "Roles and ClusterRoles which use a wildcard in their verbs or resources grant every action on every matching resource, including resources which are added to the cluster later.",
+	"recommended_actions": "List the verbs and resources the subjects of the binding need explicitly, instead of using '*'.",
+	"url": "https://kubernetes.io/docs/concepts/security/rbac-good-practices/#least-privilege",
+}
+
+__rego_input__ := {
+	"combine": true,
+	"selector": [{"type": "kubernetes"}],
+}
+
+deny[res] {
+	[role, binding] := rbac.bound[_]
+	rule := role.rules[_]
+	rbac.wildcard_rule(rule)
+	msg := sprintf("%s '%s' bound by %s '%s' should not use wildcards in its verbs or resources", [role.kind, role.metadata.name, binding.kind, binding.metadata.name])
+	res := result.with_related(result.new(msg, rule), cluster.name(binding), binding)
+}
@@ -0,0 +1,85 @@
+package builtin.kubernetes.KSV041
+
+test_wildcard_verbs_denied {
+	r := deny with input as [
+		{"path": "role.yaml", "contents": {
+			"kind": "ClusterRole",
+			"metadata": {"name": "everything"},
+			"rules": [{"apiGroups": [""], "resources": ["pods"], "verbs": ["*"]}],
+		}},
+		{"path": "binding.yaml", "contents": {
+			"kind": "ClusterRoleBinding",
+			"metadata": {"name": "everything-binding"},
+			"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "everything"},
+			"subjects": [{"kind": "User", "name": "alice"}],
+		}},
+	]
+
+	count(r) == 1
+	r[_].msg == "ClusterRole 'everything' bound by ClusterRoleBinding 'everything-binding' should not use wildcards in its verbs or resources"
+	r[_].related[_].resource == "ClusterRoleBinding/everything-binding"
+}
+
+test_wildcard_resources_denied {
+	r := deny with input as [
+		{"path": "role.yaml", "contents": {
+			"kind": "Role",
+			"metadata": {"name": "reader", "namespace": "apps"},
+			"rules": [{"apiGroups": [""], "resources": ["*"], "verbs": ["get"]}],
+		}},
+		{"path": "binding.yaml", "contents": {
+			"kind": "RoleBinding",
+			"metadata": {"name": "reader-binding", "namespace": "apps"},
+			"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "Role", "name": "reader"},
+			"subjects": [{"kind": "User", "name": "alice"}],
+		}},
+	]
+
+	count(r) == 1
+}
+
+test_unbound_role_allowed {
+	r := deny with input as [{"path": "role.yaml", "contents": {
+		"kind": "ClusterRole",
+		"metadata": {"name": "everything"},
+		"rules": [{"apiGroups": ["*"], "resources": ["*"], "verbs": ["*"]}],
+	}}]
+
+	count(r) == 0
+}
+
+test_role_in_other_namespace_allowed {
+	r := deny with input as [
+		{"path": "role.yaml", "contents": {
+			"kind": "Role",
+			"metadata": {"name": "reader", "namespace": "apps"},
+			"rules": [{"apiGroups": [""], "resources": ["*"], "verbs": ["get"]}],
+		}},
+		{"path": "binding.yaml", "contents": {
+			"kind": "RoleBinding",
+			"metadata": {"name": "reader-binding", "namespace": "other"},
+			"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "Role", "name": "reader"},
+			"subjects": [{"kind": "User", "name": "alice"}],
+		}},
+	]
+
+	count(r) == 0
+}
+
+test_explicit_permissions_allowed {
+	r := deny with input as [
+		{"path": "role.yaml", "contents": {
+			"kind": "ClusterRole",
+			"metadata": {"name": "reader"},
+			"rules": [{"apiGroups": [""], "resources": ["pods"], "verbs": ["get", "list"]}],
+		}},
+		{"path": "binding.yaml", "contents": {
+			"kind": "ClusterRoleBinding",
+			"metadata": {"name": "reader-binding"},
+			"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "reader"},
+			"subjects": [{"kind": "User", "name": "alice"}],
+		}},
+	]
+
+	count(r) == 0
+}
@@ -68,7 +68,7 @@ func (b *boolValue) IsFalse() bool {
 }
 
 func (s *boolValue) ToRego() interface{} {
-	return map[string]interface{}{
+	return s.metadata.withRegoDetails(map[string]interface{}{
 		"filepath":  s.metadata.Range().GetFilename(),
 		"startline": s.metadata.Range().GetStartLine(),
 		"endline":   s.metadata.Range().GetEndLine(),
@@ -77,5 +77,5 @@ func (s *boolValue) ToRego() interface{} {
 		"value":     s.Value(),
 		"fskey":     CreateFSKey(s.metadata.Range().GetFS()),
 		"resource":  s.metadata.Reference().String(),
-	}
+	})
 }
@@ -61,7 +61,7 @@ func BytesUnresolvable(m Metadata) BytesValue {
 }
 
 func (s *bytesValue) ToRego() interface{} {
-	return map[string]interface{}{
+	return s.metadata.withRegoDetails(map[string]interface{}{
 		"filepath":  s.metadata.Range().GetFilename(),
 		"startline": s.metadata.Range().GetStartLine(),
 		"endline":   s.metadata.Range().GetEndLine(),
@@ -70,5 +70,5 @@ func (s *bytesValue) ToRego() interface{} {
 		"value":     string(s.Value()),
 		"fskey":     CreateFSKey(s.metadata.Range().GetFS()),
 		"resource":  s.metadata.Reference().String(),
-	}
+	})
 }
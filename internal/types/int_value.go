@@ -88,7 +88,7 @@ func (b *intValue) GreaterThan(i int) bool {
 }
 
 func (s *intValue) ToRego() interface{} {
-	return map[string]interface{}{
+	return s.metadata.withRegoDetails(map[string]interface{}{
 		"filepath":  s.metadata.Range().GetFilename(),
 		"startline": s.metadata.Range().GetStartLine(),
 		"endline":   s.metadata.Range().GetEndLine(),
@@ -97,5 +97,5 @@ func (s *intValue) ToRego() interface{} {
 		"value":     s.Value(),
 		"fskey":     CreateFSKey(s.metadata.Range().GetFS()),
 		"resource":  s.metadata.Reference().String(),
-	}
+	})
 }
@@ -60,7 +60,7 @@ func (b *mapValue) HasKey(key string) bool {
 }
 
 func (s *mapValue) ToRego() interface{} {
-	return map[string]interface{}{
+	return s.metadata.withRegoDetails(map[string]interface{}{
 		"filepath":  s.metadata.Range().GetFilename(),
 		"startline": s.metadata.Range().GetStartLine(),
 		"endline":   s.metadata.Range().GetEndLine(),
@@ -69,5 +69,5 @@ func (s *mapValue) ToRego() interface{} {
 		"value":     s.Value(),
 		"fskey":     CreateFSKey(s.metadata.Range().GetFS()),
 		"resource":  s.metadata.Reference().String(),
-	}
+	})
 }
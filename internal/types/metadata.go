@@ -13,6 +13,7 @@ type Metadata struct {
 	isExplicit     bool
 	isUnresolvable bool
 	parent         *Metadata
+	provenance     []ProvenanceStep
 }
 
 func (m *Metadata) ToRego() interface{} {
@@ -26,7 +27,7 @@ func (m *Metadata) ToRego() interface{} {
 	if ref := m.Reference(); ref != nil {
 		refStr = ref.String()
 	}
-	return map[string]interface{}{
+	return m.withRegoDetails(map[string]interface{}{
 		"filepath":  m.Range().GetFilename(),
 		"startline": m.Range().GetStartLine(),
 		"endline":   m.Range().GetEndLine(),
@@ -34,7 +35,34 @@ func (m *Metadata) ToRego() interface{} {
 		"explicit":  m.isExplicit,
 		"fskey":     CreateFSKey(m.Range().GetFS()),
 		"resource":  refStr,
+	})
+}
+
+// withRegoDetails adds whether the value could not be resolved, and its provenance chain, if any, to the rego
+// representation of the metadata, so that rego results can report them
+func (m Metadata) withRegoDetails(output map[string]interface{}) map[string]interface{} {
+	if m.isUnresolvable {
+		output["unresolvable"] = true
+	}
+	if len(m.provenance) == 0 {
+		return output
+	}
+	var steps []interface{}
+	for _, step := range m.provenance {
+		regoStep := map[string]interface{}{
+			"kind": string(step.Kind),
+			"name": step.Name,
+		}
+		if step.Range != nil {
+			regoStep["filepath"] = step.Range.GetFilename()
+			regoStep["startline"] = step.Range.GetStartLine()
+			regoStep["endline"] = step.Range.GetEndLine()
+			regoStep["fskey"] = CreateFSKey(step.Range.GetFS())
+		}
+		steps = append(steps, regoStep)
 	}
+	output["provenance"] = steps
+	return output
 }
 
 func NewMetadata(r Range, ref Reference) Metadata {
@@ -72,6 +100,16 @@ func (m Metadata) Parent() *Metadata {
 	return m.parent
 }
 
+// WithProvenance records the chain of definitions which supplied the value, nearest first
+func (m Metadata) WithProvenance(steps ...ProvenanceStep) Metadata {
+	m.provenance = steps
+	return m
+}
+
+func (m Metadata) Provenance() []ProvenanceStep {
+	return m.provenance
+}
+
 func (m Metadata) IsMultiLine() bool {
 	return m.rnge.GetStartLine() < m.rnge.GetEndLine()
 }
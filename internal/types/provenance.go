@@ -0,0 +1,31 @@
+package types
+
+import "fmt"
+
+// ProvenanceKind describes what supplied a step of a value's provenance chain
+type ProvenanceKind string
+
+const (
+	ProvenanceLocal           ProvenanceKind = "local"
+	ProvenanceVariable        ProvenanceKind = "variable"
+	ProvenanceVariableDefault ProvenanceKind = "variable_default"
+	ProvenanceTFVars          ProvenanceKind = "tfvars"
+	ProvenanceEnvironment     ProvenanceKind = "environment"
+	ProvenanceInputVar        ProvenanceKind = "input_var"
+	ProvenanceModuleArgument  ProvenanceKind = "module_argument"
+)
+
+// ProvenanceStep is a single link in the chain of definitions which supplied a value. The range is nil for values
+// which did not come from a file, such as environment variables.
+type ProvenanceStep struct {
+	Kind  ProvenanceKind
+	Name  string
+	Range Range
+}
+
+func (s ProvenanceStep) String() string {
+	if s.Range == nil {
+		return fmt.Sprintf("%s (%s)", s.Name, s.Kind)
+	}
+	return fmt.Sprintf("%s (%s) at %s", s.Name, s.Kind, s.Range)
+}
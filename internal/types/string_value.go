@@ -70,7 +70,7 @@ func (v *stringValue) MarshalJSON() ([]byte, error) {
 }
 
 func (s *stringValue) ToRego() interface{} {
-	return map[string]interface{}{
+	return s.metadata.withRegoDetails(map[string]interface{}{
 		"filepath":  s.metadata.Range().GetFilename(),
 		"startline": s.metadata.Range().GetStartLine(),
 		"endline":   s.metadata.Range().GetEndLine(),
@@ -79,7 +79,7 @@ func (s *stringValue) ToRego() interface{} {
 		"value":     s.Value(),
 		"fskey":     CreateFSKey(s.metadata.Range().GetFS()),
 		"resource":  s.metadata.Reference().String(),
-	}
+	})
 }
 
 func (s *stringValue) IsOneOf(values ...string) bool {
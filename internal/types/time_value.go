@@ -83,7 +83,7 @@ func (b *timeValue) GreaterThan(i time.Time) bool {
 }
 
 func (s *timeValue) ToRego() interface{} {
-	return map[string]interface{}{
+	return s.metadata.withRegoDetails(map[string]interface{}{
 		"filepath":  s.metadata.Range().GetFilename(),
 		"startline": s.metadata.Range().GetStartLine(),
 		"endline":   s.metadata.Range().GetEndLine(),
@@ -92,5 +92,5 @@ func (s *timeValue) ToRego() interface{} {
 		"value":     s.Value().Format(time.RFC3339),
 		"fskey":     CreateFSKey(s.metadata.Range().GetFS()),
 		"resource":  s.metadata.Reference().String(),
-	}
+	})
 }
@@ -3,6 +3,7 @@ package detection
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
 	"io/ioutil"
 	"path/filepath"
@@ -170,40 +171,25 @@ func init() {
 			return false
 		}
 
-		expectedProperties := []string{"apiVersion", "kind", "metadata", "spec"}
-
 		if IsType(name, r, FileTypeJSON) {
 			var result map[string]interface{}
 			if err := json.Unmarshal(contents, &result); err != nil {
 				return false
 			}
-			for _, expected := range expectedProperties {
-				if _, ok := result[expected]; !ok {
-					return false
-				}
-			}
-			return true
+			return isKubernetesManifest(result)
 		}
 
-		marker := "\n---\n"
-		altMarker := "\r\n---\r\n"
-		if bytes.Contains(contents, []byte(altMarker)) {
-			marker = altMarker
-		}
-
-		for _, partial := range strings.Split(string(contents), marker) {
+		decoder := yaml.NewDecoder(bytes.NewReader(contents))
+		for {
 			var result map[string]interface{}
-			if err := yaml.Unmarshal([]byte(partial), &result); err != nil {
-				continue
-			}
-			match := true
-			for _, expected := range expectedProperties {
-				if _, ok := result[expected]; !ok {
-					match = false
-					break
+			if err := decoder.Decode(&result); err != nil {
+				var typeErr *yaml.TypeError
+				if errors.As(err, &typeErr) {
+					continue
 				}
+				break
 			}
-			if match {
+			if isKubernetesManifest(result) {
 				return true
 			}
 		}
@@ -212,6 +198,33 @@ func init() {
 	}
 }
 
+// kubernetesKindProperties lists the properties of kinds which do not have a spec, other than apiVersion and kind
+var kubernetesKindProperties = map[string][]string{
+	"List":               {"items"},
+	"Namespace":          {"metadata"},
+	"ServiceAccount":     {"metadata"},
+	"Role":               {"metadata", "rules"},
+	"ClusterRole":        {"metadata", "rules"},
+	"RoleBinding":        {"metadata", "roleRef"},
+	"ClusterRoleBinding": {"metadata", "roleRef"},
+}
+
+// isKubernetesManifest returns true if the document is a Kubernetes resource, or a List of resources
+func isKubernetesManifest(document map[string]interface{}) bool {
+	expectedProperties := []string{"apiVersion", "kind", "metadata", "spec"}
+	if kind, ok := document["kind"].(string); ok {
+		if properties, ok := kubernetesKindProperties[kind]; ok {
+			expectedProperties = append([]string{"apiVersion", "kind"}, properties...)
+		}
+	}
+	for _, expected := range expectedProperties {
+		if _, ok := document[expected]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 func IsType(name string, r io.ReadSeeker, t FileType) bool {
 	r = ensureSeeker(r)
 	f, ok := matchers[t]
@@ -166,6 +166,71 @@ spec:
 				FileTypeYAML,
 			},
 		},
+		{
+			name: "kubernetes, list with separators",
+			path: "k8s.yml",
+			r: strings.NewReader(`--- # exported with kubectl
+# an empty document
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ServiceAccount
+  metadata:
+    name: default`),
+			expected: []FileType{
+				FileTypeKubernetes,
+				FileTypeYAML,
+			},
+		},
+		{
+			name: "kubernetes, role binding",
+			path: "k8s.yml",
+			r: strings.NewReader(`apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: read-pods
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: pod-reader
+subjects:
+- kind: User
+  name: jane`),
+			expected: []FileType{
+				FileTypeKubernetes,
+				FileTypeYAML,
+			},
+		},
+		{
+			name: "kubernetes, namespace",
+			path: "k8s.yml",
+			r: strings.NewReader(`apiVersion: v1
+kind: Namespace
+metadata:
+  name: apps
+  labels:
+    pod-security.kubernetes.io/enforce: restricted`),
+			expected: []FileType{
+				FileTypeKubernetes,
+				FileTypeYAML,
+			},
+		},
+		{
+			name: "kubernetes, service account",
+			path: "k8s.yml",
+			r: strings.NewReader(`apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: builder
+  namespace: ci
+automountServiceAccountToken: false`),
+			expected: []FileType{
+				FileTypeKubernetes,
+				FileTypeYAML,
+			},
+		},
 		{
 			name: "YAML, no reader",
 			path: "file.yaml",
@@ -23,6 +23,7 @@ type API struct {
 	ProtocolType types.StringValue
 	Stages       []Stage
 	RESTMethods  []RESTMethod
+	Tags         types.MapValue
 }
 
 type Stage struct {
@@ -32,6 +33,7 @@ type Stage struct {
 	AccessLogging      AccessLogging
 	RESTMethodSettings RESTMethodSettings
 	XRayTracingEnabled types.BoolValue
+	Tags               types.MapValue
 }
 
 type AccessLogging struct {
@@ -64,4 +66,5 @@ type DomainName struct {
 	Name           types.StringValue
 	Version        types.IntValue
 	SecurityPolicy types.StringValue
+	Tags           types.MapValue
 }
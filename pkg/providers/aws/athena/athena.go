@@ -20,6 +20,7 @@ type Workgroup struct {
 	Name                 types.StringValue
 	Encryption           EncryptionConfiguration
 	EnforceConfiguration types.BoolValue
+	Tags                 types.MapValue
 }
 
 const (
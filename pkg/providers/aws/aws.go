@@ -32,6 +32,7 @@ import (
 	"github.com/aquasecurity/defsec/pkg/providers/aws/redshift"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/s3"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/sam"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/sfn"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/sns"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/sqs"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/ssm"
@@ -70,6 +71,7 @@ type AWS struct {
 	RDS           rds.RDS
 	Redshift      redshift.Redshift
 	SAM           sam.SAM
+	SFN           sfn.SFN
 	S3            s3.S3
 	SNS           sns.SNS
 	SQS           sqs.SQS
@@ -15,6 +15,7 @@ type Distribution struct {
 	DefaultCacheBehaviour  CacheBehaviour
 	OrdererCacheBehaviours []CacheBehaviour
 	ViewerCertificate      ViewerCertificate
+	Tags                   types.MapValue
 }
 
 type Logging struct {
@@ -14,4 +14,5 @@ type Trail struct {
 	EnableLogFileValidation types.BoolValue
 	IsMultiRegion           types.BoolValue
 	KMSKeyID                types.StringValue
+	Tags                    types.MapValue
 }
@@ -13,4 +13,5 @@ type LogGroup struct {
 	Name            types.StringValue
 	KMSKeyID        types.StringValue
 	RetentionInDays types.IntValue
+	Tags            types.MapValue
 }
@@ -12,6 +12,7 @@ type Project struct {
 	types.Metadata
 	ArtifactSettings          ArtifactSettings
 	SecondaryArtifactSettings []ArtifactSettings
+	Tags                      types.MapValue
 }
 
 type ArtifactSettings struct {
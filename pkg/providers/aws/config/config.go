@@ -12,4 +12,5 @@ type ConfigurationAggregrator struct {
 	types.Metadata
 	SourceAllRegions types.BoolValue
 	IsDefined        bool
+	Tags             types.MapValue
 }
@@ -20,9 +20,11 @@ type Cluster struct {
 	Instances         []Instance
 	StorageEncrypted  types.BoolValue
 	KMSKeyID          types.StringValue
+	Tags              types.MapValue
 }
 
 type Instance struct {
 	types.Metadata
 	KMSKeyID types.StringValue
+	Tags     types.MapValue
 }
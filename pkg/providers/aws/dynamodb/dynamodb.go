@@ -13,12 +13,14 @@ type DAXCluster struct {
 	types.Metadata
 	ServerSideEncryption ServerSideEncryption
 	PointInTimeRecovery  types.BoolValue
+	Tags                 types.MapValue
 }
 
 type Table struct {
 	types.Metadata
 	ServerSideEncryption ServerSideEncryption
 	PointInTimeRecovery  types.BoolValue
+	Tags                 types.MapValue
 }
 
 type ServerSideEncryption struct {
@@ -12,6 +12,7 @@ type EBS struct {
 type Volume struct {
 	types.Metadata
 	Encryption Encryption
+	Tags       types.MapValue
 }
 
 type Encryption struct {
@@ -13,6 +13,7 @@ type Instance struct {
 	SecurityGroups  []vpc.SecurityGroup
 	RootBlockDevice *BlockDevice
 	EBSBlockDevices []BlockDevice
+	Tags            types.MapValue
 }
 
 type BlockDevice struct {
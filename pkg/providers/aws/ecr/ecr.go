@@ -15,6 +15,7 @@ type Repository struct {
 	ImageTagsImmutable types.BoolValue
 	Policies           []iam.Policy
 	Encryption         Encryption
+	Tags               types.MapValue
 }
 
 type ImageScanning struct {
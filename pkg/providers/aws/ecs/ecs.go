@@ -12,6 +12,7 @@ type ECS struct {
 type Cluster struct {
 	types.Metadata
 	Settings ClusterSettings
+	Tags     types.MapValue
 }
 
 type ClusterSettings struct {
@@ -23,6 +24,7 @@ type TaskDefinition struct {
 	types.Metadata
 	Volumes              []Volume
 	ContainerDefinitions types.StringValue
+	Tags                 types.MapValue
 }
 
 type Volume struct {
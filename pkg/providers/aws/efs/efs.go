@@ -11,4 +11,5 @@ type EFS struct {
 type FileSystem struct {
 	types.Metadata
 	Encrypted types.BoolValue
+	Tags      types.MapValue
 }
@@ -14,6 +14,7 @@ type Cluster struct {
 	Encryption          Encryption
 	PublicAccessEnabled types.BoolValue
 	PublicAccessCIDRs   []types.StringValue
+	Tags                types.MapValue
 }
 
 type Logging struct {
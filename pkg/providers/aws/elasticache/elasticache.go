@@ -15,12 +15,14 @@ type Cluster struct {
 	Engine                 types.StringValue
 	NodeType               types.StringValue
 	SnapshotRetentionLimit types.IntValue // days
+	Tags                   types.MapValue
 }
 
 type ReplicationGroup struct {
 	types.Metadata
 	TransitEncryptionEnabled types.BoolValue
 	AtRestEncryptionEnabled  types.BoolValue
+	Tags                     types.MapValue
 }
 
 type SecurityGroup struct {
@@ -15,6 +15,7 @@ type Domain struct {
 	TransitEncryption TransitEncryption
 	AtRestEncryption  AtRestEncryption
 	Endpoint          Endpoint
+	Tags              types.MapValue
 }
 
 type Endpoint struct {
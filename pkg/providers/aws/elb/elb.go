@@ -21,6 +21,7 @@ type LoadBalancer struct {
 	DropInvalidHeaderFields types.BoolValue
 	Internal                types.BoolValue
 	Listeners               []Listener
+	Tags                    types.MapValue
 }
 
 type Listener struct {
@@ -12,6 +12,7 @@ type EMR struct {
 type Cluster struct {
 	types.Metadata
 	Settings ClusterSettings
+	Tags     types.MapValue
 }
 
 type ClusterSettings struct {
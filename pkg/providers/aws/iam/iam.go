@@ -57,12 +57,14 @@ type User struct {
 	Name     types.StringValue
 	Groups   []Group
 	Policies []Policy
+	Tags     types.MapValue
 }
 
 type Role struct {
 	types.Metadata
 	Name     types.StringValue
 	Policies []Policy
+	Tags     types.MapValue
 }
 
 func (d Document) MetadataFromIamGo(r ...iamgo.Range) types.Metadata {
@@ -11,6 +11,7 @@ type Kinesis struct {
 type Stream struct {
 	types.Metadata
 	Encryption Encryption
+	Tags       types.MapValue
 }
 
 const (
@@ -16,4 +16,5 @@ type Key struct {
 	types.Metadata
 	Usage           types.StringValue
 	RotationEnabled types.BoolValue
+	Tags            types.MapValue
 }
@@ -12,6 +12,7 @@ type Function struct {
 	types.Metadata
 	Tracing     Tracing
 	Permissions []Permission
+	Tags        types.MapValue
 }
 
 const (
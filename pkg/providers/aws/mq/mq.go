@@ -12,6 +12,7 @@ type Broker struct {
 	types.Metadata
 	PublicAccess types.BoolValue
 	Logging      Logging
+	Tags         types.MapValue
 }
 
 type Logging struct {
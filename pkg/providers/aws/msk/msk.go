@@ -12,6 +12,7 @@ type Cluster struct {
 	types.Metadata
 	EncryptionInTransit EncryptionInTransit
 	Logging             Logging
+	Tags                types.MapValue
 }
 
 const (
@@ -13,6 +13,7 @@ type Cluster struct {
 	Logging          Logging
 	StorageEncrypted types.BoolValue
 	KMSKeyID         types.StringValue
+	Tags             types.MapValue
 }
 
 type Logging struct {
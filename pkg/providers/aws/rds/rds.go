@@ -17,6 +17,7 @@ type Cluster struct {
 	PerformanceInsights       PerformanceInsights
 	Instances                 []ClusterInstance
 	Encryption                Encryption
+	Tags                      types.MapValue
 }
 
 type Encryption struct {
@@ -32,6 +33,7 @@ type Instance struct {
 	PerformanceInsights       PerformanceInsights
 	Encryption                Encryption
 	PublicAccess              types.BoolValue
+	Tags                      types.MapValue
 }
 
 type ClusterInstance struct {
@@ -18,6 +18,7 @@ type Cluster struct {
 	types.Metadata
 	Encryption      Encryption
 	SubnetGroupName types.StringValue
+	Tags            types.MapValue
 }
 
 type Encryption struct {
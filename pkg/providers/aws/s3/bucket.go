@@ -14,6 +14,7 @@ type Bucket struct {
 	Versioning        Versioning
 	Logging           Logging
 	ACL               types.StringValue
+	Tags              types.MapValue
 }
 
 func (b *Bucket) HasPublicExposureACL() bool {
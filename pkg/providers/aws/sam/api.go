@@ -11,6 +11,7 @@ type API struct {
 	DomainConfiguration DomainConfiguration
 	AccessLogging       AccessLogging
 	RESTMethodSettings  RESTMethodSettings
+	Tags                types.MapValue
 }
 
 type ApiAuth struct {
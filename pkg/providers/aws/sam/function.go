@@ -11,6 +11,7 @@ type Function struct {
 	Tracing         types.StringValue
 	ManagedPolicies []types.StringValue
 	Policies        []iam.Policy
+	Tags            types.MapValue
 }
 
 const (
@@ -10,6 +10,7 @@ type HttpAPI struct {
 	AccessLogging        AccessLogging
 	DefaultRouteSettings RouteSettings
 	DomainConfiguration  DomainConfiguration
+	Tags                 types.MapValue
 }
 
 type RouteSettings struct {
@@ -3,6 +3,7 @@ package sam
 import (
 	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/providers/aws/iam"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/sfn"
 )
 
 type StateMachine struct {
@@ -12,6 +13,8 @@ type StateMachine struct {
 	ManagedPolicies      []types.StringValue
 	Policies             []iam.Policy
 	Tracing              TracingConfiguration
+	Definition           sfn.Definition
+	Tags                 types.MapValue
 }
 
 type LoggingConfiguration struct {
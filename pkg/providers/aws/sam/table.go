@@ -8,6 +8,7 @@ type SimpleTable struct {
 	types.Metadata
 	TableName        types.StringValue
 	SSESpecification SSESpecification
+	Tags             types.MapValue
 }
 
 type SSESpecification struct {
@@ -0,0 +1,123 @@
+package sfn
+
+import (
+	"sort"
+
+	"github.com/aquasecurity/defsec/internal/types"
+
+	"github.com/liamg/jfather"
+)
+
+type rawDefinition struct {
+	Comment *string              `json:"Comment"`
+	StartAt *string              `json:"StartAt"`
+	States  map[string]*rawState `json:"States"`
+}
+
+type rawState struct {
+	Type          *string          `json:"Type"`
+	Resource      *string          `json:"Resource"`
+	Branches      []*rawDefinition `json:"Branches"`
+	Iterator      *rawDefinition   `json:"Iterator"`
+	ItemProcessor *rawDefinition   `json:"ItemProcessor"`
+
+	startLine int
+	endLine   int
+}
+
+func (s *rawState) UnmarshalJSONWithMetadata(node jfather.Node) error {
+	// decoded through another type, as decoding into rawState itself would call this method again
+	type plainState rawState
+	if err := node.Decode((*plainState)(s)); err != nil {
+		return err
+	}
+	s.startLine, s.endLine = node.Range().Start.Line, node.Range().End.Line
+	return nil
+}
+
+// ParseDefinition parses a state machine definition written in the Amazon States Language. The individual states
+// aren't located within the definition, so are all given the metadata of the definition itself.
+func ParseDefinition(content []byte, metadata types.Metadata) (Definition, error) {
+	return ParseDefinitionAt(content, metadata, 0)
+}
+
+// ParseDefinitionAt parses a state machine definition which appears line for line in the source described by the
+// metadata, starting on the given line, so that each state is given its own location. If the line is 0 the states
+// are given the metadata of the definition itself.
+func ParseDefinitionAt(content []byte, metadata types.Metadata, line int) (Definition, error) {
+	var raw rawDefinition
+	if err := jfather.Unmarshal(content, &raw); err != nil {
+		return Definition{}, err
+	}
+	return raw.adapt(metadata, line), nil
+}
+
+// UnresolvableDefinition returns a definition whose content could not be determined, such as one which fails to parse
+// or is stored remotely
+func UnresolvableDefinition(metadata types.Metadata) Definition {
+	unresolvable := types.NewUnresolvableMetadata(metadata.Range(), metadata.Reference())
+	return Definition{
+		Metadata: unresolvable,
+		Comment:  types.StringUnresolvable(unresolvable),
+		StartAt:  types.StringUnresolvable(unresolvable),
+	}
+}
+
+func (r *rawDefinition) adapt(metadata types.Metadata, line int) Definition {
+	definition := Definition{
+		Metadata: metadata,
+		Comment:  optionalString(r.Comment, metadata),
+		StartAt:  optionalString(r.StartAt, metadata),
+	}
+
+	// the states are a JSON object, so are sorted to give a stable order
+	var names []string
+	for name := range r.States {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		raw := r.States[name]
+		if raw == nil {
+			continue
+		}
+		stateMetadata := raw.locate(metadata, line)
+		state := State{
+			Metadata: stateMetadata,
+			Name:     types.String(name, stateMetadata),
+			Type:     optionalString(raw.Type, stateMetadata),
+			Resource: optionalString(raw.Resource, stateMetadata),
+		}
+		for _, branch := range raw.Branches {
+			if branch != nil {
+				state.Branches = append(state.Branches, branch.adapt(stateMetadata, line))
+			}
+		}
+		for _, processor := range []*rawDefinition{raw.Iterator, raw.ItemProcessor} {
+			if processor != nil {
+				state.Branches = append(state.Branches, processor.adapt(stateMetadata, line))
+			}
+		}
+		definition.States = append(definition.States, state)
+	}
+	return definition
+}
+
+// locate returns the metadata for the lines of the source which hold the state. States in sources from remote modules
+// keep the metadata of the definition, as their ranges can't be rebuilt with the same filename.
+func (s *rawState) locate(metadata types.Metadata, line int) types.Metadata {
+	rng := metadata.Range()
+	if line == 0 || rng == nil || rng.GetSourcePrefix() != "" || s.startLine == 0 {
+		return metadata
+	}
+	located := types.NewRangeWithFSKey(rng.GetFilename(), line+s.startLine-1, line+s.endLine-1, "", rng.GetFSKey(), rng.GetFS())
+	return types.NewMetadata(located, metadata.Reference()).WithParent(metadata)
+}
+
+func optionalString(value *string, metadata types.Metadata) types.StringValue {
+	if value == nil {
+		return types.StringDefault("", metadata)
+	}
+	return types.String(*value, metadata)
+}
@@ -0,0 +1,74 @@
+package sfn
+
+import (
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/providers/aws/iam"
+)
+
+type SFN struct {
+	StateMachines []StateMachine
+}
+
+type StateMachine struct {
+	types.Metadata
+	Name       types.StringValue
+	Logging    Logging
+	Tracing    Tracing
+	Policies   []iam.Policy
+	Definition Definition
+	Tags       types.MapValue
+}
+
+const (
+	LoggingLevelAll   = "ALL"
+	LoggingLevelError = "ERROR"
+	LoggingLevelFatal = "FATAL"
+	LoggingLevelOff   = "OFF"
+)
+
+type Logging struct {
+	types.Metadata
+	Level                types.StringValue
+	IncludeExecutionData types.BoolValue
+}
+
+type Tracing struct {
+	types.Metadata
+	Enabled types.BoolValue
+}
+
+const (
+	StateTypeTask     = "Task"
+	StateTypeParallel = "Parallel"
+	StateTypeMap      = "Map"
+)
+
+// Definition is a state machine written in the Amazon States Language
+type Definition struct {
+	types.Metadata
+	Comment types.StringValue
+	StartAt types.StringValue
+	States  []State
+}
+
+type State struct {
+	types.Metadata
+	Name     types.StringValue
+	Type     types.StringValue
+	Resource types.StringValue
+	// Branches holds the branches of a Parallel state and the item processor of a Map state
+	Branches []Definition
+}
+
+// AllStates returns the states of the definition, including the states nested in the branches of Parallel and Map
+// states
+func (d Definition) AllStates() []State {
+	var states []State
+	for _, state := range d.States {
+		states = append(states, state)
+		for _, branch := range state.Branches {
+			states = append(states, branch.AllStates()...)
+		}
+	}
+	return states
+}
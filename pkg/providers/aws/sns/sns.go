@@ -11,6 +11,7 @@ type SNS struct {
 type Topic struct {
 	types.Metadata
 	Encryption Encryption
+	Tags       types.MapValue
 }
 
 type Encryption struct {
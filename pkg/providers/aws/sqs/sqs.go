@@ -13,6 +13,7 @@ type Queue struct {
 	types.Metadata
 	Encryption Encryption
 	Policies   []iam.Policy
+	Tags       types.MapValue
 }
 
 type Encryption struct {
@@ -11,6 +11,7 @@ type SSM struct {
 type Secret struct {
 	types.Metadata
 	KMSKeyID types.StringValue
+	Tags     types.MapValue
 }
 
 const DefaultKMSKeyID = "alias/aws/secretsmanager"
@@ -13,6 +13,7 @@ type VPC struct {
 type NetworkACL struct {
 	types.Metadata
 	Rules []NetworkACLRule
+	Tags  types.MapValue
 }
 
 type SecurityGroup struct {
@@ -20,6 +21,7 @@ type SecurityGroup struct {
 	Description  types.StringValue
 	IngressRules []SecurityGroupRule
 	EgressRules  []SecurityGroupRule
+	Tags         types.MapValue
 }
 
 type SecurityGroupRule struct {
@@ -30,6 +32,7 @@ type SecurityGroupRule struct {
 
 type DefaultVPC struct {
 	types.Metadata
+	Tags types.MapValue
 }
 
 const (
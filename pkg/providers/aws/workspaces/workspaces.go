@@ -12,6 +12,7 @@ type WorkSpace struct {
 	types.Metadata
 	RootVolume Volume
 	UserVolume Volume
+	Tags       types.MapValue
 }
 
 type Volume struct {
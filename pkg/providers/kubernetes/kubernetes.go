@@ -6,6 +6,12 @@ import (
 
 type Kubernetes struct {
 	NetworkPolicies []NetworkPolicy
+	Workloads       []Workload
+	Services        []Service
+	Ingresses       []IngressResource
+	Roles           []Role
+	RoleBindings    []RoleBinding
+	ServiceAccounts []ServiceAccount
 }
 
 type NetworkPolicy struct {
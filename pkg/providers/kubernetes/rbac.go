@@ -0,0 +1,54 @@
+package kubernetes
+
+import (
+	"github.com/aquasecurity/defsec/internal/types"
+)
+
+// Role is a Role, or a ClusterRole when its Kind says so
+type Role struct {
+	types.Metadata
+	Kind      types.StringValue
+	Name      types.StringValue
+	Namespace types.StringValue
+	Rules     []PolicyRule
+}
+
+type PolicyRule struct {
+	types.Metadata
+	APIGroups     []types.StringValue
+	Resources     []types.StringValue
+	ResourceNames []types.StringValue
+	Verbs         []types.StringValue
+}
+
+// RoleBinding is a RoleBinding, or a ClusterRoleBinding when its Kind says so
+type RoleBinding struct {
+	types.Metadata
+	Kind      types.StringValue
+	Name      types.StringValue
+	Namespace types.StringValue
+	RoleRef   RoleRef
+	Subjects  []Subject
+}
+
+type RoleRef struct {
+	types.Metadata
+	APIGroup types.StringValue
+	Kind     types.StringValue
+	Name     types.StringValue
+}
+
+type Subject struct {
+	types.Metadata
+	Kind      types.StringValue
+	Name      types.StringValue
+	Namespace types.StringValue
+	APIGroup  types.StringValue
+}
+
+type ServiceAccount struct {
+	types.Metadata
+	Name                         types.StringValue
+	Namespace                    types.StringValue
+	AutomountServiceAccountToken types.BoolValue
+}
@@ -0,0 +1,56 @@
+package kubernetes
+
+import (
+	"github.com/aquasecurity/defsec/internal/types"
+)
+
+type Service struct {
+	types.Metadata
+	Name        types.StringValue
+	Namespace   types.StringValue
+	Labels      types.MapValue
+	Type        types.StringValue
+	Selector    types.MapValue
+	Ports       []ServicePort
+	ExternalIPs []types.StringValue
+}
+
+type ServicePort struct {
+	types.Metadata
+	Port     types.IntValue
+	NodePort types.IntValue
+	// TargetPort is a port number or the name of a container port
+	TargetPort types.StringValue
+	Protocol   types.StringValue
+}
+
+// IngressResource is an Ingress, which routes HTTP traffic to services. It is not to be confused with the Ingress of
+// a NetworkPolicy.
+type IngressResource struct {
+	types.Metadata
+	Name      types.StringValue
+	Namespace types.StringValue
+	Labels    types.MapValue
+	Rules     []IngressRule
+	TLS       []IngressTLS
+}
+
+type IngressRule struct {
+	types.Metadata
+	Host  types.StringValue
+	Paths []IngressPath
+}
+
+type IngressPath struct {
+	types.Metadata
+	Path        types.StringValue
+	ServiceName types.StringValue
+	// ServicePort is a port number or the name of a service port
+	ServicePort types.StringValue
+}
+
+type IngressTLS struct {
+	types.Metadata
+	Hosts      []types.StringValue
+	SecretName types.StringValue
+}
@@ -0,0 +1,94 @@
+package kubernetes
+
+import (
+	"github.com/aquasecurity/defsec/internal/types"
+)
+
+// Workload is a resource which runs pods: a Pod, Deployment, StatefulSet, DaemonSet, ReplicaSet, Job or CronJob
+type Workload struct {
+	types.Metadata
+	Kind        types.StringValue
+	Name        types.StringValue
+	Namespace   types.StringValue
+	Labels      types.MapValue
+	Annotations types.MapValue
+	Replicas    types.IntValue
+	// Selector is the matchLabels of the label selector, which is empty for Pods, Jobs and CronJobs
+	Selector types.MapValue
+	// Pod is the spec of the Pod itself, or of the pod template of other workloads
+	Pod PodSpec
+}
+
+type PodSpec struct {
+	types.Metadata
+	// Labels are those of the Pod, or of the pod template
+	Labels                       types.MapValue
+	ServiceAccountName           types.StringValue
+	AutomountServiceAccountToken types.BoolValue
+	HostNetwork                  types.BoolValue
+	HostPID                      types.BoolValue
+	HostIPC                      types.BoolValue
+	SecurityContext              PodSecurityContext
+	Containers                   []Container
+	InitContainers               []Container
+	Volumes                      []Volume
+}
+
+type PodSecurityContext struct {
+	types.Metadata
+	RunAsNonRoot       types.BoolValue
+	RunAsUser          types.IntValue
+	RunAsGroup         types.IntValue
+	FSGroup            types.IntValue
+	SeccompProfileType types.StringValue
+}
+
+type Container struct {
+	types.Metadata
+	Name            types.StringValue
+	Image           types.StringValue
+	ImagePullPolicy types.StringValue
+	SecurityContext SecurityContext
+	Ports           []ContainerPort
+	Resources       Resources
+}
+
+type SecurityContext struct {
+	types.Metadata
+	Privileged               types.BoolValue
+	AllowPrivilegeEscalation types.BoolValue
+	ReadOnlyRootFilesystem   types.BoolValue
+	RunAsNonRoot             types.BoolValue
+	RunAsUser                types.IntValue
+	RunAsGroup               types.IntValue
+	SeccompProfileType       types.StringValue
+	Capabilities             Capabilities
+}
+
+type Capabilities struct {
+	types.Metadata
+	Add  []types.StringValue
+	Drop []types.StringValue
+}
+
+type ContainerPort struct {
+	types.Metadata
+	ContainerPort types.IntValue
+	HostPort      types.IntValue
+	Protocol      types.StringValue
+}
+
+type Resources struct {
+	types.Metadata
+	Limits   types.MapValue
+	Requests types.MapValue
+}
+
+type Volume struct {
+	types.Metadata
+	Name types.StringValue
+	// Type is the kind of volume source, as it is named in manifests, e.g. "hostPath" or "emptyDir"
+	Type types.StringValue
+	// HostPath is the path on the node of a hostPath volume
+	HostPath types.StringValue
+}
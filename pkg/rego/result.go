@@ -20,8 +20,22 @@ type regoResult struct {
 	Message   string
 	Explicit  bool
 	Managed   bool
+	// Unresolvable is set when the result was reported against a value which could not be resolved
+	Unresolvable bool
+	FSKey        string
+	FS           fs.FS
+	Provenance   []regoProvenanceStep
+	Related      []regoResult
+}
+
+// regoProvenanceStep is a step of the provenance chain of the value a result was reported against
+type regoProvenanceStep struct {
+	Kind      string
+	Name      string
+	Filepath  string
+	StartLine int
+	EndLine   int
 	FSKey     string
-	FS        fs.FS
 }
 
 func (r regoResult) GetMetadata() types.Metadata {
@@ -30,10 +44,41 @@ func (r regoResult) GetMetadata() types.Metadata {
 	}
 	rng := types.NewRangeWithFSKey(r.Filepath, r.StartLine, r.EndLine, "", r.FSKey, r.FS)
 	ref := types.NewNamedReference(r.Resource)
-	if r.Explicit {
-		return types.NewExplicitMetadata(rng, ref)
+	var metadata types.Metadata
+	switch {
+	case r.Unresolvable:
+		metadata = types.NewUnresolvableMetadata(rng, ref)
+	case r.Explicit:
+		metadata = types.NewExplicitMetadata(rng, ref)
+	default:
+		metadata = types.NewMetadata(rng, ref)
 	}
-	return types.NewMetadata(rng, ref)
+	if len(r.Provenance) > 0 {
+		var steps []types.ProvenanceStep
+		for _, step := range r.Provenance {
+			provenanceStep := types.ProvenanceStep{
+				Kind: types.ProvenanceKind(step.Kind),
+				Name: step.Name,
+			}
+			if step.Filepath != "" {
+				provenanceStep.Range = types.NewRangeWithFSKey(step.Filepath, step.StartLine, step.EndLine, "", step.FSKey, r.FS)
+			}
+			steps = append(steps, provenanceStep)
+		}
+		metadata = metadata.WithProvenance(steps...)
+	}
+	return metadata
+}
+
+func (r regoResult) GetRelated() []scan.RelatedResource {
+	var related []scan.RelatedResource
+	for _, resource := range r.Related {
+		related = append(related, scan.RelatedResource{
+			Resource: resource.Resource,
+			Range:    types.NewRangeWithFSKey(resource.Filepath, resource.StartLine, resource.EndLine, "", resource.FSKey, r.FS),
+		})
+	}
+	return related
 }
 
 func (r regoResult) GetRawValue() interface{} {
@@ -91,14 +136,56 @@ func parseCause(cause map[string]interface{}) regoResult {
 			result.Explicit = set
 		}
 	}
+	if unresolvable, ok := cause["unresolvable"]; ok {
+		if set, ok := unresolvable.(bool); ok {
+			result.Unresolvable = set
+		}
+	}
 	if managed, ok := cause["managed"]; ok {
 		if set, ok := managed.(bool); ok {
 			result.Managed = set
 		}
 	}
+	if provenance, ok := cause["provenance"].([]interface{}); ok {
+		for _, raw := range provenance {
+			if step, ok := raw.(map[string]interface{}); ok {
+				result.Provenance = append(result.Provenance, parseProvenanceStep(step))
+			}
+		}
+	}
+	if related, ok := cause["related"].([]interface{}); ok {
+		for _, raw := range related {
+			if cause, ok := raw.(map[string]interface{}); ok {
+				result.Related = append(result.Related, parseCause(cause))
+			}
+		}
+	}
 	return result
 }
 
+func parseProvenanceStep(step map[string]interface{}) regoProvenanceStep {
+	var parsed regoProvenanceStep
+	if kind, ok := step["kind"]; ok {
+		parsed.Kind = fmt.Sprintf("%s", kind)
+	}
+	if name, ok := step["name"]; ok {
+		parsed.Name = fmt.Sprintf("%s", name)
+	}
+	if filepath, ok := step["filepath"]; ok {
+		parsed.Filepath = fmt.Sprintf("%s", filepath)
+	}
+	if fsKey, ok := step["fskey"]; ok {
+		parsed.FSKey = fmt.Sprintf("%s", fsKey)
+	}
+	if start, ok := step["startline"]; ok {
+		parsed.StartLine = parseLineNumber(start)
+	}
+	if end, ok := step["endline"]; ok {
+		parsed.EndLine = parseLineNumber(end)
+	}
+	return parsed
+}
+
 func parseLineNumber(raw interface{}) int {
 	str := fmt.Sprintf("%s", raw)
 	n, _ := strconv.Atoi(str)
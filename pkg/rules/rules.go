@@ -32,6 +32,7 @@ import (
 	_ "github.com/aquasecurity/defsec/internal/rules/aws/redshift"
 	_ "github.com/aquasecurity/defsec/internal/rules/aws/s3"
 	_ "github.com/aquasecurity/defsec/internal/rules/aws/sam"
+	_ "github.com/aquasecurity/defsec/internal/rules/aws/sfn"
 	_ "github.com/aquasecurity/defsec/internal/rules/aws/sns"
 	_ "github.com/aquasecurity/defsec/internal/rules/aws/sqs"
 	_ "github.com/aquasecurity/defsec/internal/rules/aws/ssm"
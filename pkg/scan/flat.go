@@ -18,9 +18,14 @@ type FlatResult struct {
 	RangeAnnotation string             `json:"-"`
 	Severity        severity.Severity  `json:"severity"`
 	Warning         bool               `json:"warning"`
+	Unresolved      bool               `json:"unresolved,omitempty"`
+	Profile         string             `json:"profile,omitempty"`
+	RenderedSource  *RenderedSource    `json:"rendered_source,omitempty"`
 	Status          Status             `json:"status"`
 	Resource        string             `json:"resource"`
 	Location        FlatRange          `json:"location"`
+	Provenance      []FlatProvenance   `json:"provenance,omitempty"`
+	Related         []FlatRelated      `json:"related,omitempty"`
 }
 
 type FlatRange struct {
@@ -29,6 +34,17 @@ type FlatRange struct {
 	EndLine   int    `json:"end_line"`
 }
 
+type FlatProvenance struct {
+	Kind     string     `json:"kind"`
+	Name     string     `json:"name"`
+	Location *FlatRange `json:"location,omitempty"`
+}
+
+type FlatRelated struct {
+	Resource string     `json:"resource"`
+	Location *FlatRange `json:"location,omitempty"`
+}
+
 func (r Results) Flatten() []FlatResult {
 	var results []FlatResult
 	for _, original := range r {
@@ -51,6 +67,37 @@ func (r *Result) Flatten() FlatResult {
 		resource = resMetadata.Reference().LogicalID()
 	}
 
+	var provenance []FlatProvenance
+	for _, step := range r.Provenance() {
+		flat := FlatProvenance{
+			Kind: string(step.Kind),
+			Name: step.Name,
+		}
+		if step.Range != nil {
+			flat.Location = &FlatRange{
+				Filename:  step.Range.GetFilename(),
+				StartLine: step.Range.GetStartLine(),
+				EndLine:   step.Range.GetEndLine(),
+			}
+		}
+		provenance = append(provenance, flat)
+	}
+
+	var related []FlatRelated
+	for _, resource := range r.Related() {
+		flat := FlatRelated{
+			Resource: resource.Resource,
+		}
+		if resource.Range != nil {
+			flat.Location = &FlatRange{
+				Filename:  resource.Range.GetFilename(),
+				StartLine: resource.Range.GetStartLine(),
+				EndLine:   resource.Range.GetEndLine(),
+			}
+		}
+		related = append(related, flat)
+	}
+
 	return FlatResult{
 		RuleID:          r.rule.AVDID,
 		LongID:          r.Rule().LongID(),
@@ -66,10 +113,15 @@ func (r *Result) Flatten() FlatResult {
 		Status:          r.status,
 		Resource:        resource,
 		Warning:         r.IsWarning(),
+		Unresolved:      r.IsUnresolved(),
+		Profile:         r.Profile(),
+		RenderedSource:  r.RenderedSource(),
 		Location: FlatRange{
 			Filename:  rng.GetFilename(),
 			StartLine: rng.GetStartLine(),
 			EndLine:   rng.GetEndLine(),
 		},
+		Provenance: provenance,
+		Related:    related,
 	}
 }
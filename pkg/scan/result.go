@@ -31,6 +31,27 @@ type Result struct {
 	warning          bool
 	traces           []string
 	fsPath           string
+	unresolved       bool
+	profile          string
+	renderedSource   *RenderedSource
+	related          []RelatedResource
+}
+
+// RelatedResource is another resource which contributed to a result, such as the role granted by a binding
+type RelatedResource struct {
+	Resource string
+	Range    types.Range
+}
+
+// RenderedSource describes where a finding in a rendered manifest, such as the output of a Helm chart, came from
+type RenderedSource struct {
+	TemplateFile string `json:"template_file"`
+	TemplateLine int    `json:"template_line"`
+	// ValuesPath is the values key substituted on the template line, e.g. "securityContext.privileged", if any
+	ValuesPath string `json:"values_path,omitempty"`
+	// ValuesFile is the file the value was set in, which is empty if the value was not set in a file
+	ValuesFile string `json:"values_file,omitempty"`
+	ValuesLine int    `json:"values_line,omitempty"`
 }
 
 func (r Result) RegoNamespace() string {
@@ -52,6 +73,27 @@ func (r *Result) IsWarning() bool {
 	return r.warning
 }
 
+// IsUnresolved returns true if the outcome of the check depended on a value which could not be resolved, such as an
+// unknown module output or data source, so the result may not reflect the deployed configuration.
+func (r Result) IsUnresolved() bool {
+	return r.unresolved
+}
+
+// Profile returns the name of the profile, such as a set of Helm values, the scanned input was rendered with
+func (r Result) Profile() string {
+	return r.profile
+}
+
+// RenderedSource returns the template line, and the values key if any, which produced the part of the rendered input
+// this result refers to. It is nil for inputs which are not rendered from templates.
+func (r Result) RenderedSource() *RenderedSource {
+	return r.renderedSource
+}
+
+func (r *Result) SetRenderedSource(source *RenderedSource) {
+	r.renderedSource = source
+}
+
 func (r *Result) OverrideSeverity(s severity.Severity) {
 	r.severityOverride = &s
 }
@@ -100,6 +142,17 @@ func (r Result) Range() types.Range {
 	return r.metadata.Range()
 }
 
+// Provenance returns the chain of variables, locals and other definitions which supplied the value the result
+// refers to, nearest first. It is empty for values which were written directly.
+func (r Result) Provenance() []types.ProvenanceStep {
+	return r.metadata.Provenance()
+}
+
+// Related returns the other resources, besides the one the result refers to, which contributed to the result
+func (r Result) Related() []RelatedResource {
+	return r.related
+}
+
 func (r Result) Traces() []string {
 	return r.traces
 }
@@ -153,6 +206,11 @@ type MetadataProvider interface {
 	GetRawValue() interface{}
 }
 
+// RelatedResourceProvider is implemented by sources which can reference other resources which contributed to a result
+type RelatedResourceProvider interface {
+	GetRelated() []RelatedResource
+}
+
 func (r *Results) GetPassed() Results {
 	return r.filterStatus(StatusPassed)
 }
@@ -165,6 +223,31 @@ func (r *Results) GetFailed() Results {
 	return r.filterStatus(StatusFailed)
 }
 
+// GetUnresolved returns the results whose outcome depended on values which could not be resolved, excluding those
+// which were ignored
+func (r *Results) GetUnresolved() Results {
+	var filtered Results
+	if r == nil {
+		return filtered
+	}
+	for _, res := range *r {
+		if res.IsUnresolved() && res.Status() != StatusIgnored {
+			filtered = append(filtered, res)
+		}
+	}
+	return filtered
+}
+
+// MarkUnresolved flags results which were reported against a value which could not be resolved. Checks which should
+// have passes flagged too report them against the value they decided on, e.g. results.AddPassed(bucket.Versioning.Enabled).
+func (r *Results) MarkUnresolved() {
+	for i, res := range *r {
+		if !res.metadata.IsResolvable() {
+			(*r)[i].unresolved = true
+		}
+	}
+}
+
 func (r *Results) filterStatus(status Status) Results {
 	var filtered Results
 	if r == nil {
@@ -205,6 +288,9 @@ func (r *Results) AddRego(description string, namespace string, rule string, tra
 		annotationStr := rawToString(source.GetRawValue())
 		result.annotation = annotationStr
 	}
+	if provider, ok := source.(RelatedResourceProvider); ok {
+		result.related = provider.GetRelated()
+	}
 	rnge := result.metadata.Range()
 	result.fsPath = rnge.GetLocalFilename()
 	*r = append(*r, result)
@@ -251,6 +337,13 @@ func (r *Results) SetRule(rule Rule) {
 	}
 }
 
+// SetProfile records the name of the profile the scanned input was rendered with
+func (r *Results) SetProfile(profile string) {
+	for i := range *r {
+		(*r)[i].profile = profile
+	}
+}
+
 func (r *Results) SetSourceAndFilesystem(source string, f fs.FS, logicalSource bool) {
 	for i := range *r {
 		m := (*r)[i].Metadata()
@@ -270,6 +363,7 @@ func (r *Results) SetSourceAndFilesystem(source string, f fs.FS, logicalSource b
 		default:
 			m = types.NewMetadata(newrng, m.Reference())
 		}
+		m = m.WithProvenance((*r)[i].metadata.Provenance()...)
 		(*r)[i].OverrideMetadata(m)
 	}
 }
@@ -104,6 +104,7 @@ var acronyms = []string{
 	"rds",
 	"rsa",
 	"sam",
+	"sfn",
 	"sgr",
 	"sha1",
 	"sha256",
@@ -5,33 +5,73 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aquasecurity/defsec/internal/debug"
+	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/parser"
 
 	"github.com/aquasecurity/defsec/pkg/scan"
 )
 
-func isIgnored(scanResult scan.Result) bool {
+// findIgnore returns the ignore which applies to the result, if any. Ignores are read from a cfsec:ignore comment on
+// the offending line, or from the defsec.ignore list in the Metadata section of the offending resource. Results from
+// rego checks don't refer to a resource, so only the Metadata of the resource which contains them is consulted.
+func findIgnore(scanResult scan.Result, cfCtx *parser.FileContext, logger debug.Logger) *Ignore {
 	ref := scanResult.Metadata().Reference()
+	if ref == nil && scanResult.Metadata().Parent() != nil {
+		ref = scanResult.Metadata().Parent().Reference()
+	}
 
+	var resource *parser.Resource
 	if cfRef, ok := ref.(*parser.CFReference); ok {
-		if ignore, err := parseIgnore(cfRef.Comment()); err == nil {
-			if ignore.RuleID != scanResult.Rule().AVDID && ignore.RuleID != scanResult.Rule().LongID() {
-				return false
-			}
-			if ignore.Expiry == nil || time.Now().Before(*ignore.Expiry) {
-				return true
-			}
+		if ignore, err := parseIgnore(cfRef.Comment()); err == nil && ignore.applies(scanResult) {
+			return ignore
+		}
+		if cfCtx != nil {
+			resource = cfCtx.GetResourceByLogicalID(cfRef.LogicalID())
+		}
+	} else {
+		resource = resourceContaining(cfCtx, scanResult.Range())
+	}
+
+	if resource == nil {
+		return nil
+	}
+	for _, ignore := range parseMetadataIgnores(resource, logger) {
+		if ignore.applies(scanResult) {
+			return &ignore
 		}
+	}
+	return nil
+}
 
+// resourceContaining returns the resource defined across the given range of the template, if any
+func resourceContaining(cfCtx *parser.FileContext, rng types.Range) *parser.Resource {
+	if cfCtx == nil || rng == nil {
+		return nil
 	}
-	return false
+	for _, resource := range cfCtx.Resources {
+		outer := resource.Range()
+		if outer.GetFSKey() == rng.GetFSKey() && outer.GetFilename() == rng.GetFilename() &&
+			outer.GetStartLine() <= rng.GetStartLine() && outer.GetEndLine() >= rng.GetEndLine() {
+			return resource
+		}
+	}
+	return nil
 }
 
 type Ignore struct {
 	RuleID string
+	Reason string
 	Expiry *time.Time
 }
 
+func (i *Ignore) applies(scanResult scan.Result) bool {
+	if i.RuleID != scanResult.Rule().AVDID && i.RuleID != scanResult.Rule().LongID() {
+		return false
+	}
+	return i.Expiry == nil || time.Now().Before(*i.Expiry)
+}
+
 func parseIgnore(comment string) (*Ignore, error) {
 
 	comment = strings.TrimSpace(comment)
@@ -65,3 +105,46 @@ func parseIgnore(comment string) (*Ignore, error) {
 
 	return &ignore, nil
 }
+
+// parseMetadataIgnores reads the ignores declared in the Metadata section of a resource, for example:
+//
+//	Metadata:
+//	  defsec:
+//	    ignore:
+//	      - id: AVD-AWS-0086
+//	        reason: the bucket is public by design
+//	        expires: 2030-01-01
+//
+// An entry may also be just the ID of the rule. Entries with a missing ID or an invalid expiry date are skipped.
+func parseMetadataIgnores(resource *parser.Resource, logger debug.Logger) (ignores []Ignore) {
+	for _, entry := range resource.GetMetadataProperty("defsec.ignore").AsList() {
+		if entry.IsString() {
+			ignores = append(ignores, Ignore{RuleID: entry.AsString()})
+			continue
+		}
+		if !entry.IsMap() {
+			continue
+		}
+
+		id := entry.GetProperty("id")
+		if !id.IsString() || id.AsString() == "" {
+			continue
+		}
+		ignore := Ignore{
+			RuleID: id.AsString(),
+		}
+		if reason := entry.GetProperty("reason"); reason.IsString() {
+			ignore.Reason = reason.AsString()
+		}
+		if expires := entry.GetProperty("expires"); expires.IsString() {
+			parsed, err := time.Parse("2006-01-02", expires.AsString())
+			if err != nil {
+				logger.Log("Ignore for '%s' on resource '%s' has an invalid expiry date '%s', skipping: %s", ignore.RuleID, resource.ID(), expires.AsString(), err)
+				continue
+			}
+			ignore.Expiry = &parsed
+		}
+		ignores = append(ignores, ignore)
+	}
+	return ignores
+}
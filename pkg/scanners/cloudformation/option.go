@@ -1,12 +1,16 @@
 package cloudformation
 
 import (
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/parser"
 	"github.com/aquasecurity/defsec/pkg/scanners/options"
 )
 
 type ConfigurableCloudFormationScanner interface {
 	options.ConfigurableScanner
 	SetRegoOnly(regoOnly bool)
+	SetUnresolvedReporting(enabled bool)
+	SetAllConditionBranches(enabled bool)
+	AddParserOptions(options ...options.ParserOption)
 }
 
 func ScannerWithRegoOnly(regoOnly bool) options.ScannerOption {
@@ -16,3 +20,91 @@ func ScannerWithRegoOnly(regoOnly bool) options.ScannerOption {
 		}
 	}
 }
+
+// ScannerWithUnresolvedReporting flags results reported against values which could not be resolved, such as
+// unsupported intrinsic functions, so they can be reported separately from real passes and failures.
+func ScannerWithUnresolvedReporting(enabled bool) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if cf, ok := s.(ConfigurableCloudFormationScanner); ok {
+			cf.SetUnresolvedReporting(enabled)
+		}
+	}
+}
+
+// ScannerWithAllConditionBranches scans templates which use Conditions with each condition in turn forced true and then
+// false, while the other conditions are evaluated from their definitions. Both sides of each Fn::If and all
+// condition-guarded resources are checked, at the cost of scanning the template twice per condition.
+func ScannerWithAllConditionBranches(enabled bool) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if cf, ok := s.(ConfigurableCloudFormationScanner); ok {
+			cf.SetAllConditionBranches(enabled)
+		}
+	}
+}
+
+// ScannerWithParameterFiles loads parameter values from the given files, which are read from the scanned filesystem.
+// See parser.OptionWithParameterFiles for the supported formats.
+func ScannerWithParameterFiles(paths ...string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if cf, ok := s.(ConfigurableCloudFormationScanner); ok {
+			cf.AddParserOptions(parser.OptionWithParameterFiles(paths...))
+		}
+	}
+}
+
+// ScannerWithTemplateParameterFiles loads parameter values for the template at the given path from the given files,
+// in place of any set with ScannerWithParameterFiles.
+func ScannerWithTemplateParameterFiles(template string, paths ...string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if cf, ok := s.(ConfigurableCloudFormationScanner); ok {
+			cf.AddParserOptions(parser.OptionWithTemplateParameterFiles(template, paths...))
+		}
+	}
+}
+
+// ScannerWithParameters sets parameter values, taking precedence over parameter files and template defaults
+func ScannerWithParameters(params map[string]string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if cf, ok := s.(ConfigurableCloudFormationScanner); ok {
+			cf.AddParserOptions(parser.OptionWithParameters(params))
+		}
+	}
+}
+
+// ScannerWithAccountID sets the value of the AWS::AccountId pseudo parameter
+func ScannerWithAccountID(accountID string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if cf, ok := s.(ConfigurableCloudFormationScanner); ok {
+			cf.AddParserOptions(parser.OptionWithAccountID(accountID))
+		}
+	}
+}
+
+// ScannerWithRegion sets the value of the AWS::Region pseudo parameter
+func ScannerWithRegion(region string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if cf, ok := s.(ConfigurableCloudFormationScanner); ok {
+			cf.AddParserOptions(parser.OptionWithRegion(region))
+		}
+	}
+}
+
+// ScannerWithPartition sets the value of the AWS::Partition pseudo parameter, which also determines AWS::URLSuffix
+func ScannerWithPartition(partition string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if cf, ok := s.(ConfigurableCloudFormationScanner); ok {
+			cf.AddParserOptions(parser.OptionWithPartition(partition))
+		}
+	}
+}
+
+// ScannerWithSAMExpansion adds the resources which the SAM transform implicitly creates for serverless resources, such
+// as execution roles, log groups and API stages and deployments, so they are also checked by the rules for those
+// resource types.
+func ScannerWithSAMExpansion(enabled bool) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if cf, ok := s.(ConfigurableCloudFormationScanner); ok {
+			cf.AddParserOptions(parser.OptionWithSAMExpansion(enabled))
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package parser
+
+// exportTable holds the outputs exported by every template in a scan, so that Fn::ImportValue can be resolved
+// across stacks
+type exportTable struct {
+	outputs   map[string]*Property
+	resolving map[string]bool
+}
+
+func linkExports(contexts FileContexts) {
+	table := &exportTable{
+		outputs:   make(map[string]*Property),
+		resolving: make(map[string]bool),
+	}
+	for _, ctx := range contexts {
+		ctx.exports = table
+	}
+	for _, ctx := range contexts {
+		ctx.withStackName(func() {
+			for _, output := range ctx.Outputs {
+				if output.IsNotMap() {
+					continue
+				}
+				if name := output.GetProperty("Export.Name"); name.IsString() {
+					table.outputs[name.AsString()] = output
+				}
+			}
+		})
+	}
+}
+
+// withStackName calls fn with AWS::StackName derived from the template's path, unless the template already has a
+// name of its own as a child stack. Every other template in a scan has the same default stack name, so their export
+// names would otherwise collide when they include it.
+func (c *FileContext) withStackName(fn func()) {
+	if c.pseudo.stackName != "" {
+		fn()
+		return
+	}
+	c.pseudo.stackName = stackNameFromPath(c.filepath)
+	defer func() { c.pseudo.stackName = "" }()
+	fn()
+}
+
+func (t *exportTable) lookup(name string) (*Property, bool) {
+	if t == nil {
+		return nil, false
+	}
+	output, ok := t.outputs[name]
+	if !ok {
+		return nil, false
+	}
+
+	// stacks which (invalidly) import each other's exports would otherwise recurse forever
+	if t.resolving[name] {
+		return nil, false
+	}
+	t.resolving[name] = true
+	defer delete(t.resolving, name)
+
+	value := output.GetProperty("Value")
+	if value.IsNil() || value.IsUnresolved() {
+		return nil, false
+	}
+	return value, true
+}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"github.com/aquasecurity/defsec/internal/debug"
 	"github.com/aquasecurity/defsec/internal/types"
 )
 
@@ -21,6 +22,19 @@ type FileContext struct {
 	Resources    map[string]*Resource   `json:"Resources" yaml:"Resources"`
 	Globals      map[string]*Resource   `json:"Globals" yaml:"Globals"`
 	Mappings     map[string]interface{} `json:"Mappings,omitempty" yaml:"Mappings"`
+	Conditions   map[string]*Property   `json:"Conditions" yaml:"Conditions"`
+	Outputs      map[string]*Property   `json:"Outputs" yaml:"Outputs"`
+
+	pseudo           pseudoParameters
+	exports          *exportTable
+	forcedConditions map[string]bool
+	evaluating       map[string]bool
+	debug            debug.Logger
+}
+
+// Debug writes to the debug log of the parser which loaded the file, for adapters to report content they can't use
+func (t *FileContext) Debug(format string, args ...interface{}) {
+	t.debug.Log(format, args...)
 }
 
 func (t *FileContext) GetResourceByLogicalID(name string) *Resource {
@@ -35,6 +49,9 @@ func (t *FileContext) GetResourceByLogicalID(name string) *Resource {
 func (t *FileContext) GetResourcesByType(names ...string) []*Resource {
 	var resources []*Resource
 	for _, r := range t.Resources {
+		if !r.ConditionMet() {
+			continue
+		}
 		for _, name := range names {
 			if name == r.Type() {
 				//
@@ -50,3 +67,60 @@ func (t *FileContext) Metadata() types.Metadata {
 
 	return types.NewMetadata(rng, NewCFReference("Template", rng))
 }
+
+// EvaluateCondition returns the value of the named condition from the Conditions section of the template. The second
+// return value is false if the condition does not exist or its value could not be determined.
+func (t *FileContext) EvaluateCondition(name string) (bool, bool) {
+	condition, ok := t.Conditions[name]
+	if !ok || condition == nil {
+		return false, false
+	}
+	if forced, ok := t.forcedConditions[name]; ok {
+		return forced, true
+	}
+
+	// conditions which (invalidly) refer to each other would otherwise recurse forever
+	if t.evaluating[name] {
+		return false, false
+	}
+	if t.evaluating == nil {
+		t.evaluating = make(map[string]bool)
+	}
+	t.evaluating[name] = true
+	defer delete(t.evaluating, name)
+
+	resolved, ok := condition.resolveValue()
+	if !ok || !resolved.IsBool() {
+		return false, false
+	}
+	return resolved.AsBool(), true
+}
+
+// ForceCondition makes the named condition evaluate to the given value, regardless of its definition. Other conditions
+// are still evaluated from their definitions, so those which refer to the forced condition follow it. This is used to
+// check each side of the Fn::If functions and condition-guarded resources which depend on a condition in turn.
+func (t *FileContext) ForceCondition(name string, value bool) {
+	t.forcedConditions = map[string]bool{name: value}
+	t.resetResolution()
+}
+
+// ResetConditions reverts the effect of ForceCondition, so conditions are evaluated from their definitions again
+func (t *FileContext) ResetConditions() {
+	t.forcedConditions = nil
+	t.resetResolution()
+}
+
+// resetResolution forgets which properties could not be resolved, as the outcome may differ once conditions change
+func (t *FileContext) resetResolution() {
+	for _, resource := range t.Resources {
+		resource.resetResolution()
+	}
+	for _, resource := range t.Globals {
+		resource.resetResolution()
+	}
+	for _, properties := range []map[string]*Property{t.Conditions, t.Outputs} {
+		for _, property := range properties {
+			property.resetResolution()
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package parser
+
+import (
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/cftypes"
+)
+
+func ResolveAnd(property *Property) (resolved *Property, success bool) {
+	if !property.isFunction() {
+		return property, true
+	}
+
+	refValue := property.AsMap()["Fn::And"].AsList()
+	if len(refValue) < 2 || len(refValue) > 10 {
+		return abortIntrinsic(property, "Fn::And should have between 2 and 10 conditions, returning original Property")
+	}
+
+	values, ok := resolveConditions(refValue)
+	if !ok {
+		return abortIntrinsic(property, "Fn::And conditions could not be evaluated, returning original Property")
+	}
+
+	result := true
+	for _, value := range values {
+		result = result && value
+	}
+	return property.deriveResolved(cftypes.Bool, result), true
+}
@@ -0,0 +1,35 @@
+package parser
+
+import (
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/cftypes"
+)
+
+func ResolveCondition(property *Property) (resolved *Property, success bool) {
+	if !property.isFunction() {
+		return property, true
+	}
+
+	refProp := property.AsMap()["Condition"]
+	if refProp.IsNotString() {
+		return abortIntrinsic(property, "Condition should refer to a condition by name, returning original Property")
+	}
+
+	value, known := property.ctx.EvaluateCondition(refProp.AsString())
+	if !known {
+		return abortIntrinsic(property, "Condition could not be evaluated, returning original Property")
+	}
+	return property.deriveResolved(cftypes.Bool, value), true
+}
+
+// resolveConditions resolves each of the given properties to a boolean, failing if any of them cannot be resolved
+func resolveConditions(properties []*Property) ([]bool, bool) {
+	var values []bool
+	for _, prop := range properties {
+		resolved, ok := prop.resolveValue()
+		if !ok || !resolved.IsBool() {
+			return nil, false
+		}
+		values = append(values, resolved.AsBool())
+	}
+	return values, true
+}
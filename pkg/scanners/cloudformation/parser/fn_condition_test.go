@@ -0,0 +1,124 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_evaluate_conditions(t *testing.T) {
+
+	source := `---
+Parameters:
+  Environment:
+    Type: String
+    Default: prod
+  Region:
+    Type: String
+    Default: eu-west-1
+Conditions:
+  IsProduction: !Equals [!Ref Environment, prod]
+  IsEurope: !Equals [!Ref Region, eu-west-1]
+  IsUS: !Not [!Condition IsEurope]
+  IsEuropeanProduction: !And [!Condition IsProduction, !Condition IsEurope]
+  IsProductionOrUS: !Or [!Condition IsProduction, !Condition IsUS]
+  IsImported: !Equals [!ImportValue SharedEnvironment, prod]
+  Loop: !Not [!Condition Loop]
+  IsPolicyLike:
+    Fn::And:
+      - Condition: IsProduction
+      - Fn::Equals: [a, a]
+Resources:
+  Always:
+    Type: AWS::S3::Bucket
+  ProductionOnly:
+    Type: AWS::S3::Bucket
+    Condition: IsProduction
+  USOnly:
+    Type: AWS::S3::Bucket
+    Condition: IsUS
+  Imported:
+    Type: AWS::S3::Bucket
+    Condition: IsImported
+`
+	ctx := createTestFileContext(t, source)
+	require.NotNil(t, ctx)
+
+	tests := []struct {
+		name     string
+		expected bool
+		known    bool
+	}{
+		{name: "IsProduction", expected: true, known: true},
+		{name: "IsEurope", expected: true, known: true},
+		{name: "IsUS", expected: false, known: true},
+		{name: "IsEuropeanProduction", expected: true, known: true},
+		{name: "IsProductionOrUS", expected: true, known: true},
+		{name: "IsPolicyLike", expected: true, known: true},
+		{name: "IsImported", known: false},
+		{name: "Loop", known: false},
+		{name: "Missing", known: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, known := ctx.EvaluateCondition(test.name)
+			assert.Equal(t, test.known, known)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+
+	var names []string
+	for _, resource := range ctx.GetResourcesByType("AWS::S3::Bucket") {
+		names = append(names, resource.ID())
+	}
+	assert.ElementsMatch(t, []string{"Always", "ProductionOnly", "Imported"}, names)
+
+	ctx.ForceCondition("IsProduction", false)
+	names = nil
+	for _, resource := range ctx.GetResourcesByType("AWS::S3::Bucket") {
+		names = append(names, resource.ID())
+	}
+	assert.ElementsMatch(t, []string{"Always", "Imported"}, names)
+
+	// conditions which refer to the forced condition follow it, while the others keep their own values
+	ctx.ForceCondition("IsEurope", false)
+	defer ctx.ResetConditions()
+	names = nil
+	for _, resource := range ctx.GetResourcesByType("AWS::S3::Bucket") {
+		names = append(names, resource.ID())
+	}
+	assert.ElementsMatch(t, []string{"Always", "ProductionOnly", "USOnly", "Imported"}, names)
+	value, known := ctx.EvaluateCondition("IsEuropeanProduction")
+	assert.True(t, known)
+	assert.False(t, value)
+}
+
+func Test_policy_condition_is_not_intrinsic(t *testing.T) {
+
+	source := `---
+Resources:
+  Policy:
+    Type: AWS::IAM::Policy
+    Properties:
+      PolicyDocument:
+        Statement:
+          - Effect: Allow
+            Action: s3:GetObject
+            Resource: '*'
+            Condition:
+              Bool:
+                aws:SecureTransport: 'true'
+`
+	ctx := createTestFileContext(t, source)
+	require.NotNil(t, ctx)
+
+	policy := ctx.GetResourceByLogicalID("Policy")
+	require.NotNil(t, policy)
+
+	statements := policy.GetProperty("PolicyDocument.Statement").AsList()
+	require.Len(t, statements, 1)
+	assert.False(t, statements[0].isFunction())
+	assert.True(t, statements[0].GetProperty("Condition").IsMap())
+}
@@ -0,0 +1,32 @@
+package parser
+
+func ResolveIf(property *Property) (resolved *Property, success bool) {
+	if !property.isFunction() {
+		return property, true
+	}
+
+	// the arguments are read directly, as AsList would drop a branch which is AWS::NoValue
+	refValue := property.AsMap()["Fn::If"].rawList()
+	if len(refValue) != 3 {
+		return abortIntrinsic(property, "Fn::If should have exactly 3 values, returning original Property")
+	}
+
+	conditionName := refValue[0]
+	if !conditionName.IsString() {
+		return abortIntrinsic(property, "Fn::If should refer to a condition by name, returning original Property")
+	}
+
+	condition, known := property.ctx.EvaluateCondition(conditionName.AsString())
+	if !known {
+		return abortIntrinsic(property, "Fn::If condition could not be evaluated, returning original Property")
+	}
+
+	branch := refValue[2]
+	if condition {
+		branch = refValue[1]
+	}
+	if branch.isFunction() {
+		return branch.resolveValue()
+	}
+	return branch, true
+}
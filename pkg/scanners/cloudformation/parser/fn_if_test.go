@@ -0,0 +1,123 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_resolve_if_value(t *testing.T) {
+
+	source := `---
+Parameters:
+  Environment:
+    Type: String
+    Default: prod
+Conditions:
+  IsProduction: !Equals [!Ref Environment, prod]
+  IsDevelopment: !Equals [!Ref Environment, dev]
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: !If [IsProduction, prod-bucket, dev-bucket]
+      AccessControl: !If [IsDevelopment, PublicRead, !Ref AWS::NoValue]
+      VersioningConfiguration:
+        Status: !If
+          - IsDevelopment
+          - Suspended
+          - !If [IsProduction, Enabled, Suspended]
+`
+	ctx := createTestFileContext(t, source)
+	require.NotNil(t, ctx)
+
+	bucket := ctx.GetResourceByLogicalID("Bucket")
+	require.NotNil(t, bucket)
+
+	assert.Equal(t, "prod-bucket", bucket.GetStringProperty("BucketName").Value())
+	assert.Equal(t, "Enabled", bucket.GetStringProperty("VersioningConfiguration.Status").Value())
+
+	acl := bucket.GetStringProperty("AccessControl")
+	assert.True(t, acl.GetMetadata().IsDefault())
+	assert.Equal(t, "", acl.Value())
+}
+
+func Test_resolve_if_removes_list_items(t *testing.T) {
+
+	source := `{
+  "Conditions": {
+    "CreateAdmin": {"Fn::Equals": ["yes", "no"]}
+  },
+  "Resources": {
+    "Role": {
+      "Type": "AWS::IAM::Role",
+      "Properties": {
+        "ManagedPolicyArns": [
+          "arn:aws:iam::aws:policy/ReadOnlyAccess",
+          {"Fn::If": ["CreateAdmin", "arn:aws:iam::aws:policy/AdministratorAccess", {"Ref": "AWS::NoValue"}]}
+        ]
+      }
+    }
+  }
+}`
+	files, err := parseFile(t, source, "cf.json")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	role := files[0].GetResourceByLogicalID("Role")
+	require.NotNil(t, role)
+
+	arns := role.GetProperty("ManagedPolicyArns").AsList()
+	require.Len(t, arns, 1)
+	assert.Equal(t, "arn:aws:iam::aws:policy/ReadOnlyAccess", arns[0].AsString())
+
+	files[0].ForceCondition("CreateAdmin", true)
+	defer files[0].ResetConditions()
+	assert.Len(t, role.GetProperty("ManagedPolicyArns").AsList(), 2)
+}
+
+func Test_resolve_if_with_unknown_condition(t *testing.T) {
+
+	source := `---
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: !If [Missing, a, b]
+`
+	ctx := createTestFileContext(t, source)
+	require.NotNil(t, ctx)
+
+	bucket := ctx.GetResourceByLogicalID("Bucket")
+	require.NotNil(t, bucket)
+	assert.True(t, bucket.GetProperty("BucketName").IsUnresolved())
+}
+
+func Test_resolve_if_after_forcing_unknown_condition(t *testing.T) {
+
+	source := `---
+Conditions:
+  IsProduction: !Equals [!ImportValue SharedEnvironment, prod]
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: !If [IsProduction, prod-bucket, dev-bucket]
+`
+	ctx := createTestFileContext(t, source)
+	require.NotNil(t, ctx)
+
+	bucket := ctx.GetResourceByLogicalID("Bucket")
+	require.NotNil(t, bucket)
+	assert.True(t, bucket.GetProperty("BucketName").IsUnresolved())
+
+	ctx.ForceCondition("IsProduction", true)
+	assert.Equal(t, "prod-bucket", bucket.GetStringProperty("BucketName").Value())
+
+	ctx.ForceCondition("IsProduction", false)
+	assert.Equal(t, "dev-bucket", bucket.GetStringProperty("BucketName").Value())
+
+	ctx.ResetConditions()
+	assert.True(t, bucket.GetProperty("BucketName").IsUnresolved())
+}
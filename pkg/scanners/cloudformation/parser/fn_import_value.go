@@ -0,0 +1,21 @@
+package parser
+
+func ResolveImportValue(property *Property) (resolved *Property, success bool) {
+	if !property.isFunction() {
+		return property, true
+	}
+
+	refProp := property.AsMap()["Fn::ImportValue"]
+	if !refProp.IsString() {
+		property.unresolved = true
+		return abortIntrinsic(property, "Fn::ImportValue should have a string export name, returning original Property")
+	}
+
+	value, found := property.ctx.exports.lookup(refProp.AsString())
+	if !found {
+		// the export belongs to a stack which is not part of this scan
+		property.unresolved = true
+		return abortIntrinsic(property, "Fn::ImportValue refers to an unknown export, returning original Property")
+	}
+	return property.deriveResolved(value.Type(), value.RawValue()), true
+}
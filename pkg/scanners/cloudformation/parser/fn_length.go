@@ -0,0 +1,26 @@
+package parser
+
+import (
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/cftypes"
+)
+
+func ResolveLength(property *Property) (*Property, bool) {
+	if !property.isFunction() {
+		return property, true
+	}
+
+	refValue := property.AsMap()["Fn::Length"]
+	if refValue.isFunction() {
+		resolved, success := refValue.resolveValue()
+		if !success {
+			return abortIntrinsic(property, "the list for Fn::Length could not be resolved, returning original Property")
+		}
+		refValue = resolved
+	}
+
+	if !refValue.IsList() {
+		return abortIntrinsic(property, "Fn::Length on property [%s] should be given a list, returning original Property", property.name)
+	}
+
+	return property.deriveResolved(cftypes.Int, len(refValue.AsList())), true
+}
@@ -0,0 +1,22 @@
+package parser
+
+import (
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/cftypes"
+)
+
+func ResolveNot(property *Property) (resolved *Property, success bool) {
+	if !property.isFunction() {
+		return property, true
+	}
+
+	refValue := property.AsMap()["Fn::Not"].AsList()
+	if len(refValue) != 1 {
+		return abortIntrinsic(property, "Fn::Not should have exactly 1 condition, returning original Property")
+	}
+
+	values, ok := resolveConditions(refValue)
+	if !ok {
+		return abortIntrinsic(property, "Fn::Not condition could not be evaluated, returning original Property")
+	}
+	return property.deriveResolved(cftypes.Bool, !values[0]), true
+}
@@ -0,0 +1,27 @@
+package parser
+
+import (
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/cftypes"
+)
+
+func ResolveOr(property *Property) (resolved *Property, success bool) {
+	if !property.isFunction() {
+		return property, true
+	}
+
+	refValue := property.AsMap()["Fn::Or"].AsList()
+	if len(refValue) < 2 || len(refValue) > 10 {
+		return abortIntrinsic(property, "Fn::Or should have between 2 and 10 conditions, returning original Property")
+	}
+
+	values, ok := resolveConditions(refValue)
+	if !ok {
+		return abortIntrinsic(property, "Fn::Or conditions could not be evaluated, returning original Property")
+	}
+
+	result := false
+	for _, value := range values {
+		result = result || value
+	}
+	return property.deriveResolved(cftypes.Bool, result), true
+}
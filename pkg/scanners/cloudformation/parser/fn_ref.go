@@ -15,30 +15,30 @@ func ResolveReference(property *Property) (resolved *Property, success bool) {
 	}
 	refValue := refProp.AsString()
 
-	if pseudo, ok := pseudoParameters[refValue]; ok {
-		return property.deriveResolved(cftypes.String, pseudo.(string)), true
+	if refValue == "AWS::NoValue" {
+		// the property is removed, so it behaves as though it was never set
+		resolved = property.deriveResolved(cftypes.String, nil)
+		resolved.noValue = true
+		return resolved, true
 	}
 
-	var param *Parameter
-	for k := range property.ctx.Parameters {
-		if k == refValue {
-			param = property.ctx.Parameters[k]
-			resolvedType := param.Type()
-
-			switch param.Default().(type) {
-			case bool:
-				resolvedType = cftypes.Bool
-			case string:
-				resolvedType = cftypes.String
-			case int:
-				resolvedType = cftypes.Int
+	if pseudo, ok := property.ctx.pseudo.values()[refValue]; ok {
+		switch value := pseudo.(type) {
+		case []string:
+			var items []*Property
+			for _, item := range value {
+				items = append(items, property.deriveResolved(cftypes.String, item))
 			}
-
-			resolved = property.deriveResolved(resolvedType, param.Default())
-			return resolved, true
+			return property.deriveResolved(cftypes.List, items), true
+		default:
+			return property.deriveResolved(cftypes.String, value), true
 		}
 	}
 
+	if param, ok := property.ctx.Parameters[refValue]; ok && param != nil {
+		return param.resolve(property)
+	}
+
 	for k := range property.ctx.Resources {
 		if k == refValue {
 			res := property.ctx.Resources[k]
@@ -63,9 +63,19 @@ func resolveMapSub(refValue *Property, original *Property) (*Property, bool) {
 func resolveStringSub(refValue *Property, original *Property) *Property {
 	workingString := refValue.AsString()
 
-	for k, v := range pseudoParameters {
+	for k, v := range original.ctx.pseudo.values() {
 		workingString = strings.ReplaceAll(workingString, fmt.Sprintf("${%s}", k), fmt.Sprintf("%v", v))
 	}
 
+	for name, param := range original.ctx.Parameters {
+		placeholder := fmt.Sprintf("${%s}", name)
+		if param == nil || !strings.Contains(workingString, placeholder) {
+			continue
+		}
+		if resolved, ok := param.resolve(original); ok && (resolved.IsString() || resolved.IsInt()) {
+			workingString = strings.ReplaceAll(workingString, placeholder, resolved.String())
+		}
+	}
+
 	return original.deriveResolved(cftypes.String, workingString)
 }
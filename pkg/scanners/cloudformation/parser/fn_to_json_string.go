@@ -0,0 +1,25 @@
+package parser
+
+import (
+	"encoding/json"
+
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/cftypes"
+)
+
+func ResolveToJsonString(property *Property) (*Property, bool) {
+	if !property.isFunction() {
+		return property, true
+	}
+
+	refValue := property.AsMap()["Fn::ToJsonString"]
+	if !refValue.IsMap() && !refValue.IsList() {
+		return abortIntrinsic(property, "Fn::ToJsonString on property [%s] should be given an object or list, returning original Property", property.name)
+	}
+
+	raw, err := json.Marshal(refValue.toInterface())
+	if err != nil {
+		return abortIntrinsic(property, "failed to encode property [%s] as JSON, returning original Property", property.name)
+	}
+
+	return property.deriveResolved(cftypes.String, string(raw)), true
+}
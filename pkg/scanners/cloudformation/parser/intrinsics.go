@@ -11,27 +11,28 @@ var intrinsicFuncs map[string]func(property *Property) (*Property, bool)
 
 func init() {
 	intrinsicFuncs = map[string]func(property *Property) (*Property, bool){
-		"Ref":             ResolveReference,
-		"Fn::Base64":      ResolveBase64,
-		"Fn::Equals":      ResolveEquals,
-		"Fn::Join":        ResolveJoin,
-		"Fn::Split":       ResolveSplit,
-		"Fn::Sub":         ResolveSub,
-		"Fn::FindInMap":   ResolveFindInMap,
-		"Fn::Select":      ResolveSelect,
-		"Fn::GetAtt":      ResolveGetAtt,
-		"Fn::GetAZs":      GetAzs,
-		"Fn::Cidr":        GetCidr,
-		"Fn::ImportValue": ImportPlaceholder,
-		// "Fn::If":        PassthroughResolution,
+		"Ref":              ResolveReference,
+		"Fn::Base64":       ResolveBase64,
+		"Fn::Equals":       ResolveEquals,
+		"Fn::Join":         ResolveJoin,
+		"Fn::Split":        ResolveSplit,
+		"Fn::Sub":          ResolveSub,
+		"Fn::FindInMap":    ResolveFindInMap,
+		"Fn::Select":       ResolveSelect,
+		"Fn::GetAtt":       ResolveGetAtt,
+		"Fn::GetAZs":       GetAzs,
+		"Fn::Cidr":         GetCidr,
+		"Fn::ImportValue":  ResolveImportValue,
+		"Fn::Length":       ResolveLength,
+		"Fn::ToJsonString": ResolveToJsonString,
+		"Fn::If":           ResolveIf,
+		"Fn::And":          ResolveAnd,
+		"Fn::Or":           ResolveOr,
+		"Fn::Not":          ResolveNot,
+		"Condition":        ResolveCondition,
 	}
 }
 
-func ImportPlaceholder(property *Property) (*Property, bool) {
-	property.unresolved = true
-	return property, false
-}
-
 func PassthroughResolution(property *Property) (*Property, bool) {
 	return property, false
 }
@@ -41,10 +42,7 @@ func IsIntrinsicFunc(node *yaml.Node) bool {
 		return false
 	}
 
-	nodeTag := strings.TrimPrefix(node.Tag, "!")
-	if nodeTag != "Ref" {
-		nodeTag = fmt.Sprintf("Fn::%s", nodeTag)
-	}
+	nodeTag := getIntrinsicTag(node.Tag)
 	for tag := range intrinsicFuncs {
 
 		if nodeTag == tag {
@@ -83,7 +81,7 @@ func ResolveIntrinsicFunc(property *Property) (*Property, bool) {
 func getIntrinsicTag(tag string) string {
 	tag = strings.TrimPrefix(tag, "!")
 	switch tag {
-	case "Ref", "Contains":
+	case "Ref", "Contains", "Condition":
 		return tag
 	default:
 		return fmt.Sprintf("Fn::%s", tag)
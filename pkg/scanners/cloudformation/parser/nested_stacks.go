@@ -0,0 +1,156 @@
+package parser
+
+import (
+	"context"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/cftypes"
+)
+
+const nestedStackType = "AWS::CloudFormation::Stack"
+
+// loadNestedStacks loads the child templates of AWS::CloudFormation::Stack resources whose TemplateURL is a path in
+// the scanned filesystem, passing through the parameters given by the parent. Templates which are loaded as a child
+// are not also returned on their own, as their parameters would otherwise only have default values.
+func (p *Parser) loadNestedStacks(ctx context.Context, target fs.FS, contexts FileContexts) (FileContexts, error) {
+	children := make(map[string]bool)
+	for _, parent := range contexts {
+		for _, stack := range parent.GetResourcesByType(nestedStackType) {
+			if childPath, ok := localTemplatePath(parent, stack); ok && childPath != parent.filepath {
+				children[childPath] = true
+			}
+		}
+	}
+
+	var loaded FileContexts
+	included := make(map[string]bool)
+	load := func(parent *FileContext) error {
+		nested, err := p.loadChildStacks(ctx, target, parent, map[string]bool{parent.filepath: true})
+		if err != nil {
+			return err
+		}
+		loaded = append(loaded, parent)
+		loaded = append(loaded, nested...)
+		for _, c := range append(nested, parent) {
+			included[c.filepath] = true
+		}
+		return nil
+	}
+
+	for _, parent := range contexts {
+		if children[parent.filepath] {
+			continue
+		}
+		if err := load(parent); err != nil {
+			return nil, err
+		}
+	}
+
+	// templates which only include each other have no parent outside of the cycle, so are loaded on their own
+	for _, parent := range contexts {
+		if included[parent.filepath] {
+			continue
+		}
+		if err := load(parent); err != nil {
+			return nil, err
+		}
+	}
+	return loaded, nil
+}
+
+func (p *Parser) loadChildStacks(ctx context.Context, target fs.FS, parent *FileContext, ancestors map[string]bool) (FileContexts, error) {
+	var loaded FileContexts
+	for _, stack := range parent.GetResourcesByType(nestedStackType) {
+		childPath, ok := localTemplatePath(parent, stack)
+		if !ok {
+			continue
+		}
+		if ancestors[childPath] {
+			p.debug.Log("Nested stack %s in %s refers to a template which includes it, skipping", stack.ID(), parent.filepath)
+			continue
+		}
+		if _, err := fs.Stat(target, childPath); err != nil {
+			p.debug.Log("Template %s for nested stack %s could not be found: %s", childPath, stack.ID(), err)
+			continue
+		}
+
+		child, err := p.ParseFile(ctx, target, childPath)
+		if err != nil {
+			return nil, err
+		}
+		child.pseudo.stackName = stackNameFromPath(childPath)
+		passParameters(stack, child)
+		loaded = append(loaded, child)
+
+		ancestors[childPath] = true
+		nested, err := p.loadChildStacks(ctx, target, child, ancestors)
+		delete(ancestors, childPath)
+		if err != nil {
+			return nil, err
+		}
+		loaded = append(loaded, nested...)
+	}
+	return loaded, nil
+}
+
+// localTemplatePath returns the path of the template for a nested stack, relative to the root of the filesystem, if
+// its TemplateURL is not a remote location
+func localTemplatePath(parent *FileContext, stack *Resource) (string, bool) {
+	templateURL := stack.GetProperty("TemplateURL")
+	if !templateURL.IsString() {
+		return "", false
+	}
+	location := templateURL.AsString()
+	if location == "" || strings.Contains(location, "://") {
+		return "", false
+	}
+	if path.IsAbs(location) {
+		return strings.TrimPrefix(path.Clean(location), "/"), true
+	}
+	return path.Join(path.Dir(parent.filepath), location), true
+}
+
+// passParameters sets the parameters of a child template to the values given by the parent stack resource
+func passParameters(stack *Resource, child *FileContext) {
+	params := stack.GetProperty("Parameters")
+	if !params.IsMap() {
+		return
+	}
+	for name, value := range params.AsMap() {
+		param, ok := child.Parameters[name]
+		if !ok || param == nil {
+			continue
+		}
+		resolved := value
+		if value.isFunction() {
+			resolved, _ = value.resolveValue()
+		}
+		if resolved == nil || resolved.IsUnresolved() {
+			param.markUnresolvable()
+			continue
+		}
+		param.UpdateDefault(resolved.parameterValue())
+	}
+}
+
+// parameterValue converts a resolved property to a value in the form used for parameter defaults
+func (p *Property) parameterValue() interface{} {
+	if p == nil {
+		return nil
+	}
+	switch p.Type() {
+	case cftypes.List:
+		var items []interface{}
+		for _, item := range p.AsList() {
+			if item.isFunction() {
+				item, _ = item.resolveValue()
+			}
+			items = append(items, item.parameterValue())
+		}
+		return items
+	default:
+		return p.RawValue()
+	}
+}
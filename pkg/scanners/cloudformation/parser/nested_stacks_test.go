@@ -0,0 +1,137 @@
+package parser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aquasecurity/defsec/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NestedStacks(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/parent.yaml": `---
+Parameters:
+  Environment:
+    Type: String
+    Default: prod
+Resources:
+  Storage:
+    Type: AWS::CloudFormation::Stack
+    Properties:
+      TemplateURL: ./stacks/storage.yaml
+      Parameters:
+        BucketName: !Sub ${Environment}-assets
+        Versioning: Suspended
+        Unknown: !ImportValue missing-export
+  Remote:
+    Type: AWS::CloudFormation::Stack
+    Properties:
+      TemplateURL: https://s3.amazonaws.com/bucket/template.yaml
+`,
+		"code/stacks/storage.yaml": `---
+Parameters:
+  BucketName:
+    Type: String
+    Default: default-name
+  Versioning:
+    Type: String
+    Default: Enabled
+  Unknown:
+    Type: String
+    Default: known
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: !Ref BucketName
+      Owner: !Ref Unknown
+      Stack: !Ref AWS::StackName
+      VersioningConfiguration:
+        Status: !Ref Versioning
+  Logs:
+    Type: AWS::CloudFormation::Stack
+    Properties:
+      TemplateURL: ../parent.yaml
+`,
+	})
+
+	contexts, err := New().ParseFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+	require.Len(t, contexts, 2)
+
+	var child *FileContext
+	for _, ctx := range contexts {
+		if ctx.Metadata().Range().GetFilename() == "code/stacks/storage.yaml" {
+			child = ctx
+		}
+	}
+	require.NotNil(t, child, "the child template should only be included with the parent's parameters")
+
+	bucket := child.GetResourceByLogicalID("Bucket")
+	require.NotNil(t, bucket)
+	assert.Equal(t, "prod-assets", bucket.GetStringProperty("BucketName").Value())
+	assert.Equal(t, "Suspended", bucket.GetStringProperty("VersioningConfiguration.Status").Value())
+	assert.True(t, bucket.GetProperty("Owner").IsUnresolved())
+	assert.Equal(t, "code-stacks-storage", bucket.GetStringProperty("Stack").Value(), "child stacks have a name of their own")
+}
+
+func Test_ImportValue(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/network.yaml": `---
+Resources:
+  Key:
+    Type: AWS::KMS::Key
+Outputs:
+  KeyArn:
+    Value: arn:aws:kms:eu-west-1:123456789012:key/shared
+    Export:
+      Name: !Sub ${AWS::StackName}-KeyArn
+  Status:
+    Value: Suspended
+    Export:
+      Name: versioning-status
+  Looped:
+    Value: !ImportValue looped
+    Export:
+      Name: looped
+`,
+		"code/app.json": `{
+  "Resources": {
+    "Bucket": {
+      "Type": "AWS::S3::Bucket",
+      "Properties": {
+        "KmsKey": {"Fn::ImportValue": "code-network-KeyArn"},
+        "OwnKey": {"Fn::ImportValue": {"Fn::Sub": "${AWS::StackName}-KeyArn"}},
+        "VersioningConfiguration": {
+          "Status": {"Fn::ImportValue": "versioning-status"}
+        },
+        "Looped": {"Fn::ImportValue": "looped"},
+        "Missing": {"Fn::ImportValue": "not-in-this-scan"}
+      }
+    }
+  }
+}`,
+	})
+
+	contexts, err := New().ParseFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+	require.Len(t, contexts, 2)
+
+	var bucket *Resource
+	for _, ctx := range contexts {
+		if res := ctx.GetResourceByLogicalID("Bucket"); res != nil {
+			bucket = res
+		}
+	}
+	require.NotNil(t, bucket)
+
+	assert.Equal(t, "arn:aws:kms:eu-west-1:123456789012:key/shared", bucket.GetStringProperty("KmsKey").Value())
+	status := bucket.GetProperty("VersioningConfiguration.Status")
+	assert.Equal(t, "Suspended", status.AsString())
+	assert.Equal(t, "code/app.json", status.Range().GetFilename())
+	assert.True(t, bucket.GetProperty("OwnKey").IsUnresolved(), "only export names are read with a stack name of their own")
+	assert.True(t, bucket.GetProperty("Looped").IsUnresolved())
+	assert.True(t, bucket.GetProperty("Missing").IsUnresolved())
+}
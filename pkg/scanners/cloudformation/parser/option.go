@@ -0,0 +1,82 @@
+package parser
+
+import (
+	"github.com/aquasecurity/defsec/pkg/scanners/options"
+)
+
+type ConfigurableCloudFormationParser interface {
+	options.ConfigurableParser
+	SetParameterFiles(...string)
+	SetTemplateParameterFiles(string, ...string)
+	SetParameters(map[string]string)
+	SetAccountID(string)
+	SetRegion(string)
+	SetPartition(string)
+	SetSAMExpansion(bool)
+}
+
+// OptionWithParameterFiles loads parameter values from files in the format used by 'aws cloudformation deploy' or
+// create-stack (a JSON list of ParameterKey/ParameterValue objects), the CodePipeline template configuration format,
+// or a list of Key=Value pairs. Later files take precedence over earlier ones. The files are used for every template
+// which is not given its own files with OptionWithTemplateParameterFiles.
+func OptionWithParameterFiles(paths ...string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if cf, ok := p.(ConfigurableCloudFormationParser); ok {
+			cf.SetParameterFiles(paths...)
+		}
+	}
+}
+
+// OptionWithTemplateParameterFiles loads parameter values for the template at the given path from the given files,
+// in place of any set with OptionWithParameterFiles. It can be used once for each template.
+func OptionWithTemplateParameterFiles(template string, paths ...string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if cf, ok := p.(ConfigurableCloudFormationParser); ok {
+			cf.SetTemplateParameterFiles(template, paths...)
+		}
+	}
+}
+
+// OptionWithParameters sets parameter values, taking precedence over parameter files and template defaults
+func OptionWithParameters(params map[string]string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if cf, ok := p.(ConfigurableCloudFormationParser); ok {
+			cf.SetParameters(params)
+		}
+	}
+}
+
+func OptionWithAccountID(accountID string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if cf, ok := p.(ConfigurableCloudFormationParser); ok {
+			cf.SetAccountID(accountID)
+		}
+	}
+}
+
+func OptionWithRegion(region string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if cf, ok := p.(ConfigurableCloudFormationParser); ok {
+			cf.SetRegion(region)
+		}
+	}
+}
+
+func OptionWithPartition(partition string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if cf, ok := p.(ConfigurableCloudFormationParser); ok {
+			cf.SetPartition(partition)
+		}
+	}
+}
+
+// OptionWithSAMExpansion adds the resources which AWS::Serverless resources implicitly create, such as execution roles,
+// log groups and API stages, to templates using the SAM transform, so they are also checked by the rules for those
+// resource types.
+func OptionWithSAMExpansion(enabled bool) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if cf, ok := p.(ConfigurableCloudFormationParser); ok {
+			cf.SetSAMExpansion(enabled)
+		}
+	}
+}
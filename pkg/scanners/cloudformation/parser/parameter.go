@@ -1,7 +1,9 @@
 package parser
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/cftypes"
 
@@ -10,7 +12,8 @@ import (
 )
 
 type Parameter struct {
-	inner parameterInner
+	inner        parameterInner
+	unresolvable bool
 }
 
 type parameterInner struct {
@@ -27,13 +30,13 @@ func (p *Parameter) UnmarshalJSONWithMetadata(node jfather.Node) error {
 }
 
 func (p *Parameter) Type() cftypes.CfType {
-	switch p.inner.Type {
-	case "Boolean":
+	switch {
+	case p.inner.Type == "Boolean":
 		return cftypes.Bool
-	case "String":
-		return cftypes.String
-	case "Integer":
+	case p.inner.Type == "Number", p.inner.Type == "Integer":
 		return cftypes.Int
+	case p.isList():
+		return cftypes.List
 	default:
 		return cftypes.String
 	}
@@ -43,17 +46,116 @@ func (p *Parameter) Default() interface{} {
 	return p.inner.Default
 }
 
+// UpdateDefault replaces the value of the parameter, such as with a value from a parameter file. The value is
+// coerced to the parameter's type when it is referenced.
 func (p *Parameter) UpdateDefault(inVal interface{}) {
-	passedVal := inVal.(string)
-
-	switch p.inner.Type {
-	case "Boolean":
-		p.inner.Default, _ = strconv.ParseBool(passedVal)
-	case "String":
-		p.inner.Default = passedVal
-	case "Integer":
-		p.inner.Default, _ = strconv.Atoi(passedVal)
+	p.inner.Default = inVal
+}
+
+func (p *Parameter) isList() bool {
+	return p.inner.Type == "CommaDelimitedList" || strings.HasPrefix(p.inner.Type, "List<")
+}
+
+// markUnresolvable records that the value of the parameter is not known, such as when a parent stack passes a value
+// which cannot be resolved
+func (p *Parameter) markUnresolvable() {
+	p.unresolvable = true
+}
+
+// isSSMParameter returns true if the value of the parameter is the name of an SSM parameter, rather than the value
+// itself, which is only looked up when the stack is deployed
+func (p *Parameter) isSSMParameter() bool {
+	return strings.HasPrefix(p.inner.Type, "AWS::SSM::Parameter::Value<")
+}
+
+// resolve returns the value of the parameter as a property, coerced according to the parameter's type
+func (p *Parameter) resolve(property *Property) (*Property, bool) {
+	if p.isSSMParameter() {
+		return abortIntrinsic(property, "the value of an SSM parameter cannot be resolved")
+	}
+	if p.unresolvable {
+		return abortIntrinsic(property, "the value of the parameter cannot be resolved")
+	}
+
+	value := p.Default()
+	if value == nil {
+		return property.deriveResolved(p.Type(), nil), true
+	}
+
+	switch p.Type() {
+	case cftypes.List:
+		numeric := p.inner.Type == "List<Number>"
+		var items []*Property
+		for _, item := range listItems(value) {
+			if numeric {
+				items = append(items, coerceNumber(property, item))
+			} else {
+				items = append(items, property.deriveResolved(cftypes.String, scalarString(item)))
+			}
+		}
+		return property.deriveResolved(cftypes.List, items), true
+	case cftypes.Int:
+		return coerceNumber(property, value), true
+	case cftypes.Bool:
+		if b, ok := value.(bool); ok {
+			return property.deriveResolved(cftypes.Bool, b), true
+		}
+		if b, err := strconv.ParseBool(scalarString(value)); err == nil {
+			return property.deriveResolved(cftypes.Bool, b), true
+		}
+		return property.deriveResolved(cftypes.String, scalarString(value)), true
+	default:
+		return property.deriveResolved(cftypes.String, scalarString(value)), true
+	}
+}
+
+func listItems(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case []string:
+		var items []interface{}
+		for _, item := range v {
+			items = append(items, item)
+		}
+		return items
+	default:
+		var items []interface{}
+		for _, item := range strings.Split(scalarString(value), ",") {
+			items = append(items, strings.TrimSpace(item))
+		}
+		return items
+	}
+}
+
+func coerceNumber(property *Property, value interface{}) *Property {
+	switch v := value.(type) {
+	case int:
+		return property.deriveResolved(cftypes.Int, v)
+	case float64:
+		if v == float64(int(v)) {
+			return property.deriveResolved(cftypes.Int, int(v))
+		}
+		return property.deriveResolved(cftypes.Float64, v)
+	}
+
+	str := scalarString(value)
+	if i, err := strconv.Atoi(str); err == nil {
+		return property.deriveResolved(cftypes.Int, i)
+	}
+	if f, err := strconv.ParseFloat(str, 64); err == nil {
+		return property.deriveResolved(cftypes.Float64, f)
+	}
+	return property.deriveResolved(cftypes.String, str)
+}
+
+func scalarString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
 	default:
-		p.inner.Default = passedVal
+		return fmt.Sprintf("%v", v)
 	}
 }
@@ -0,0 +1,154 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/aquasecurity/defsec/internal/types"
+)
+
+// loadParameterFiles reads each configured parameter file once per filesystem, so that a file which cannot be read is
+// reported as a single error rather than once for every template
+func (p *Parser) loadParameterFiles(target fs.FS) error {
+	key := types.CreateFSKey(target)
+	if p.parameterFileValues != nil && p.parameterFileFSKey == key {
+		return nil
+	}
+
+	paths := append([]string(nil), p.parameterFiles...)
+	for _, templatePaths := range p.templateParameterFiles {
+		paths = append(paths, templatePaths...)
+	}
+
+	loaded := make(map[string]map[string]interface{})
+	for _, path := range paths {
+		if _, ok := loaded[path]; ok {
+			continue
+		}
+		fileValues, err := loadParameterFile(target, path)
+		if err != nil {
+			return fmt.Errorf("failed to load parameters from %s: %w", path, err)
+		}
+		loaded[path] = fileValues
+	}
+	p.parameterFileValues = loaded
+	p.parameterFileFSKey = key
+	return nil
+}
+
+// loadParameterValues returns the values from the parameter files for the given template in order, followed by any
+// explicitly set parameters. Templates without parameter files of their own use those configured for every template.
+func (p *Parser) loadParameterValues(target fs.FS, templatePath string) (map[string]interface{}, error) {
+	if err := p.loadParameterFiles(target); err != nil {
+		return nil, err
+	}
+
+	paths, ok := p.templateParameterFiles[cleanTemplatePath(templatePath)]
+	if !ok {
+		paths = p.parameterFiles
+	}
+
+	values := make(map[string]interface{})
+	for _, path := range paths {
+		for name, value := range p.parameterFileValues[path] {
+			values[name] = value
+		}
+	}
+	for name, value := range p.parameters {
+		values[name] = value
+	}
+	return values, nil
+}
+
+func cleanTemplatePath(path string) string {
+	return strings.TrimPrefix(filepath.ToSlash(filepath.Clean(path)), "/")
+}
+
+func loadParameterFile(target fs.FS, path string) (map[string]interface{}, error) {
+	content, err := fs.ReadFile(target, filepath.ToSlash(path))
+	if err != nil {
+		return nil, err
+	}
+
+	trimmed := strings.TrimSpace(string(content))
+	switch {
+	case strings.HasPrefix(trimmed, "["):
+		return parseParameterList([]byte(trimmed))
+	case strings.HasPrefix(trimmed, "{"):
+		return parseTemplateConfiguration([]byte(trimmed))
+	default:
+		return parseKeyValuePairs(strings.Fields(trimmed))
+	}
+}
+
+// parseParameterList parses the format used by 'aws cloudformation deploy' and create-stack, which is a list of
+// ParameterKey/ParameterValue objects or of Key=Value strings
+func parseParameterList(content []byte) (map[string]interface{}, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(content, &items); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{})
+	for _, item := range items {
+		var pair string
+		if err := json.Unmarshal(item, &pair); err == nil {
+			parsed, err := parseKeyValuePairs([]string{pair})
+			if err != nil {
+				return nil, err
+			}
+			for name, value := range parsed {
+				values[name] = value
+			}
+			continue
+		}
+
+		var param struct {
+			ParameterKey     string      `json:"ParameterKey"`
+			ParameterValue   interface{} `json:"ParameterValue"`
+			UsePreviousValue bool        `json:"UsePreviousValue"`
+		}
+		if err := json.Unmarshal(item, &param); err != nil {
+			return nil, err
+		}
+		if param.ParameterKey == "" {
+			return nil, fmt.Errorf("parameter is missing a ParameterKey")
+		}
+		// the previous value is not known, so the template default is left in place
+		if param.UsePreviousValue {
+			continue
+		}
+		values[param.ParameterKey] = param.ParameterValue
+	}
+	return values, nil
+}
+
+// parseTemplateConfiguration parses the CodePipeline template configuration format, which holds the parameters in
+// a Parameters object alongside other stack configuration
+func parseTemplateConfiguration(content []byte) (map[string]interface{}, error) {
+	var config struct {
+		Parameters map[string]interface{} `json:"Parameters"`
+	}
+	if err := json.Unmarshal(content, &config); err != nil {
+		return nil, err
+	}
+	if config.Parameters == nil {
+		return make(map[string]interface{}), nil
+	}
+	return config.Parameters, nil
+}
+
+func parseKeyValuePairs(pairs []string) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+	for _, pair := range pairs {
+		name, value, found := strings.Cut(pair, "=")
+		if !found || name == "" {
+			return nil, fmt.Errorf("invalid parameter %q, expected Key=Value", pair)
+		}
+		values[name] = value
+	}
+	return values, nil
+}
@@ -0,0 +1,195 @@
+package parser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aquasecurity/defsec/pkg/scanners/options"
+	"github.com/aquasecurity/defsec/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const parameterTemplate = `---
+Parameters:
+  BucketName:
+    Type: String
+    Default: default-bucket
+  Environment:
+    Type: String
+    Default: dev
+  Retention:
+    Type: Number
+    Default: 7
+  Subnets:
+    Type: CommaDelimitedList
+    Default: subnet-a, subnet-b
+  Ports:
+    Type: List<Number>
+  AmiId:
+    Type: AWS::SSM::Parameter::Value<AWS::EC2::Image::Id>
+    Default: /aws/service/ami-amazon-linux-latest/amzn2-ami-hvm-x86_64-gp2
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: !Ref BucketName
+      Environment: !Ref Environment
+      Retention: !Ref Retention
+      Subnets: !Ref Subnets
+      Ports: !Ref Ports
+      ImageId: !Ref AmiId
+      Arn: !Sub arn:${AWS::Partition}:s3:::${BucketName}-${AWS::Region}-${AWS::AccountId}
+`
+
+func parseWithParameters(t *testing.T, files map[string]string, opts ...options.ParserOption) *FileContext {
+	files["code/template.yaml"] = parameterTemplate
+	fs := testutil.CreateFS(t, files)
+	contexts, err := New(opts...).ParseFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+	require.Len(t, contexts, 1)
+	return contexts[0]
+}
+
+func Test_ParameterDefaultsAndCoercion(t *testing.T) {
+	ctx := parseWithParameters(t, map[string]string{})
+	bucket := ctx.GetResourceByLogicalID("Bucket")
+	require.NotNil(t, bucket)
+
+	assert.Equal(t, "default-bucket", bucket.GetStringProperty("BucketName").Value())
+	assert.Equal(t, 7, bucket.GetIntProperty("Retention").Value())
+
+	subnets := bucket.GetProperty("Subnets")
+	require.True(t, subnets.IsList())
+	require.Len(t, subnets.AsList(), 2)
+	assert.Equal(t, "subnet-a", subnets.AsList()[0].AsString())
+	assert.Equal(t, "subnet-b", subnets.AsList()[1].AsString())
+
+	assert.True(t, bucket.GetProperty("ImageId").IsUnresolved())
+	assert.Equal(t, "arn:aws:s3:::default-bucket-eu-west-1-123456789012", bucket.GetStringProperty("Arn").Value())
+}
+
+func Test_ParameterFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name: "deploy json",
+			content: `[
+  {"ParameterKey": "BucketName", "ParameterValue": "file-bucket"},
+  {"ParameterKey": "Ports", "ParameterValue": "80,443"},
+  {"ParameterKey": "Environment", "UsePreviousValue": true}
+]`,
+		},
+		{
+			name: "codepipeline template configuration",
+			content: `{
+  "Parameters": {
+    "BucketName": "file-bucket",
+    "Ports": "80,443"
+  },
+  "Tags": {
+    "team": "platform"
+  }
+}`,
+		},
+		{
+			name:    "key value pairs",
+			content: "BucketName=file-bucket\nPorts=80,443\n",
+		},
+		{
+			name:    "json list of key value pairs",
+			content: `["BucketName=file-bucket", "Ports=80,443"]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := parseWithParameters(t, map[string]string{
+				"params/prod.json": test.content,
+			}, OptionWithParameterFiles("params/prod.json"))
+
+			bucket := ctx.GetResourceByLogicalID("Bucket")
+			require.NotNil(t, bucket)
+			assert.Equal(t, "file-bucket", bucket.GetStringProperty("BucketName").Value())
+			assert.Equal(t, "dev", bucket.GetStringProperty("Environment").Value())
+
+			ports := bucket.GetProperty("Ports").AsList()
+			require.Len(t, ports, 2)
+			assert.Equal(t, 80, ports[0].AsInt())
+			assert.Equal(t, 443, ports[1].AsInt())
+		})
+	}
+}
+
+func Test_ParameterOverridesAndPseudoParameters(t *testing.T) {
+	ctx := parseWithParameters(t, map[string]string{
+		"params/a.txt": "BucketName=first Environment=staging",
+		"params/b.txt": "BucketName=second",
+	},
+		OptionWithParameterFiles("params/a.txt", "params/b.txt"),
+		OptionWithParameters(map[string]string{"Environment": "prod", "Unknown": "ignored"}),
+		OptionWithAccountID("111122223333"),
+		OptionWithRegion("cn-north-1"),
+		OptionWithPartition("aws-cn"),
+	)
+
+	bucket := ctx.GetResourceByLogicalID("Bucket")
+	require.NotNil(t, bucket)
+	assert.Equal(t, "second", bucket.GetStringProperty("BucketName").Value())
+	assert.Equal(t, "prod", bucket.GetStringProperty("Environment").Value())
+	assert.Equal(t, "arn:aws-cn:s3:::second-cn-north-1-111122223333", bucket.GetStringProperty("Arn").Value())
+}
+
+func Test_InvalidParameterFile(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/template.yaml": parameterTemplate,
+		"params/bad.txt":     "BucketName",
+	})
+	_, err := New(OptionWithParameterFiles("params/bad.txt")).ParseFS(context.TODO(), fs, "code")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "params/bad.txt")
+
+	_, err = New(OptionWithTemplateParameterFiles("code/other.yaml", "params/missing.txt")).ParseFS(context.TODO(), fs, "code")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "params/missing.txt")
+}
+
+func Test_TemplateParameterFiles(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/first.yaml":  parameterTemplate,
+		"code/second.yaml": parameterTemplate,
+		"code/third.yaml":  parameterTemplate,
+		"params/all.txt":   "BucketName=shared",
+		"params/first.txt": "BucketName=first",
+		"params/env.txt":   "Environment=prod",
+	})
+
+	contexts, err := New(
+		OptionWithParameterFiles("params/all.txt"),
+		OptionWithTemplateParameterFiles("code/first.yaml", "params/first.txt", "params/env.txt"),
+		OptionWithTemplateParameterFiles("/code/second.yaml", "params/env.txt"),
+	).ParseFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+	require.Len(t, contexts, 3)
+
+	names := make(map[string]string)
+	environments := make(map[string]string)
+	for _, ctx := range contexts {
+		bucket := ctx.GetResourceByLogicalID("Bucket")
+		require.NotNil(t, bucket)
+		names[ctx.filepath] = bucket.GetStringProperty("BucketName").Value()
+		environments[ctx.filepath] = bucket.GetStringProperty("Environment").Value()
+	}
+	assert.Equal(t, map[string]string{
+		"code/first.yaml":  "first",
+		"code/second.yaml": "default-bucket",
+		"code/third.yaml":  "shared",
+	}, names)
+	assert.Equal(t, map[string]string{
+		"code/first.yaml":  "prod",
+		"code/second.yaml": "prod",
+		"code/third.yaml":  "dev",
+	}, environments)
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/aquasecurity/defsec/internal/debug"
+	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/detection"
 	"github.com/aquasecurity/defsec/pkg/scanners/options"
 
@@ -21,8 +22,47 @@ import (
 var _ options.ConfigurableParser = (*Parser)(nil)
 
 type Parser struct {
-	debug        debug.Logger
-	skipRequired bool
+	debug          debug.Logger
+	skipRequired   bool
+	parameterFiles []string
+	parameters     map[string]string
+	pseudo         pseudoParameters
+	expandSAM      bool
+
+	templateParameterFiles map[string][]string
+	parameterFileValues    map[string]map[string]interface{}
+	parameterFileFSKey     string
+}
+
+func (p *Parser) SetSAMExpansion(enabled bool) {
+	p.expandSAM = enabled
+}
+
+func (p *Parser) SetParameterFiles(paths ...string) {
+	p.parameterFiles = paths
+}
+
+func (p *Parser) SetTemplateParameterFiles(template string, paths ...string) {
+	if p.templateParameterFiles == nil {
+		p.templateParameterFiles = make(map[string][]string)
+	}
+	p.templateParameterFiles[cleanTemplatePath(template)] = paths
+}
+
+func (p *Parser) SetParameters(params map[string]string) {
+	p.parameters = params
+}
+
+func (p *Parser) SetAccountID(accountID string) {
+	p.pseudo.accountID = accountID
+}
+
+func (p *Parser) SetRegion(region string) {
+	p.pseudo.region = region
+}
+
+func (p *Parser) SetPartition(partition string) {
+	p.pseudo.partition = partition
 }
 
 func (p *Parser) SetDebugWriter(writer io.Writer) {
@@ -42,6 +82,12 @@ func New(options ...options.ParserOption) *Parser {
 }
 
 func (p *Parser) ParseFS(ctx context.Context, target fs.FS, dir string) (FileContexts, error) {
+	// parameter files are read again for each scan in case they have changed, but only once for all templates
+	p.parameterFileValues = nil
+	if err := p.loadParameterFiles(target); err != nil {
+		return nil, err
+	}
+
 	var contexts FileContexts
 	if err := fs.WalkDir(target, filepath.ToSlash(dir), func(path string, entry fs.DirEntry, err error) error {
 		select {
@@ -70,6 +116,15 @@ func (p *Parser) ParseFS(ctx context.Context, target fs.FS, dir string) (FileCon
 	}); err != nil {
 		return nil, err
 	}
+
+	// exports are linked before nested stacks are loaded so they can be passed to child stacks as parameters, and
+	// again afterwards to include those exported by the child stacks
+	linkExports(contexts)
+	contexts, err := p.loadNestedStacks(ctx, target, contexts)
+	if err != nil {
+		return nil, err
+	}
+	linkExports(contexts)
 	return contexts, nil
 }
 
@@ -128,19 +183,42 @@ func (p *Parser) ParseFile(ctx context.Context, fs fs.FS, path string) (context
 		SourceFormat: sourceFmt,
 	}
 
-	if strings.HasSuffix(strings.ToLower(path), ".json") {
+	values, err := p.loadParameterValues(fs, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON templates are only decoded through yaml when they need transforming, as jfather keeps better track of ranges
+	if sourceFmt == JsonSourceFormat && !mayNeedTransforming(content) {
 		if err := jfather.Unmarshal(content, context); err != nil {
 			return nil, NewErrInvalidContent(path, err)
 		}
 	} else {
-		if err := yaml.Unmarshal(content, context); err != nil {
+		var root yaml.Node
+		if err := yaml.Unmarshal(content, &root); err != nil {
 			return nil, NewErrInvalidContent(path, err)
 		}
+		if err := p.applyTransforms(&root, fs, path, values); err != nil {
+			return nil, err
+		}
+		if len(root.Content) > 0 {
+			if err := root.Decode(context); err != nil {
+				return nil, NewErrInvalidContent(path, err)
+			}
+		}
 	}
 
 	context.lines = lines
 	context.SourceFormat = sourceFmt
 	context.filepath = path
+	context.pseudo = p.pseudo
+	context.debug = p.debug
+
+	for name, value := range values {
+		if param, ok := context.Parameters[name]; ok && param != nil {
+			param.UpdateDefault(value)
+		}
+	}
 
 	p.debug.Log("Context loaded from source %s", path)
 
@@ -148,5 +226,24 @@ func (p *Parser) ParseFile(ctx context.Context, fs fs.FS, path string) (context
 		r.ConfigureResource(name, fs, path, context)
 	}
 
+	for name, condition := range context.Conditions {
+		configureProperty(condition, name, fs, path, context)
+	}
+
+	for name, output := range context.Outputs {
+		configureProperty(output, name, fs, path, context)
+	}
+
 	return context, nil
 }
+
+// configureProperty prepares a property which sits outside a resource, such as a condition or an output
+func configureProperty(property *Property, name string, target fs.FS, path string, context *FileContext) {
+	if property == nil {
+		return
+	}
+	property.setName(name)
+	rng := types.NewRange(path, property.rng.GetStartLine(), property.rng.GetEndLine(), "", target)
+	property.setFileAndParentRange(target, path, rng)
+	property.setContext(context)
+}
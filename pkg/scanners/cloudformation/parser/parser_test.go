@@ -176,3 +176,44 @@ func createTestFileContext(t *testing.T, source string) *FileContext {
 	require.Len(t, contexts, 1)
 	return contexts[0]
 }
+
+func Test_ResourceTags(t *testing.T) {
+	source := `---
+Parameters:
+  Owner:
+    Type: String
+    Default: platform
+Resources:
+  Listed:
+    Type: 'AWS::S3::Bucket'
+    Properties:
+      Tags:
+      - Key: owner
+        Value: !Ref Owner
+      - Key: retention
+        Value: 30
+  Mapped:
+    Type: 'AWS::SSM::Parameter'
+    Properties:
+      Tags:
+        owner: security
+  Untagged:
+    Type: 'AWS::S3::Bucket'
+`
+
+	files, err := parseFile(t, source, "cf.yaml")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	file := files[0]
+
+	listed := file.GetResourceByLogicalID("Listed").GetTags()
+	assert.Equal(t, map[string]string{"owner": "platform", "retention": "30"}, listed.Value())
+	assert.Equal(t, 11, listed.GetMetadata().Range().GetStartLine())
+
+	mapped := file.GetResourceByLogicalID("Mapped").GetTags()
+	assert.Equal(t, map[string]string{"owner": "security"}, mapped.Value())
+
+	untagged := file.GetResourceByLogicalID("Untagged").GetTags()
+	assert.Equal(t, 0, untagged.Len())
+	assert.True(t, untagged.GetMetadata().IsDefault())
+}
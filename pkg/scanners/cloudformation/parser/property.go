@@ -29,6 +29,8 @@ type Property struct {
 	Inner       PropertyInner
 	logicalId   string
 	unresolved  bool
+	noValue     bool
+	synthetic   bool
 }
 
 type PropertyInner struct {
@@ -64,13 +66,32 @@ func (p *Property) setContext(ctx *FileContext) {
 		}
 	}
 
-	if p.IsList() {
-		for _, subProp := range p.AsList() {
+	if p.Type() == cftypes.List {
+		for _, subProp := range p.rawList() {
 			subProp.setContext(ctx)
 		}
 	}
 }
 
+func (p *Property) resetResolution() {
+	if p == nil {
+		return
+	}
+	p.unresolved = false
+
+	if p.IsMap() {
+		for _, subProp := range p.AsMap() {
+			subProp.resetResolution()
+		}
+	}
+
+	if p.Type() == cftypes.List {
+		for _, subProp := range p.rawList() {
+			subProp.resetResolution()
+		}
+	}
+}
+
 func (p *Property) setFileAndParentRange(target fs.FS, filepath string, parentRange types.Range) {
 	p.rng = types.NewRange(filepath, p.rng.GetStartLine(), p.rng.GetEndLine(), p.rng.GetSourcePrefix(), target)
 	p.parentRange = parentRange
@@ -84,7 +105,7 @@ func (p *Property) setFileAndParentRange(target fs.FS, filepath string, parentRa
 			subProp.setFileAndParentRange(target, filepath, parentRange)
 		}
 	case cftypes.List:
-		for _, subProp := range p.AsList() {
+		for _, subProp := range p.rawList() {
 			if subProp == nil {
 				continue
 			}
@@ -97,6 +118,7 @@ func (p *Property) UnmarshalYAML(node *yaml.Node) error {
 	p.rng = types.NewRange("", node.Line, calculateEndLine(node), "", nil)
 
 	p.comment = node.LineComment
+	p.synthetic = isSynthetic(node)
 	return setPropertyValueFromYaml(node, &p.Inner)
 }
 
@@ -134,13 +156,48 @@ func (p *Property) isFunction() bool {
 		return false
 	}
 	if p.Type() == cftypes.Map {
-		for n := range p.AsMap() {
+		for n, val := range p.AsMap() {
+			if n == "Condition" {
+				// IAM policy statements also have a Condition key, but it is never the only key and is not a string
+				return len(p.AsMap()) == 1 && val.IsString()
+			}
 			return IsIntrinsic(n)
 		}
 	}
 	return false
 }
 
+// isNoValue returns true if the property resolves to AWS::NoValue, meaning it should be treated as though it was
+// removed from the template. Only the functions which can produce AWS::NoValue are followed, so that checking does
+// not resolve (and possibly mark as unresolved) anything else.
+func (p *Property) isNoValue() bool {
+	if p == nil || p.ctx == nil {
+		return false
+	}
+	if !p.isFunction() {
+		return p.noValue
+	}
+	fn := p.AsMap()
+	if ref, ok := fn["Ref"]; ok {
+		return ref.IsString() && ref.AsString() == "AWS::NoValue"
+	}
+	if args, ok := fn["Fn::If"]; ok {
+		branches := args.rawList()
+		if len(branches) != 3 || !branches[0].IsString() {
+			return false
+		}
+		condition, known := p.ctx.EvaluateCondition(branches[0].AsString())
+		if !known {
+			return false
+		}
+		if condition {
+			return branches[1].isNoValue()
+		}
+		return branches[2].isNoValue()
+	}
+	return false
+}
+
 func (p *Property) RawValue() interface{} {
 	return p.Inner.Value
 }
@@ -253,7 +310,15 @@ func (p *Property) GetProperty(path string) *Property {
 		}
 	}
 
-	if len(pathParts) == 1 || property == nil {
+	if property == nil {
+		return nil
+	}
+
+	if len(pathParts) == 1 {
+		if property.isFunction() {
+			resolved, _ := property.resolveValue()
+			return resolved
+		}
 		return property
 	}
 
@@ -349,8 +414,8 @@ func (p *Property) SetLogicalResource(id string) {
 		}
 	}
 
-	if p.IsList() {
-		for _, subProp := range p.AsList() {
+	if p.Type() == cftypes.List {
+		for _, subProp := range p.rawList() {
 			subProp.SetLogicalResource(id)
 		}
 	}
@@ -358,6 +423,15 @@ func (p *Property) SetLogicalResource(id string) {
 }
 
 func (p *Property) GetJsonBytes(squashList ...bool) []byte {
+	// properties created by transforms don't correspond to the lines of the template, so are encoded from their values
+	if p.synthetic {
+		raw, err := json.Marshal(p.toInterface())
+		if err != nil {
+			return nil
+		}
+		return raw
+	}
+
 	lines, err := p.AsRawStrings()
 	if err != nil {
 		return nil
@@ -389,6 +463,34 @@ func (p *Property) GetJsonBytesAsString(squashList ...bool) string {
 	return string(p.GetJsonBytes(squashList...))
 }
 
+// toInterface converts the property to plain values, resolving any intrinsic functions which can be resolved
+func (p *Property) toInterface() interface{} {
+	if p == nil {
+		return nil
+	}
+	if p.isFunction() {
+		if resolved, ok := p.resolveValue(); ok && resolved != nil && resolved != p {
+			return resolved.toInterface()
+		}
+	}
+	switch p.Type() {
+	case cftypes.Map:
+		values := make(map[string]interface{})
+		for key, value := range p.AsMap() {
+			values[key] = value.toInterface()
+		}
+		return values
+	case cftypes.List:
+		items := make([]interface{}, 0)
+		for _, item := range p.AsList() {
+			items = append(items, item.toInterface())
+		}
+		return items
+	default:
+		return p.Inner.Value
+	}
+}
+
 func removeLeftMargin(lines []string) []string {
 	if len(lines) == 0 {
 		return lines
@@ -181,11 +181,34 @@ func (p *Property) AsList() []*Property {
 	}
 
 	if list, ok := p.Inner.Value.([]*Property); ok {
-		return list
+		return withoutNoValues(list)
 	}
 	return nil
 }
 
+// rawList returns the items of a list property as written, without resolving or removing any of them
+func (p *Property) rawList() []*Property {
+	list, _ := p.Inner.Value.([]*Property)
+	return list
+}
+
+// withoutNoValues removes list items which resolve to AWS::NoValue, as CloudFormation does
+func withoutNoValues(list []*Property) []*Property {
+	for i, item := range list {
+		if !item.isNoValue() {
+			continue
+		}
+		filtered := append([]*Property{}, list[:i]...)
+		for _, remaining := range list[i+1:] {
+			if !remaining.isNoValue() {
+				filtered = append(filtered, remaining)
+			}
+		}
+		return filtered
+	}
+	return list
+}
+
 func (p *Property) EqualTo(checkValue interface{}, equalityOptions ...EqualityOptions) bool {
 	var ignoreCase bool
 	for _, option := range equalityOptions {
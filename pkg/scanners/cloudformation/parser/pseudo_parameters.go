@@ -1,11 +1,81 @@
 package parser
 
-var pseudoParameters = map[string]interface{}{
-	"AWS::AccountId":        "123456789012",
-	"AWS::NotificationARNs": []string{"notification::arn::1", "notification::arn::2"},
-	"AWS::NoValue":          "",
-	"AWS::Partition":        "aws",
-	"AWS::Region":           "eu-west-1",
-	"AWS::StackId":          "arn:aws:cloudformation:eu-west-1:stack/ID",
-	"AWS::StackName":        "cfsec-test-stack",
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+const (
+	defaultAccountID = "123456789012"
+	defaultRegion    = "eu-west-1"
+	defaultPartition = "aws"
+	defaultStackName = "cfsec-test-stack"
+
+	maxStackNameLength = 128
+)
+
+// pseudoParameters holds the account, region and partition which a template is assumed to be deployed to. Any
+// which are not set fall back to fixed placeholder values. The stack name is only set for child stacks and while
+// reading export names, so that templates which include the stack name in their exports do not collide.
+type pseudoParameters struct {
+	accountID string
+	region    string
+	partition string
+	stackName string
+}
+
+func (p pseudoParameters) values() map[string]interface{} {
+	accountID := valueOrDefault(p.accountID, defaultAccountID)
+	region := valueOrDefault(p.region, defaultRegion)
+	partition := valueOrDefault(p.partition, defaultPartition)
+	stackName := valueOrDefault(p.stackName, defaultStackName)
+
+	urlSuffix := "amazonaws.com"
+	if strings.HasPrefix(partition, "aws-cn") {
+		urlSuffix = "amazonaws.com.cn"
+	}
+
+	return map[string]interface{}{
+		"AWS::AccountId":        accountID,
+		"AWS::NotificationARNs": []string{"notification::arn::1", "notification::arn::2"},
+		"AWS::Partition":        partition,
+		"AWS::Region":           region,
+		"AWS::StackId":          fmt.Sprintf("arn:%s:cloudformation:%s:%s:stack/%s/ID", partition, region, accountID, stackName),
+		"AWS::StackName":        stackName,
+		"AWS::URLSuffix":        urlSuffix,
+	}
+}
+
+func valueOrDefault(value string, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+// stackNameFromPath derives a valid stack name from the path of a template, e.g. "stacks/network.yaml" becomes
+// "stacks-network". Stack names may only hold letters, digits and hyphens, must start with a letter and are at most
+// 128 characters long, so the end of the path is kept as it is the most specific part.
+func stackNameFromPath(filepath string) string {
+	name := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '-'
+	}, strings.TrimSuffix(filepath, path.Ext(filepath)))
+	if len(name) > maxStackNameLength {
+		name = name[len(name)-maxStackNameLength:]
+	}
+	name = strings.Trim(name, "-")
+	if name == "" {
+		return defaultStackName
+	}
+	if first := name[0]; first >= '0' && first <= '9' {
+		name = "stack-" + name
+		if len(name) > maxStackNameLength {
+			name = name[:maxStackNameLength]
+		}
+	}
+	return name
 }
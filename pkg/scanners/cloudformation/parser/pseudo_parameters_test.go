@@ -0,0 +1,27 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_stackNameFromPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{path: "main.yaml", expected: "main"},
+		{path: "stacks/network.template.json", expected: "stacks-network-template"},
+		{path: "/code/my_app/app.yml", expected: "code-my-app-app"},
+		{path: "2022/app.yaml", expected: "stack-2022-app"},
+		{path: ".yaml", expected: defaultStackName},
+		{path: strings.Repeat("a", 200) + "/app.yaml", expected: strings.Repeat("a", 124) + "-app"},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.expected, stackNameFromPath(test.path))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"io/fs"
+	"strconv"
 	"strings"
 
 	"github.com/aquasecurity/defsec/internal/types"
@@ -20,7 +21,9 @@ type Resource struct {
 
 type ResourceInner struct {
 	Type       string               `json:"Type" yaml:"Type"`
+	Condition  string               `json:"Condition" yaml:"Condition"`
 	Properties map[string]*Property `json:"Properties" yaml:"Properties"`
+	Metadata   *Property            `json:"Metadata" yaml:"Metadata"`
 }
 
 func (r *Resource) ConfigureResource(id string, target fs.FS, filepath string, ctx *FileContext) {
@@ -35,6 +38,9 @@ func (r *Resource) setId(id string) {
 	for n, p := range r.properties() {
 		p.setName(n)
 	}
+	if r.Inner.Metadata != nil {
+		r.Inner.Metadata.setName("Metadata")
+	}
 }
 
 func (r *Resource) setFile(target fs.FS, filepath string) {
@@ -43,6 +49,9 @@ func (r *Resource) setFile(target fs.FS, filepath string) {
 	for _, p := range r.Inner.Properties {
 		p.setFileAndParentRange(target, filepath, r.rng)
 	}
+	if r.Inner.Metadata != nil {
+		r.Inner.Metadata.setFileAndParentRange(target, filepath, r.rng)
+	}
 }
 
 func (r *Resource) setContext(ctx *FileContext) {
@@ -52,6 +61,20 @@ func (r *Resource) setContext(ctx *FileContext) {
 		p.SetLogicalResource(r.id)
 		p.setContext(ctx)
 	}
+	if r.Inner.Metadata != nil {
+		r.Inner.Metadata.SetLogicalResource(r.id)
+		r.Inner.Metadata.setContext(ctx)
+	}
+}
+
+func (r *Resource) resetResolution() {
+	if r == nil {
+		return
+	}
+	for _, p := range r.Inner.Properties {
+		p.resetResolution()
+	}
+	r.Inner.Metadata.resetResolution()
 }
 
 func (r *Resource) UnmarshalYAML(value *yaml.Node) error {
@@ -73,6 +96,18 @@ func (r *Resource) Type() string {
 	return r.Inner.Type
 }
 
+// ConditionMet returns false if the resource has a Condition which evaluates to false, meaning the resource would
+// not be created. Resources whose condition cannot be evaluated are assumed to be created.
+func (r *Resource) ConditionMet() bool {
+	if r.Inner.Condition == "" || r.ctx == nil {
+		return true
+	}
+	if met, known := r.ctx.EvaluateCondition(r.Inner.Condition); known {
+		return met
+	}
+	return true
+}
+
 func (r *Resource) Range() types.Range {
 	return r.rng
 }
@@ -122,6 +157,17 @@ func (r *Resource) GetProperty(path string) *Property {
 	return &Property{}
 }
 
+// GetMetadataProperty returns the value at the given path in the Metadata section of the resource
+func (r *Resource) GetMetadataProperty(path string) *Property {
+	if r.Inner.Metadata.IsNil() {
+		return &Property{}
+	}
+	if property := r.Inner.Metadata.GetProperty(path); property != nil {
+		return property
+	}
+	return &Property{}
+}
+
 func (r *Resource) GetStringProperty(path string, defaultValue ...string) types.StringValue {
 	defVal := ""
 	if len(defaultValue) > 0 {
@@ -164,6 +210,48 @@ func (r *Resource) GetIntProperty(path string, defaultValue ...int) types.IntVal
 	return prop.AsIntValue()
 }
 
+// GetTags returns the resource's Tags property, which is either a list of Key/Value pairs or, for some resource
+// types, a map of tag names to values
+func (r *Resource) GetTags() types.MapValue {
+	prop := r.GetProperty("Tags")
+	if prop.IsNil() {
+		return types.MapDefault(make(map[string]string), r.Metadata())
+	}
+
+	tags := make(map[string]string)
+	switch {
+	case prop.IsList():
+		for _, tag := range prop.AsList() {
+			key := tag.GetProperty("Key")
+			if !key.IsString() {
+				continue
+			}
+			if value, ok := tagValue(tag.GetProperty("Value")); ok {
+				tags[key.AsString()] = value
+			}
+		}
+	case prop.IsMap():
+		for key, val := range prop.AsMap() {
+			if value, ok := tagValue(val); ok {
+				tags[key] = value
+			}
+		}
+	}
+	return types.Map(tags, prop.Metadata())
+}
+
+func tagValue(prop *Property) (string, bool) {
+	switch {
+	case prop.IsString():
+		return prop.AsString(), true
+	case prop.IsInt():
+		return strconv.Itoa(prop.AsInt()), true
+	case prop.IsBool():
+		return strconv.FormatBool(prop.AsBool()), true
+	}
+	return "", false
+}
+
 func (r *Resource) StringDefault(defaultValue string) types.StringValue {
 	return types.StringDefault(defaultValue, r.Metadata())
 }
@@ -0,0 +1,171 @@
+package parser
+
+import (
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+const forEachPrefix = "Fn::ForEach::"
+
+// loopContext holds what is needed to find the collection of a Fn::ForEach loop which refers to a parameter. Loops
+// are only expanded when the template declares the AWS::LanguageExtensions transform.
+type loopContext struct {
+	enabled  bool
+	template *yaml.Node
+	values   map[string]interface{}
+}
+
+// expandForEach replaces the Fn::ForEach loops in a node and its children with the output of the loop for each item
+// in its collection. Loops which can't be expanded are removed, as they aren't valid resources or outputs.
+func expandForEach(node *yaml.Node, loops *loopContext) {
+	if node == nil {
+		return
+	}
+
+	if node.Kind == yaml.MappingNode {
+		var content []*yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if strings.HasPrefix(key.Value, forEachPrefix) {
+				if expanded, ok := loops.expand(value); ok {
+					content = append(content, expanded...)
+				}
+				continue
+			}
+			content = append(content, key, value)
+		}
+		node.Content = content
+	}
+
+	for _, child := range node.Content {
+		expandForEach(child, loops)
+	}
+}
+
+// expand returns the key/value pairs output by a loop, given as [Identifier, Collection, OutputTemplate]
+func (l *loopContext) expand(loop *yaml.Node) ([]*yaml.Node, bool) {
+	if !l.enabled || loop.Kind != yaml.SequenceNode || len(loop.Content) != 3 {
+		return nil, false
+	}
+	identifier, output := loop.Content[0], loop.Content[2]
+	if identifier.Kind != yaml.ScalarNode || output.Kind != yaml.MappingNode {
+		return nil, false
+	}
+
+	items, ok := l.collection(loop.Content[1])
+	if !ok {
+		return nil, false
+	}
+
+	var expanded []*yaml.Node
+	for _, item := range items {
+		copied := copyNode(output)
+		substituteLoopItem(copied, identifier.Value, item)
+		markSynthetic(copied, 0)
+		expandForEach(copied, l)
+		expanded = append(expanded, copied.Content...)
+	}
+	return expanded, true
+}
+
+// collection returns the items of a loop's collection, which is either a list or a reference to a list parameter
+func (l *loopContext) collection(node *yaml.Node) ([]string, bool) {
+	if node.Kind == yaml.SequenceNode {
+		var items []string
+		for _, item := range node.Content {
+			if item.Kind != yaml.ScalarNode {
+				return nil, false
+			}
+			items = append(items, item.Value)
+		}
+		return items, true
+	}
+
+	name, ok := refName(node)
+	if !ok {
+		return nil, false
+	}
+
+	if value, ok := l.values[name]; ok {
+		var items []string
+		for _, item := range listItems(value) {
+			items = append(items, scalarString(item))
+		}
+		return items, true
+	}
+
+	def := mappingValue(mappingValue(mappingValue(l.template, "Parameters"), name), "Default")
+	if def == nil {
+		return nil, false
+	}
+	switch def.Kind {
+	case yaml.ScalarNode:
+		var items []string
+		for _, item := range strings.Split(def.Value, ",") {
+			items = append(items, strings.TrimSpace(item))
+		}
+		return items, true
+	case yaml.SequenceNode:
+		return l.collection(def)
+	}
+	return nil, false
+}
+
+// refName returns the name referred to by a Ref, in either its long or short form
+func refName(node *yaml.Node) (string, bool) {
+	if node.Tag == "!Ref" && node.Kind == yaml.ScalarNode {
+		return node.Value, true
+	}
+	if node.Kind == yaml.MappingNode && len(node.Content) == 2 && node.Content[0].Value == "Ref" && node.Content[1].Kind == yaml.ScalarNode {
+		return node.Content[1].Value, true
+	}
+	return "", false
+}
+
+// substituteLoopItem replaces the loop identifier with the current item in keys, Fn::Sub strings and references
+func substituteLoopItem(node *yaml.Node, identifier string, item string) {
+	switch {
+	case node.Tag == "!Sub" && node.Kind == yaml.ScalarNode:
+		node.Value = substituteIdentifier(node.Value, identifier, item)
+	case node.Tag == "!Sub" && node.Kind == yaml.SequenceNode && len(node.Content) > 0:
+		node.Content[0].Value = substituteIdentifier(node.Content[0].Value, identifier, item)
+	}
+
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			key.Value = substituteIdentifier(key.Value, identifier, item)
+			if key.Value != "Fn::Sub" {
+				continue
+			}
+			switch {
+			case value.Kind == yaml.ScalarNode:
+				value.Value = substituteIdentifier(value.Value, identifier, item)
+			case value.Kind == yaml.SequenceNode && len(value.Content) > 0:
+				value.Content[0].Value = substituteIdentifier(value.Content[0].Value, identifier, item)
+			}
+		}
+	}
+
+	for i, child := range node.Content {
+		if name, ok := refName(child); ok && name == identifier {
+			node.Content[i] = scalarNode(item, child.Line)
+			continue
+		}
+		substituteLoopItem(child, identifier, item)
+	}
+}
+
+// substituteIdentifier replaces ${Identifier} with the item, and &{Identifier} with the item stripped of any
+// characters which aren't alphanumeric, so it can be used in a logical ID
+func substituteIdentifier(value string, identifier string, item string) string {
+	value = strings.ReplaceAll(value, "${"+identifier+"}", item)
+	return strings.ReplaceAll(value, "&{"+identifier+"}", strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, item))
+}
@@ -0,0 +1,132 @@
+package parser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aquasecurity/defsec/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ForEachTransform(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/template.yaml": `---
+Transform: AWS::LanguageExtensions
+Parameters:
+  Environments:
+    Type: CommaDelimitedList
+    Default: dev, prod
+Resources:
+  Fn::ForEach::Buckets:
+    - Name
+    - [logs, data-store]
+    - '&{Name}Bucket':
+        Type: AWS::S3::Bucket
+        Properties:
+          BucketName: !Sub ${Name}-bucket
+          Tags:
+            - Key: Name
+              Value: !Ref Name
+  Fn::ForEach::Queues:
+    - Environment
+    - !Ref Environments
+    - Fn::ForEach::Types:
+        - Type
+        - [standard, priority]
+        - '${Environment}${Type}Queue':
+            Type: AWS::SQS::Queue
+            Properties:
+              QueueName:
+                Fn::Sub: ${Environment}-${Type}
+  Topic:
+    Type: AWS::SNS::Topic
+    Properties:
+      DisplayName: !ToJsonString
+        Count: !Length [a, b, c]
+        Environments: !Ref Environments
+      TopicName: !Length
+        Ref: Environments
+`,
+	})
+
+	file, err := New().ParseFile(context.TODO(), fs, "code/template.yaml")
+	require.NoError(t, err)
+
+	logs := file.GetResourceByLogicalID("logsBucket")
+	require.NotNil(t, logs)
+	assert.Equal(t, "logs-bucket", logs.GetStringProperty("BucketName").Value())
+
+	data := file.GetResourceByLogicalID("datastoreBucket")
+	require.NotNil(t, data)
+	assert.Equal(t, "data-store-bucket", data.GetStringProperty("BucketName").Value())
+	tags := data.GetProperty("Tags").AsList()
+	require.Len(t, tags, 1)
+	assert.Equal(t, "data-store", tags[0].GetStringProperty("Value").Value())
+
+	for _, id := range []string{"devstandardQueue", "devpriorityQueue", "prodstandardQueue", "prodpriorityQueue"} {
+		require.NotNil(t, file.GetResourceByLogicalID(id), id)
+	}
+	assert.Equal(t, "prod-priority", file.GetResourceByLogicalID("prodpriorityQueue").GetStringProperty("QueueName").Value())
+
+	topic := file.GetResourceByLogicalID("Topic")
+	require.NotNil(t, topic)
+	assert.Equal(t, `{"Count":3,"Environments":["dev","prod"]}`, topic.GetStringProperty("DisplayName").Value())
+	assert.Equal(t, 2, topic.GetIntProperty("TopicName").Value())
+}
+
+func Test_ForEachTransformWithParameterOverride(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/template.json": `{
+  "Transform": "AWS::LanguageExtensions",
+  "Parameters": {
+    "Names": {
+      "Type": "CommaDelimitedList",
+      "Default": "a"
+    }
+  },
+  "Resources": {
+    "Fn::ForEach::Buckets": [
+      "Name",
+      {"Ref": "Names"},
+      {
+        "${Name}Bucket": {
+          "Type": "AWS::S3::Bucket",
+          "Properties": {
+            "BucketName": {"Fn::Sub": "${Name}-bucket"}
+          }
+        }
+      }
+    ]
+  }
+}
+`,
+	})
+
+	file, err := New(OptionWithParameters(map[string]string{"Names": "x,y"})).ParseFile(context.TODO(), fs, "code/template.json")
+	require.NoError(t, err)
+
+	assert.Nil(t, file.GetResourceByLogicalID("aBucket"))
+	require.NotNil(t, file.GetResourceByLogicalID("xBucket"))
+	y := file.GetResourceByLogicalID("yBucket")
+	require.NotNil(t, y)
+	assert.Equal(t, "y-bucket", y.GetStringProperty("BucketName").Value())
+	assert.Equal(t, JsonSourceFormat, file.SourceFormat)
+}
+
+func Test_ForEachWithoutLanguageExtensions(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/template.yaml": `---
+Resources:
+  Fn::ForEach::Buckets:
+    - Name
+    - [a, b]
+    - '${Name}Bucket':
+        Type: AWS::S3::Bucket
+`,
+	})
+
+	file, err := New().ParseFile(context.TODO(), fs, "code/template.yaml")
+	require.NoError(t, err)
+	assert.Nil(t, file.GetResourceByLogicalID("aBucket"))
+}
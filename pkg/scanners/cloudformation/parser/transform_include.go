@@ -0,0 +1,88 @@
+package parser
+
+import (
+	"io/fs"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const maxIncludeDepth = 10
+
+// inlineIncludes replaces AWS::Include transforms which refer to a snippet in the scanned filesystem with the content
+// of the snippet. Snippets which are mappings are merged into the mapping containing the transform.
+func (p *Parser) inlineIncludes(node *yaml.Node, target fs.FS, dir string, depth int) {
+	if node == nil || depth > maxIncludeDepth {
+		return
+	}
+
+	if node.Kind == yaml.MappingNode {
+		var content []*yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value == "Fn::Transform" {
+				if snippet := p.loadInclude(value, key.Line, target, dir, depth); snippet != nil {
+					if snippet.Kind == yaml.MappingNode {
+						content = append(content, snippet.Content...)
+						continue
+					}
+					if len(node.Content) == 2 {
+						*node = *snippet
+						return
+					}
+				}
+			}
+			content = append(content, key, value)
+		}
+		node.Content = content
+	}
+
+	for i, child := range node.Content {
+		if child.Tag == "!Transform" {
+			if snippet := p.loadInclude(child, child.Line, target, dir, depth); snippet != nil {
+				node.Content[i] = snippet
+				continue
+			}
+		}
+		p.inlineIncludes(child, target, dir, depth)
+	}
+}
+
+// loadInclude returns the snippet referred to by an AWS::Include transform, or nil if it can't be loaded locally. The
+// content of the snippet is given the line of the transform.
+func (p *Parser) loadInclude(transform *yaml.Node, line int, target fs.FS, dir string, depth int) *yaml.Node {
+	name := mappingValue(transform, "Name")
+	if name == nil || name.Value != includeTransform {
+		return nil
+	}
+	location := mappingValue(mappingValue(transform, "Parameters"), "Location")
+	if location == nil || location.Kind != yaml.ScalarNode || location.Value == "" || strings.Contains(location.Value, "://") {
+		return nil
+	}
+
+	snippetPath := path.Join(dir, location.Value)
+	if path.IsAbs(location.Value) {
+		snippetPath = strings.TrimPrefix(path.Clean(location.Value), "/")
+	}
+
+	content, err := fs.ReadFile(target, snippetPath)
+	if err != nil {
+		p.debug.Log("Snippet %s for AWS::Include could not be read: %s", snippetPath, err)
+		return nil
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		p.debug.Log("Snippet %s for AWS::Include could not be parsed: %s", snippetPath, err)
+		return nil
+	}
+	if len(root.Content) == 0 {
+		return nil
+	}
+
+	snippet := root.Content[0]
+	p.inlineIncludes(snippet, target, path.Dir(snippetPath), depth+1)
+	markSynthetic(snippet, line)
+	return snippet
+}
@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aquasecurity/defsec/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IncludeTransform(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/template.yaml": `---
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      Fn::Transform:
+        Name: AWS::Include
+        Parameters:
+          Location: snippets/bucket.yaml
+  'Fn::Transform':
+    Name: AWS::Include
+    Parameters:
+      Location: snippets/resources.yaml
+  Remote:
+    Type: AWS::S3::Bucket
+    Properties:
+      Fn::Transform:
+        Name: AWS::Include
+        Parameters:
+          Location: s3://bucket/snippet.yaml
+`,
+		"code/snippets/bucket.yaml": `
+BucketName: included-bucket
+VersioningConfiguration:
+  Status: Enabled
+`,
+		"code/snippets/resources.yaml": `
+Topic:
+  Type: AWS::SNS::Topic
+  Properties:
+    TopicName: !Sub ${AWS::Region}-topic
+Queue:
+  Type: AWS::SQS::Queue
+  Properties:
+    Fn::Transform:
+      Name: AWS::Include
+      Parameters:
+        Location: queue.yaml
+`,
+		"code/snippets/queue.yaml": `
+QueueName: nested-include
+`,
+	})
+
+	file, err := New().ParseFile(context.TODO(), fs, "code/template.yaml")
+	require.NoError(t, err)
+
+	bucket := file.GetResourceByLogicalID("Bucket")
+	require.NotNil(t, bucket)
+	assert.Equal(t, "included-bucket", bucket.GetStringProperty("BucketName").Value())
+	assert.Equal(t, "Enabled", bucket.GetStringProperty("VersioningConfiguration.Status").Value())
+	assert.Equal(t, 6, bucket.GetProperty("BucketName").Range().GetStartLine())
+
+	topic := file.GetResourceByLogicalID("Topic")
+	require.NotNil(t, topic)
+	assert.Equal(t, "eu-west-1-topic", topic.GetStringProperty("TopicName").Value())
+
+	queue := file.GetResourceByLogicalID("Queue")
+	require.NotNil(t, queue)
+	assert.Equal(t, "nested-include", queue.GetStringProperty("QueueName").Value())
+
+	remote := file.GetResourceByLogicalID("Remote")
+	require.NotNil(t, remote)
+	assert.NotNil(t, remote.GetProperty("Properties.Fn::Transform"))
+}
@@ -0,0 +1,342 @@
+package parser
+
+import (
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	lambdaBasicExecutionPolicy = "arn:aws:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole"
+	xrayWriteOnlyPolicy        = "arn:aws:iam::aws:policy/AWSXrayWriteOnlyAccess"
+	awsManagedPolicyPrefix     = "arn:aws:iam::aws:policy/"
+)
+
+// expandServerless adds the resources which the SAM transform implicitly creates for AWS::Serverless resources to the
+// template. The serverless resources themselves are kept, so the SAM rules still apply to them. Functions and state
+// machines without a role get an execution role, functions get the log group they write to unless the template
+// declares it, and each AWS::Serverless::Api gets a rest API with a deployment and a stage. Functions and state machines
+// with Api events which don't name a RestApiId share the implicit ServerlessRestApi, as they do in SAM. Globals are
+// not merged into the generated resources.
+func expandServerless(template *yaml.Node) {
+	resources := mappingValue(template, "Resources")
+	if resources == nil || resources.Kind != yaml.MappingNode {
+		return
+	}
+
+	existing := make(map[string]bool)
+	var logGroupNames []*yaml.Node
+	for i := 0; i+1 < len(resources.Content); i += 2 {
+		existing[resources.Content[i].Value] = true
+		if resourceType := mappingValue(resources.Content[i+1], "Type"); resourceType != nil && resourceType.Value == "AWS::Logs::LogGroup" {
+			logGroupNames = append(logGroupNames, mappingValue(mappingValue(resources.Content[i+1], "Properties"), "LogGroupName"))
+		}
+	}
+
+	var generated []*yaml.Node
+	add := func(id string, line int, resource *yaml.Node) {
+		if existing[id] {
+			return
+		}
+		markSynthetic(resource, line)
+		generated = append(generated, scalarNode(id, line), resource)
+		existing[id] = true
+	}
+
+	implicitApiLine := 0
+	for i := 0; i+1 < len(resources.Content); i += 2 {
+		key, resource := resources.Content[i], resources.Content[i+1]
+		resourceType := mappingValue(resource, "Type")
+		if resourceType == nil {
+			continue
+		}
+		properties := mappingValue(resource, "Properties")
+
+		var service string
+		switch resourceType.Value {
+		case "AWS::Serverless::Function":
+			service = "lambda.amazonaws.com"
+			if !declaresLogGroup(key.Value, properties, logGroupNames) {
+				add(key.Value+"LogGroup", resource.Line, functionLogGroup(key.Value, resource, properties))
+			}
+		case "AWS::Serverless::StateMachine":
+			service = "states.amazonaws.com"
+		case "AWS::Serverless::Api":
+			for _, apiResource := range restApi(key.Value, resource, properties) {
+				add(apiResource.id, resource.Line, apiResource.node)
+			}
+			continue
+		default:
+			continue
+		}
+
+		if implicitApiLine == 0 && usesImplicitApi(properties) {
+			implicitApiLine = resource.Line
+		}
+
+		if mappingValue(properties, "Role") == nil {
+			add(key.Value+"Role", resource.Line, executionRole(key.Value, resourceType.Value, resource, properties, service))
+		}
+	}
+
+	if implicitApiLine > 0 && !existing[implicitApiID] {
+		implicit := mappingNode(implicitApiLine,
+			scalarNode("StageName", implicitApiLine), scalarNode(implicitApiStage, implicitApiLine),
+		)
+		for _, apiResource := range restApi(implicitApiID, nil, implicit) {
+			add(apiResource.id, implicitApiLine, apiResource.node)
+		}
+	}
+
+	resources.Content = append(resources.Content, generated...)
+}
+
+const (
+	implicitApiID    = "ServerlessRestApi"
+	implicitApiStage = "Prod"
+)
+
+// stageProperties are the properties of an AWS::Serverless::Api which SAM passes on to the stage it creates
+var stageProperties = []string{
+	"StageName",
+	"TracingEnabled",
+	"AccessLogSetting",
+	"MethodSettings",
+	"CacheClusterEnabled",
+	"CacheClusterSize",
+	"Variables",
+	"Tags",
+}
+
+type generatedResource struct {
+	id   string
+	node *yaml.Node
+}
+
+// restApi builds the AWS::ApiGateway::RestApi, Deployment and Stage which SAM creates for an AWS::Serverless::Api. The
+// serverless API keeps its logical ID, so the rest API is named after it rather than replacing it. The resource is nil
+// for the implicit API, which has no serverless resource of its own.
+func restApi(id string, resource *yaml.Node, properties *yaml.Node) []generatedResource {
+	line := properties.Line
+	apiID, deploymentID := id, id+"Deployment"
+	if resource != nil {
+		line = resource.Line
+		apiID = id + "RestApi"
+	}
+
+	apiProperties := mappingNode(line)
+	if name := mappingValue(properties, "Name"); name != nil {
+		apiProperties.Content = append(apiProperties.Content, scalarNode("Name", line), copyNode(name))
+	}
+
+	stageName := mappingValue(properties, "StageName")
+	stageID := id + "Stage"
+	if stageName != nil && stageName.Kind == yaml.ScalarNode && !isShortFormFunction(stageName) {
+		stageID = id + alphanumeric(stageName.Value) + "Stage"
+	}
+
+	stage := mappingNode(line,
+		scalarNode("RestApiId", line), refNode(apiID, line),
+		scalarNode("DeploymentId", line), refNode(deploymentID, line),
+	)
+	for _, key := range stageProperties {
+		if value := mappingValue(properties, key); value != nil {
+			stage.Content = append(stage.Content, scalarNode(key, line), copyNode(value))
+		}
+	}
+
+	return []generatedResource{
+		{id: apiID, node: typedResource("AWS::ApiGateway::RestApi", resource, apiProperties)},
+		{id: deploymentID, node: typedResource("AWS::ApiGateway::Deployment", resource, mappingNode(line,
+			scalarNode("RestApiId", line), refNode(apiID, line),
+		))},
+		{id: stageID, node: typedResource("AWS::ApiGateway::Stage", resource, stage)},
+	}
+}
+
+// functionLogGroup builds the AWS::Logs::LogGroup a function writes to, which is created on its first invocation when
+// the template doesn't declare it
+func functionLogGroup(id string, resource *yaml.Node, properties *yaml.Node) *yaml.Node {
+	line := resource.Line
+	name := refNode(id, line)
+	if functionName := mappingValue(properties, "FunctionName"); functionName != nil {
+		name = copyNode(functionName)
+	}
+	logGroupName := mappingNode(line,
+		scalarNode("Fn::Join", line), sequenceNode(line,
+			scalarNode("", line),
+			sequenceNode(line, scalarNode("/aws/lambda/", line), name),
+		),
+	)
+	return typedResource("AWS::Logs::LogGroup", resource, mappingNode(line,
+		scalarNode("LogGroupName", line), logGroupName,
+	))
+}
+
+// declaresLogGroup reports whether a function sends its logs to a log group of its choice, or the template declares
+// the function's default log group itself, e.g. with a LogGroupName of !Sub /aws/lambda/${Function}
+func declaresLogGroup(id string, properties *yaml.Node, logGroupNames []*yaml.Node) bool {
+	if mappingValue(mappingValue(properties, "LoggingConfig"), "LogGroup") != nil {
+		return true
+	}
+	functionName := mappingValue(properties, "FunctionName")
+	for _, logGroupName := range logGroupNames {
+		declared := anyScalar(logGroupName, func(value string) bool {
+			if value == id || strings.Contains(value, "${"+id+"}") {
+				return true
+			}
+			return functionName != nil && functionName.Kind == yaml.ScalarNode && value == "/aws/lambda/"+functionName.Value
+		})
+		if declared {
+			return true
+		}
+	}
+	return false
+}
+
+// anyScalar reports whether the value of any scalar within the node matches
+func anyScalar(node *yaml.Node, match func(string) bool) bool {
+	if node == nil {
+		return false
+	}
+	if node.Kind == yaml.ScalarNode {
+		return match(node.Value)
+	}
+	for _, child := range node.Content {
+		if anyScalar(child, match) {
+			return true
+		}
+	}
+	return false
+}
+
+// usesImplicitApi reports whether a function or state machine has an Api event which doesn't name its rest API, so
+// SAM attaches it to the implicit ServerlessRestApi
+func usesImplicitApi(properties *yaml.Node) bool {
+	events := mappingValue(properties, "Events")
+	if events == nil || events.Kind != yaml.MappingNode {
+		return false
+	}
+	for i := 0; i+1 < len(events.Content); i += 2 {
+		event := events.Content[i+1]
+		if eventType := mappingValue(event, "Type"); eventType == nil || eventType.Value != "Api" {
+			continue
+		}
+		if mappingValue(mappingValue(event, "Properties"), "RestApiId") == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// typedResource builds a resource of the given type, which keeps the condition of the serverless resource it was
+// generated for, if any
+func typedResource(resourceType string, source *yaml.Node, properties *yaml.Node) *yaml.Node {
+	line := properties.Line
+	resource := mappingNode(line,
+		scalarNode("Type", line), scalarNode(resourceType, line),
+		scalarNode("Properties", line), properties,
+	)
+	if condition := mappingValue(source, "Condition"); condition != nil {
+		resource.Content = append(resource.Content, scalarNode("Condition", line), copyNode(condition))
+	}
+	return resource
+}
+
+func refNode(id string, line int) *yaml.Node {
+	return mappingNode(line, scalarNode("Ref", line), scalarNode(id, line))
+}
+
+// alphanumeric drops the characters which are not allowed in logical IDs
+func alphanumeric(value string) string {
+	var builder strings.Builder
+	for _, r := range value {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+// executionRole builds the AWS::IAM::Role which SAM creates for a function or state machine without a role
+func executionRole(id string, resourceType string, resource *yaml.Node, properties *yaml.Node, service string) *yaml.Node {
+	line := resource.Line
+	trustPolicy := mappingNode(line,
+		scalarNode("Version", line), scalarNode("2012-10-17", line),
+		scalarNode("Statement", line), sequenceNode(line,
+			mappingNode(line,
+				scalarNode("Effect", line), scalarNode("Allow", line),
+				scalarNode("Action", line), sequenceNode(line, scalarNode("sts:AssumeRole", line)),
+				scalarNode("Principal", line), mappingNode(line,
+					scalarNode("Service", line), sequenceNode(line, scalarNode(service, line)),
+				),
+			),
+		),
+	)
+
+	managed := sequenceNode(line)
+	if resourceType == "AWS::Serverless::Function" {
+		managed.Content = append(managed.Content, scalarNode(lambdaBasicExecutionPolicy, line))
+		if tracing := mappingValue(properties, "Tracing"); tracing != nil && tracing.Value == "Active" {
+			managed.Content = append(managed.Content, scalarNode(xrayWriteOnlyPolicy, line))
+		}
+	}
+
+	inline := sequenceNode(line)
+	for _, policy := range samPolicies(mappingValue(properties, "Policies")) {
+		switch {
+		case policy.Kind == yaml.ScalarNode && !isShortFormFunction(policy):
+			arn := policy.Value
+			if !strings.HasPrefix(arn, "arn:") {
+				arn = awsManagedPolicyPrefix + arn
+			}
+			managed.Content = append(managed.Content, scalarNode(arn, line))
+		case isShortFormFunction(policy) || isLongFormFunction(policy):
+			managed.Content = append(managed.Content, copyNode(policy))
+		case mappingValue(policy, "Statement") != nil:
+			name := id + "RolePolicy" + strconv.Itoa(len(inline.Content))
+			inline.Content = append(inline.Content, mappingNode(line,
+				scalarNode("PolicyName", line), scalarNode(name, line),
+				scalarNode("PolicyDocument", line), copyNode(policy),
+			))
+		}
+		// anything else is a SAM policy template, which would need the template definitions to expand
+	}
+
+	roleProperties := mappingNode(line,
+		scalarNode("AssumeRolePolicyDocument", line), trustPolicy,
+		scalarNode("ManagedPolicyArns", line), managed,
+	)
+	if len(inline.Content) > 0 {
+		roleProperties.Content = append(roleProperties.Content, scalarNode("Policies", line), inline)
+	}
+	if boundary := mappingValue(properties, "PermissionsBoundary"); boundary != nil {
+		roleProperties.Content = append(roleProperties.Content, scalarNode("PermissionsBoundary", line), copyNode(boundary))
+	}
+
+	return typedResource("AWS::IAM::Role", resource, roleProperties)
+}
+
+// samPolicies returns the entries of the Policies property of a serverless resource, which may be a single entry
+func samPolicies(policies *yaml.Node) []*yaml.Node {
+	if policies == nil {
+		return nil
+	}
+	if policies.Kind == yaml.SequenceNode && !isShortFormFunction(policies) {
+		return policies.Content
+	}
+	return []*yaml.Node{policies}
+}
+
+func isShortFormFunction(node *yaml.Node) bool {
+	return strings.HasPrefix(node.Tag, "!") && !strings.HasPrefix(node.Tag, "!!")
+}
+
+func isLongFormFunction(node *yaml.Node) bool {
+	if node.Kind != yaml.MappingNode || len(node.Content) != 2 {
+		return false
+	}
+	key := node.Content[0].Value
+	return key == "Ref" || strings.HasPrefix(key, "Fn::")
+}
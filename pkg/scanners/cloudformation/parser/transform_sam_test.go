@@ -0,0 +1,185 @@
+package parser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aquasecurity/defsec/test/testutil"
+	"github.com/liamg/iamgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const samTemplate = `---
+Transform: AWS::Serverless-2016-10-31
+Resources:
+  Function:
+    Type: AWS::Serverless::Function
+    Properties:
+      Runtime: python3.9
+      Tracing: Active
+      Policies:
+        - AmazonS3ReadOnlyAccess
+        - arn:aws:iam::123456789012:policy/custom
+        - !Ref ManagedPolicy
+        - S3ReadPolicy:
+            BucketName: bucket
+        - Version: 2012-10-17
+          Statement:
+            - Effect: Allow
+              Action: s3:*
+              Resource: !Sub arn:aws:s3:::${AWS::Region}-bucket/*
+  WithRole:
+    Type: AWS::Serverless::Function
+    Properties:
+      Role: arn:aws:iam::123456789012:role/existing
+  StateMachine:
+    Type: AWS::Serverless::StateMachine
+    Properties:
+      Policies:
+        Statement:
+          - Effect: Allow
+            Action: lambda:InvokeFunction
+            Resource: '*'
+  ManagedPolicy:
+    Type: AWS::IAM::ManagedPolicy
+`
+
+func Test_SAMExpansion(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/template.yaml": samTemplate,
+	})
+
+	file, err := New(OptionWithSAMExpansion(true)).ParseFile(context.TODO(), fs, "code/template.yaml")
+	require.NoError(t, err)
+
+	require.NotNil(t, file.GetResourceByLogicalID("Function"), "serverless resources should be kept")
+	assert.Nil(t, file.GetResourceByLogicalID("WithRoleRole"))
+
+	role := file.GetResourceByLogicalID("FunctionRole")
+	require.NotNil(t, role)
+	assert.Equal(t, "AWS::IAM::Role", role.Type())
+	assert.Equal(t, 4, role.Metadata().Range().GetStartLine())
+
+	var managed []string
+	for _, arn := range role.GetProperty("ManagedPolicyArns").AsList() {
+		managed = append(managed, arn.AsString())
+	}
+	assert.Equal(t, []string{
+		"arn:aws:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole",
+		"arn:aws:iam::aws:policy/AWSXrayWriteOnlyAccess",
+		"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess",
+		"arn:aws:iam::123456789012:policy/custom",
+		"ManagedPolicy",
+	}, managed)
+
+	policies := role.GetProperty("Policies").AsList()
+	require.Len(t, policies, 1)
+	assert.Equal(t, "FunctionRolePolicy0", policies[0].GetStringProperty("PolicyName").Value())
+
+	document, err := iamgo.Parse(policies[0].GetProperty("PolicyDocument").GetJsonBytes())
+	require.NoError(t, err)
+	statements, _ := document.Statements()
+	require.Len(t, statements, 1)
+	resources, _ := statements[0].Resources()
+	assert.Equal(t, []string{"arn:aws:s3:::eu-west-1-bucket/*"}, resources)
+
+	trust, err := iamgo.Parse(role.GetProperty("AssumeRolePolicyDocument").GetJsonBytes())
+	require.NoError(t, err)
+	statements, _ = trust.Statements()
+	require.Len(t, statements, 1)
+	principals, _ := statements[0].Principals()
+	services, _ := principals.Service()
+	assert.Equal(t, []string{"lambda.amazonaws.com"}, services)
+
+	stateMachineRole := file.GetResourceByLogicalID("StateMachineRole")
+	require.NotNil(t, stateMachineRole)
+	assert.Len(t, stateMachineRole.GetProperty("ManagedPolicyArns").AsList(), 0)
+	assert.Len(t, stateMachineRole.GetProperty("Policies").AsList(), 1)
+}
+
+func Test_SAMExpansionImplicitResources(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/template.yaml": `---
+Transform: AWS::Serverless-2016-10-31
+Resources:
+  Api:
+    Type: AWS::Serverless::Api
+    Properties:
+      Name: api
+      StageName: v1
+      TracingEnabled: true
+  Handler:
+    Type: AWS::Serverless::Function
+    Properties:
+      FunctionName: handler
+      Events:
+        Get:
+          Type: Api
+          Properties:
+            Path: /
+            Method: get
+        Post:
+          Type: Api
+          Properties:
+            RestApiId: !Ref Api
+            Path: /
+            Method: post
+  Worker:
+    Type: AWS::Serverless::Function
+  WorkerLogs:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      LogGroupName: !Sub /aws/lambda/${Worker}
+`,
+	})
+
+	file, err := New(OptionWithSAMExpansion(true)).ParseFile(context.TODO(), fs, "code/template.yaml")
+	require.NoError(t, err)
+
+	restApi := file.GetResourceByLogicalID("ApiRestApi")
+	require.NotNil(t, restApi)
+	assert.Equal(t, "AWS::ApiGateway::RestApi", restApi.Type())
+	assert.Equal(t, "api", restApi.GetStringProperty("Name").Value())
+
+	deployment := file.GetResourceByLogicalID("ApiDeployment")
+	require.NotNil(t, deployment)
+	assert.Equal(t, "AWS::ApiGateway::Deployment", deployment.Type())
+	assert.Equal(t, "ApiRestApi", deployment.GetStringProperty("RestApiId").Value())
+
+	stage := file.GetResourceByLogicalID("Apiv1Stage")
+	require.NotNil(t, stage)
+	assert.Equal(t, "AWS::ApiGateway::Stage", stage.Type())
+	assert.Equal(t, 4, stage.Metadata().Range().GetStartLine())
+	assert.Equal(t, "ApiRestApi", stage.GetStringProperty("RestApiId").Value())
+	assert.Equal(t, "ApiDeployment", stage.GetStringProperty("DeploymentId").Value())
+	assert.Equal(t, "v1", stage.GetStringProperty("StageName").Value())
+	assert.True(t, stage.GetBoolProperty("TracingEnabled").IsTrue())
+
+	implicit := file.GetResourceByLogicalID("ServerlessRestApi")
+	require.NotNil(t, implicit)
+	assert.Equal(t, "AWS::ApiGateway::RestApi", implicit.Type())
+	require.NotNil(t, file.GetResourceByLogicalID("ServerlessRestApiDeployment"))
+	implicitStage := file.GetResourceByLogicalID("ServerlessRestApiProdStage")
+	require.NotNil(t, implicitStage)
+	assert.Equal(t, "ServerlessRestApi", implicitStage.GetStringProperty("RestApiId").Value())
+	assert.Equal(t, "Prod", implicitStage.GetStringProperty("StageName").Value())
+	assert.Equal(t, 10, implicitStage.Metadata().Range().GetStartLine())
+
+	logGroup := file.GetResourceByLogicalID("HandlerLogGroup")
+	require.NotNil(t, logGroup)
+	assert.Equal(t, "AWS::Logs::LogGroup", logGroup.Type())
+	assert.Equal(t, "/aws/lambda/handler", logGroup.GetStringProperty("LogGroupName").Value())
+	assert.Nil(t, file.GetResourceByLogicalID("WorkerLogGroup"), "the template declares the worker's log group")
+}
+
+func Test_SAMExpansionDisabled(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/template.yaml": samTemplate,
+	})
+
+	file, err := New().ParseFile(context.TODO(), fs, "code/template.yaml")
+	require.NoError(t, err)
+	assert.Nil(t, file.GetResourceByLogicalID("FunctionRole"))
+	assert.Nil(t, file.GetResourceByLogicalID("FunctionLogGroup"))
+}
@@ -0,0 +1,144 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/fs"
+	"path"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	includeTransform            = "AWS::Include"
+	languageExtensionsTransform = "AWS::LanguageExtensions"
+	serverlessTransform         = "AWS::Serverless-2016-10-31"
+)
+
+// mayNeedTransforming reports whether a JSON template uses a transform, in which case it is decoded through yaml so the
+// transforms can be applied before the template is read. Fn::ForEach is only valid when a Transform is declared, but
+// AWS::Include can be used through Fn::Transform anywhere in the template.
+func mayNeedTransforming(content []byte) bool {
+	var template map[string]json.RawMessage
+	if err := json.Unmarshal(content, &template); err == nil {
+		if _, ok := template["Transform"]; ok {
+			return true
+		}
+	}
+	return bytes.Contains(content, []byte(`"Fn::Transform"`))
+}
+
+// applyTransforms processes the transforms used by the template which can be applied locally: AWS::Include snippets
+// in the scanned filesystem are inlined, Fn::ForEach loops are expanded when AWS::LanguageExtensions is declared, and
+// the implicit resources of AWS::Serverless resources are added when SAM expansion is enabled.
+func (p *Parser) applyTransforms(root *yaml.Node, target fs.FS, filepath string, values map[string]interface{}) error {
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+	template := root.Content[0]
+
+	p.inlineIncludes(template, target, path.Dir(filepath), 0)
+
+	declared := declaredTransforms(template)
+	expandForEach(template, &loopContext{
+		enabled:  declared[languageExtensionsTransform],
+		template: template,
+		values:   values,
+	})
+	if declared[serverlessTransform] && p.expandSAM {
+		expandServerless(template)
+	}
+	return nil
+}
+
+func declaredTransforms(template *yaml.Node) map[string]bool {
+	declared := make(map[string]bool)
+	transform := mappingValue(template, "Transform")
+	if transform == nil {
+		return declared
+	}
+	switch transform.Kind {
+	case yaml.ScalarNode:
+		declared[transform.Value] = true
+	case yaml.SequenceNode:
+		for _, item := range transform.Content {
+			if item.Kind == yaml.ScalarNode {
+				declared[item.Value] = true
+			}
+		}
+	}
+	return declared
+}
+
+// mappingValue returns the value of the given key in a mapping node
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// markSynthetic records that a node was created by a transform, so doesn't correspond to the text of the template.
+// The node is given the line of the template which caused it to be created, or keeps its own line if that is zero.
+func markSynthetic(node *yaml.Node, line int) {
+	if node == nil {
+		return
+	}
+	if line > 0 {
+		node.Line = line
+	}
+	node.Column = 0
+	for _, child := range node.Content {
+		markSynthetic(child, line)
+	}
+}
+
+func isSynthetic(node *yaml.Node) bool {
+	return node.Column == 0
+}
+
+func copyNode(node *yaml.Node) *yaml.Node {
+	if node == nil {
+		return nil
+	}
+	copied := *node
+	if node.Content != nil {
+		copied.Content = make([]*yaml.Node, len(node.Content))
+		for i, child := range node.Content {
+			copied.Content[i] = copyNode(child)
+		}
+	}
+	return &copied
+}
+
+func scalarNode(value string, line int) *yaml.Node {
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Tag:   "!!str",
+		Value: value,
+		Line:  line,
+	}
+}
+
+func mappingNode(line int, pairs ...*yaml.Node) *yaml.Node {
+	return &yaml.Node{
+		Kind:    yaml.MappingNode,
+		Tag:     "!!map",
+		Content: pairs,
+		Line:    line,
+	}
+}
+
+func sequenceNode(line int, items ...*yaml.Node) *yaml.Node {
+	return &yaml.Node{
+		Kind:    yaml.SequenceNode,
+		Tag:     "!!seq",
+		Content: items,
+		Line:    line,
+	}
+}
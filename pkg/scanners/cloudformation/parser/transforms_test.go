@@ -0,0 +1,41 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_mayNeedTransforming(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected bool
+	}{
+		{
+			name:     "declared transform",
+			content:  `{"Transform": "AWS::LanguageExtensions", "Resources": {}}`,
+			expected: true,
+		},
+		{
+			name:     "include",
+			content:  `{"Resources": {"Fn::Transform": {"Name": "AWS::Include", "Parameters": {"Location": "a.json"}}}}`,
+			expected: true,
+		},
+		{
+			name:     "mentions in values",
+			content:  `{"Description": "Transform with Fn::ForEach", "Resources": {"Transform": {"Type": "AWS::S3::Bucket"}}}`,
+			expected: false,
+		},
+		{
+			name:     "different case",
+			content:  `{"transform": "AWS::Serverless-2016-10-31", "Resources": {}}`,
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, mayNeedTransforming([]byte(test.content)))
+		})
+	}
+}
@@ -50,9 +50,11 @@ func setPropertyValueFromYaml(node *yaml.Node, propertyData *PropertyInner) erro
 		var newContent []*yaml.Node
 
 		newContent = append(newContent, &yaml.Node{
-			Tag:   "!!str",
-			Value: getIntrinsicTag(node.Tag),
-			Kind:  yaml.ScalarNode,
+			Tag:    "!!str",
+			Value:  getIntrinsicTag(node.Tag),
+			Kind:   yaml.ScalarNode,
+			Line:   node.Line,
+			Column: node.Column,
 		})
 
 		newContent = createNode(node, newContent)
@@ -72,7 +74,10 @@ func setPropertyValueFromYaml(node *yaml.Node, propertyData *PropertyInner) erro
 		case "!!bool":
 			propertyData.Type = cftypes.Bool
 			propertyData.Value, _ = strconv.ParseBool(node.Value)
-		case "!!str", "!!string":
+		case "!!float":
+			propertyData.Type = cftypes.Float64
+			propertyData.Value, _ = strconv.ParseFloat(node.Value, 64)
+		case "!!str", "!!string", "!!timestamp":
 			propertyData.Type = cftypes.String
 			propertyData.Value = node.Value
 		}
@@ -104,15 +109,19 @@ func setPropertyValueFromYaml(node *yaml.Node, propertyData *PropertyInner) erro
 func createNode(node *yaml.Node, newContent []*yaml.Node) []*yaml.Node {
 	if node.Content == nil {
 		newContent = append(newContent, &yaml.Node{
-			Tag:   "!!str",
-			Value: node.Value,
-			Kind:  yaml.ScalarNode,
+			Tag:    "!!str",
+			Value:  node.Value,
+			Kind:   yaml.ScalarNode,
+			Line:   node.Line,
+			Column: node.Column,
 		})
 	} else {
 
 		newNode := &yaml.Node{
 			Content: node.Content,
 			Kind:    node.Kind,
+			Line:    node.Line,
+			Column:  node.Column,
 		}
 
 		switch node.Kind {
@@ -29,15 +29,18 @@ var _ scanners.Scanner = (*Scanner)(nil)
 var _ ConfigurableCloudFormationScanner = (*Scanner)(nil)
 
 type Scanner struct {
-	debug         debug.Logger
-	policyDirs    []string
-	policyReaders []io.Reader
-	parser        *parser.Parser
-	regoScanner   *rego.Scanner
-	skipRequired  bool
-	regoOnly      bool
-	loadEmbedded  bool
-	options       []options.ScannerOption
+	debug           debug.Logger
+	policyDirs      []string
+	policyReaders   []io.Reader
+	parser          *parser.Parser
+	regoScanner     *rego.Scanner
+	skipRequired    bool
+	regoOnly        bool
+	loadEmbedded    bool
+	trackUnresolved bool
+	allBranches     bool
+	parserOptions   []options.ParserOption
+	options         []options.ScannerOption
 	sync.Mutex
 }
 
@@ -49,6 +52,18 @@ func (s *Scanner) SetRegoOnly(regoOnly bool) {
 	s.regoOnly = regoOnly
 }
 
+func (s *Scanner) SetUnresolvedReporting(enabled bool) {
+	s.trackUnresolved = enabled
+}
+
+func (s *Scanner) SetAllConditionBranches(enabled bool) {
+	s.allBranches = enabled
+}
+
+func (s *Scanner) AddParserOptions(options ...options.ParserOption) {
+	s.parserOptions = append(s.parserOptions, options...)
+}
+
 func (s *Scanner) Name() string {
 	return "CloudFormation"
 }
@@ -86,7 +101,7 @@ func New(opts ...options.ScannerOption) *Scanner {
 	for _, opt := range opts {
 		opt(s)
 	}
-	s.parser = parser.New(options.ParserWithSkipRequiredCheck(s.skipRequired))
+	s.parser = parser.New(append(s.parserOptions, options.ParserWithSkipRequiredCheck(s.skipRequired))...)
 	return s
 }
 
@@ -161,6 +176,41 @@ func (s *Scanner) ScanFile(ctx context.Context, fs fs.FS, path string) (scan.Res
 }
 
 func (s *Scanner) scanFileContext(ctx context.Context, regoScanner *rego.Scanner, cfCtx *parser.FileContext, fs fs.FS) (results scan.Results, err error) {
+	if !s.allBranches || len(cfCtx.Conditions) == 0 {
+		return s.scanState(ctx, regoScanner, cfCtx, fs)
+	}
+
+	var names []string
+	for name := range cfCtx.Conditions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	defer cfCtx.ResetConditions()
+	seen := make(map[string]bool)
+	for _, name := range names {
+		for _, value := range []bool{true, false} {
+			s.debug.Log("Scanning %s with condition %s evaluating to %t", cfCtx.Metadata().Range().GetFilename(), name, value)
+			cfCtx.ForceCondition(name, value)
+			branchResults, err := s.scanState(ctx, regoScanner, cfCtx, fs)
+			if err != nil {
+				return nil, err
+			}
+			// resources which don't depend on the condition are the same in both branches, so are only reported once
+			for _, result := range branchResults {
+				key := fmt.Sprintf("%s:%s:%d:%s", result.Rule().AVDID, result.Range(), result.Status(), result.Description())
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				results = append(results, result)
+			}
+		}
+	}
+	return results, nil
+}
+
+func (s *Scanner) scanState(ctx context.Context, regoScanner *rego.Scanner, cfCtx *parser.FileContext, fs fs.FS) (results scan.Results, err error) {
 	state := adapter.Adapt(*cfCtx)
 	if state == nil {
 		return nil, nil
@@ -179,7 +229,8 @@ func (s *Scanner) scanFileContext(ctx context.Context, regoScanner *rego.Scanner
 			if len(evalResult) > 0 {
 				s.debug.Log("Found %d results for %s", len(evalResult), rule.Rule().AVDID)
 				for _, scanResult := range evalResult {
-					if isIgnored(scanResult) {
+					ignore := findIgnore(scanResult, cfCtx, s.debug)
+					if ignore != nil {
 						scanResult.OverrideStatus(scan.StatusIgnored)
 					}
 
@@ -190,7 +241,7 @@ func (s *Scanner) scanFileContext(ctx context.Context, regoScanner *rego.Scanner
 					}
 
 					reference := ref.(*parser.CFReference)
-					description := getDescription(scanResult, reference)
+					description := getDescription(scanResult, reference, ignore)
 					scanResult.OverrideDescription(description)
 					results = append(results, scanResult)
 				}
@@ -206,14 +257,26 @@ func (s *Scanner) scanFileContext(ctx context.Context, regoScanner *rego.Scanner
 	if err != nil {
 		return nil, fmt.Errorf("rego scan error: %w", err)
 	}
-	return append(results, regoResults...), nil
+	for i, regoResult := range regoResults {
+		if ignore := findIgnore(regoResult, cfCtx, s.debug); ignore != nil {
+			regoResults[i].OverrideStatus(scan.StatusIgnored)
+		}
+	}
+	results = append(results, regoResults...)
+	if s.trackUnresolved {
+		results.MarkUnresolved()
+	}
+	return results, nil
 }
 
-func getDescription(scanResult scan.Result, location *parser.CFReference) string {
+func getDescription(scanResult scan.Result, location *parser.CFReference, ignore *Ignore) string {
 	switch scanResult.Status() {
 	case scan.StatusPassed:
 		return fmt.Sprintf("Resource '%s' passed check: %s", location.LogicalID(), scanResult.Rule().Summary)
 	case scan.StatusIgnored:
+		if ignore != nil && ignore.Reason != "" {
+			return fmt.Sprintf("Resource '%s' had check ignored: %s (%s)", location.LogicalID(), scanResult.Rule().Summary, ignore.Reason)
+		}
 		return fmt.Sprintf("Resource '%s' had check ignored: %s", location.LogicalID(), scanResult.Rule().Summary)
 	default:
 		return scanResult.Description()
@@ -1,7 +1,9 @@
 package cloudformation
 
 import (
+	"bytes"
 	"context"
+	"sort"
 	"testing"
 
 	"github.com/aquasecurity/defsec/pkg/scanners/options"
@@ -96,3 +98,395 @@ deny[res] {
 		},
 	}, actualCode.Lines)
 }
+
+func Test_ScanAllConditionBranches(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"/code/main.yaml": `---
+Parameters:
+  Environment:
+    Type: String
+    Default: dev
+Conditions:
+  IsProduction: !Equals [!Ref Environment, prod]
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      VersioningConfiguration:
+        Status: !If [IsProduction, Enabled, Suspended]
+`,
+	})
+
+	versioningResults := func(results scan.Results) (passed int, failed int) {
+		for _, result := range results {
+			if result.Rule().AVDID != "AVD-AWS-0090" {
+				continue
+			}
+			switch result.Status() {
+			case scan.StatusPassed:
+				passed++
+			case scan.StatusFailed:
+				failed++
+			}
+		}
+		return passed, failed
+	}
+
+	results, err := New(options.ScannerWithEmbeddedPolicies(true)).ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+	passed, failed := versioningResults(results)
+	assert.Equal(t, 0, passed)
+	assert.Equal(t, 1, failed)
+
+	results, err = New(options.ScannerWithEmbeddedPolicies(true), ScannerWithAllConditionBranches(true)).ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+	passed, failed = versioningResults(results)
+	assert.Equal(t, 1, passed)
+	assert.Equal(t, 1, failed)
+
+	var buckets int
+	for _, result := range results {
+		if result.Rule().AVDID == "AVD-AWS-0088" {
+			buckets++
+		}
+	}
+	assert.Equal(t, 1, buckets, "results which are the same in every branch should only be reported once")
+}
+
+func Test_ScanWithParameterFile(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"/code/main.yaml": `---
+Parameters:
+  VersioningStatus:
+    Type: String
+    Default: Suspended
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      VersioningConfiguration:
+        Status: !Ref VersioningStatus
+`,
+		"/params/prod.json": `[{"ParameterKey": "VersioningStatus", "ParameterValue": "Enabled"}]`,
+	})
+
+	versioningStatus := func(results scan.Results) []scan.Status {
+		var statuses []scan.Status
+		for _, result := range results {
+			if result.Rule().AVDID == "AVD-AWS-0090" {
+				statuses = append(statuses, result.Status())
+			}
+		}
+		return statuses
+	}
+
+	results, err := New(options.ScannerWithEmbeddedPolicies(true)).ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+	assert.Equal(t, []scan.Status{scan.StatusFailed}, versioningStatus(results))
+
+	results, err = New(options.ScannerWithEmbeddedPolicies(true), ScannerWithParameterFiles("params/prod.json")).ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+	assert.Equal(t, []scan.Status{scan.StatusPassed}, versioningStatus(results))
+
+	results, err = New(
+		options.ScannerWithEmbeddedPolicies(true),
+		ScannerWithParameterFiles("params/prod.json"),
+		ScannerWithParameters(map[string]string{"VersioningStatus": "Suspended"}),
+	).ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+	assert.Equal(t, []scan.Status{scan.StatusFailed}, versioningStatus(results))
+}
+
+func Test_ScanNestedStacksAndImports(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"/code/shared.yaml": `---
+Resources:
+  Topic:
+    Type: AWS::SNS::Topic
+Outputs:
+  Versioning:
+    Value: Enabled
+    Export:
+      Name: shared-versioning
+`,
+		"/code/parent.yaml": `---
+Resources:
+  Storage:
+    Type: AWS::CloudFormation::Stack
+    Properties:
+      TemplateURL: child.yaml
+      Parameters:
+        Versioning: !ImportValue shared-versioning
+`,
+		"/code/child.yaml": `---
+Parameters:
+  Versioning:
+    Type: String
+    Default: Suspended
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      VersioningConfiguration:
+        Status: !Ref Versioning
+`,
+	})
+
+	results, err := New(options.ScannerWithEmbeddedPolicies(true)).ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+
+	var statuses []scan.Status
+	for _, result := range results {
+		if result.Rule().AVDID == "AVD-AWS-0090" {
+			assert.Equal(t, "code/child.yaml", result.Range().GetFilename())
+			statuses = append(statuses, result.Status())
+		}
+	}
+	assert.Equal(t, []scan.Status{scan.StatusPassed}, statuses)
+}
+
+func Test_ScanWithSAMExpansion(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"/code/template.yaml": `---
+Transform: AWS::Serverless-2016-10-31
+Resources:
+  Function:
+    Type: AWS::Serverless::Function
+    Properties:
+      Runtime: python3.9
+      Policies:
+        - Statement:
+            - Effect: Allow
+              Action: s3:*
+              Resource: '*'
+`,
+	})
+
+	countFailures := func(opts ...options.ScannerOption) int {
+		results, err := New(append(opts, options.ScannerWithEmbeddedPolicies(true))...).ScanFS(context.TODO(), fs, "code")
+		require.NoError(t, err)
+		var count int
+		for _, result := range results.GetFailed() {
+			if result.Rule().AVDID == "AVD-AWS-0057" {
+				assert.Equal(t, "code/template.yaml", result.Range().GetFilename())
+				count++
+			}
+		}
+		return count
+	}
+
+	assert.Equal(t, 0, countFailures())
+	assert.Greater(t, countFailures(ScannerWithSAMExpansion(true)), 0)
+}
+
+func Test_ScanWithSAMExpansionImplicitResources(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"/code/template.yaml": `---
+Transform: AWS::Serverless-2016-10-31
+Resources:
+  Api:
+    Type: AWS::Serverless::Api
+    Properties:
+      StageName: v1
+      TracingEnabled: true
+  Function:
+    Type: AWS::Serverless::Function
+    Properties:
+      Runtime: python3.9
+      Events:
+        Get:
+          Type: Api
+          Properties:
+            Path: /
+            Method: get
+`,
+	})
+
+	failedRules := func(opts ...options.ScannerOption) map[string][]int {
+		results, err := New(append(opts, options.ScannerWithEmbeddedPolicies(true))...).ScanFS(context.TODO(), fs, "code")
+		require.NoError(t, err)
+		failed := make(map[string][]int)
+		for _, result := range results.GetFailed() {
+			switch result.Rule().AVDID {
+			case "AVD-AWS-0001", "AVD-AWS-0003", "AVD-AWS-0017":
+				failed[result.Rule().AVDID] = append(failed[result.Rule().AVDID], result.Range().GetStartLine())
+			}
+		}
+		for _, lines := range failed {
+			sort.Ints(lines)
+		}
+		return failed
+	}
+
+	assert.Empty(t, failedRules())
+	assert.Equal(t, map[string][]int{
+		// access logging is enabled on neither stage, but tracing is only enabled on the explicit API's stage
+		"AVD-AWS-0001": {4, 9},
+		"AVD-AWS-0003": {9},
+		"AVD-AWS-0017": {9},
+	}, failedRules(ScannerWithSAMExpansion(true)))
+}
+
+func Test_ScanWithMetadataIgnores(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"/code/template.yaml": `---
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Metadata:
+      defsec:
+        ignore:
+          - id: AVD-AWS-0088
+            reason: encrypted by the replication target
+          - id: aws-s3-enable-bucket-logging
+            expires: 2099-01-01
+          - id: AVD-AWS-0090
+            expires: 2000-01-01
+    Properties:
+      BucketName: test
+`,
+		"/code/template.json": `{
+  "Resources": {
+    "Bucket": {
+      "Type": "AWS::S3::Bucket",
+      "Metadata": {
+        "defsec": {
+          "ignore": ["AVD-AWS-0088", {"id": "AVD-AWS-0089", "reason": "logs are not needed"}]
+        }
+      },
+      "Properties": {
+        "BucketName": "test"
+      }
+    }
+  }
+}`,
+	})
+
+	results, err := New(options.ScannerWithEmbeddedPolicies(true)).ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+
+	statuses := make(map[string]scan.Status)
+	descriptions := make(map[string]string)
+	for _, result := range results {
+		key := result.Range().GetFilename() + ":" + result.Rule().AVDID
+		statuses[key] = result.Status()
+		descriptions[key] = result.Description()
+	}
+
+	assert.Equal(t, scan.StatusIgnored, statuses["code/template.yaml:AVD-AWS-0088"])
+	assert.Equal(t, "Resource 'Bucket' had check ignored: Unencrypted S3 bucket. (encrypted by the replication target)", descriptions["code/template.yaml:AVD-AWS-0088"])
+	assert.Equal(t, scan.StatusIgnored, statuses["code/template.yaml:AVD-AWS-0089"])
+	assert.Equal(t, scan.StatusFailed, statuses["code/template.yaml:AVD-AWS-0090"])
+
+	assert.Equal(t, scan.StatusIgnored, statuses["code/template.json:AVD-AWS-0088"])
+	assert.Equal(t, scan.StatusIgnored, statuses["code/template.json:AVD-AWS-0089"])
+	assert.Equal(t, scan.StatusFailed, statuses["code/template.json:AVD-AWS-0090"])
+}
+
+func Test_ScanStateMachineDefinitionFile(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"/code/template.yaml": `---
+Transform: AWS::Serverless-2016-10-31
+Resources:
+  StateMachine:
+    Type: AWS::Serverless::StateMachine
+    Properties:
+      DefinitionUri: statemachine/process.asl.json
+      DefinitionSubstitutions:
+        FunctionArn: arn:aws:lambda:us-east-1:123456789012:function:*
+`,
+		"/code/statemachine/process.asl.json": `{
+  "StartAt": "Process",
+  "States": {
+    "Process": {
+      "Type": "Task",
+      "Resource": "${FunctionArn}",
+      "End": true
+    }
+  }
+}`,
+	})
+
+	results, err := New(options.ScannerWithEmbeddedPolicies(true)).ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+
+	var found bool
+	for _, result := range results.GetFailed() {
+		if result.Rule().AVDID == "AVD-AWS-0142" {
+			assert.Equal(t, "code/statemachine/process.asl.json", result.Range().GetFilename())
+			found = true
+		}
+	}
+	assert.True(t, found)
+}
+
+func Test_ScanWithMetadataIgnores_Rego(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"/code/main.yaml": `---
+Resources:
+  Ignored:
+    Type: AWS::S3::Bucket
+    Metadata:
+      defsec:
+        ignore:
+          - id: AVD-TEST-0123
+    Properties:
+      BucketName: evil
+  InvalidExpiry:
+    Type: AWS::S3::Bucket
+    Metadata:
+      defsec:
+        ignore:
+          - id: AVD-TEST-0123
+            expires: next-year
+    Properties:
+      BucketName: evil
+`,
+		"/rules/rule.rego": `package defsec.abcdefg
+
+__rego_metadata__ := {
+	"id": "TEST123",
+	"avd_id": "AVD-TEST-0123",
+	"title": "Buckets should not be evil",
+	"short_code": "no-evil-buckets",
+	"severity": "CRITICAL",
+	"type": "DefSec Security Check",
+}
+
+__rego_input__ := {
+	"combine": false,
+	"selector": [{"type": "defsec"}],
+}
+
+deny[cause] {
+	bucket := input.aws.s3.buckets[_]
+	bucket.name.value == "evil"
+	cause := bucket.name
+}
+`,
+	})
+
+	debugLog := bytes.NewBuffer([]byte{})
+	scanner := New(
+		options.ScannerWithDebug(debugLog),
+		options.ScannerWithPolicyDirs("rules"),
+		ScannerWithRegoOnly(true),
+	)
+
+	results, err := scanner.ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+
+	require.Len(t, results.GetIgnored(), 1)
+	assert.Equal(t, 10, results.GetIgnored()[0].Range().GetStartLine())
+	require.Len(t, results.GetFailed(), 1)
+	assert.Equal(t, 19, results.GetFailed()[0].Range().GetStartLine())
+	assert.Contains(t, debugLog.String(), "invalid expiry date 'next-year'")
+}
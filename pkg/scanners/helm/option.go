@@ -0,0 +1,113 @@
+package helm
+
+import (
+	"github.com/aquasecurity/defsec/pkg/scanners/helm/parser"
+	"github.com/aquasecurity/defsec/pkg/scanners/options"
+)
+
+type ConfigurableHelmScanner interface {
+	options.ConfigurableScanner
+	AddParserOptions(options ...options.ParserOption)
+	AddValueProfile(name string, options ...options.ParserOption)
+	SetUseSingleThread(bool)
+}
+
+// ScannerWithValuesFile loads values from the given files, which are read from the scanned filesystem, for every chart
+// found. See parser.OptionWithValuesFile.
+func ScannerWithValuesFile(paths ...string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if helmScanner, ok := s.(ConfigurableHelmScanner); ok {
+			helmScanner.AddParserOptions(parser.OptionWithValuesFile(paths...))
+		}
+	}
+}
+
+// ScannerWithChartValuesFile loads values from the given files, which are read from the scanned filesystem, for the
+// chart at chartPath only. See parser.OptionWithChartValuesFile.
+func ScannerWithChartValuesFile(chartPath string, paths ...string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if helmScanner, ok := s.(ConfigurableHelmScanner); ok {
+			helmScanner.AddParserOptions(parser.OptionWithChartValuesFile(chartPath, paths...))
+		}
+	}
+}
+
+// ScannerWithValues sets values in the same format as 'helm install --set'
+func ScannerWithValues(values ...string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if helmScanner, ok := s.(ConfigurableHelmScanner); ok {
+			helmScanner.AddParserOptions(parser.OptionWithValues(values...))
+		}
+	}
+}
+
+// ScannerWithStringValues sets values in the same format as 'helm install --set-string'
+func ScannerWithStringValues(values ...string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if helmScanner, ok := s.(ConfigurableHelmScanner); ok {
+			helmScanner.AddParserOptions(parser.OptionWithStringValues(values...))
+		}
+	}
+}
+
+func ScannerWithReleaseName(name string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if helmScanner, ok := s.(ConfigurableHelmScanner); ok {
+			helmScanner.AddParserOptions(parser.OptionWithReleaseName(name))
+		}
+	}
+}
+
+func ScannerWithNamespace(namespace string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if helmScanner, ok := s.(ConfigurableHelmScanner); ok {
+			helmScanner.AddParserOptions(parser.OptionWithNamespace(namespace))
+		}
+	}
+}
+
+func ScannerWithKubeVersion(version string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if helmScanner, ok := s.(ConfigurableHelmScanner); ok {
+			helmScanner.AddParserOptions(parser.OptionWithKubeVersion(version))
+		}
+	}
+}
+
+func ScannerWithAPIVersions(versions ...string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if helmScanner, ok := s.(ConfigurableHelmScanner); ok {
+			helmScanner.AddParserOptions(parser.OptionWithAPIVersions(versions...))
+		}
+	}
+}
+
+// ScannerWithRepositoryIndex sets the path of the index.yaml of a local chart repository in the scanned filesystem,
+// which is used to resolve chart dependencies. See parser.OptionWithRepositoryIndex.
+func ScannerWithRepositoryIndex(path string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if helmScanner, ok := s.(ConfigurableHelmScanner); ok {
+			helmScanner.AddParserOptions(parser.OptionWithRepositoryIndex(path))
+		}
+	}
+}
+
+// ScannerWithValueProfile adds a named profile, such as "prod", made up of parser options like
+// parser.OptionWithValuesFile. When profiles are configured, each chart is rendered and scanned once per profile, on
+// top of the options shared by all profiles, and the results are tagged with the name of the profile.
+func ScannerWithValueProfile(name string, profileOptions ...options.ParserOption) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if helmScanner, ok := s.(ConfigurableHelmScanner); ok {
+			helmScanner.AddValueProfile(name, profileOptions...)
+		}
+	}
+}
+
+// ScannerWithSingleThread renders and scans one chart at a time, rather than spreading charts across a worker per CPU
+func ScannerWithSingleThread(single bool) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if helmScanner, ok := s.(ConfigurableHelmScanner); ok {
+			helmScanner.SetUseSingleThread(single)
+		}
+	}
+}
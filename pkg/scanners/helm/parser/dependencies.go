@@ -0,0 +1,211 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/repo"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aquasecurity/defsec/pkg/detection"
+)
+
+// Diagnostic describes a problem which prevented a chart, or one of its dependencies, from being rendered
+type Diagnostic struct {
+	// Source is the path of the chart directory or archive in the scanned filesystem
+	Source string
+	// Chart is the name of the chart which declares the dependency
+	Chart string
+	// Dependency is the name of the dependency which could not be resolved, if any
+	Dependency string
+	Message    string
+}
+
+func (d Diagnostic) String() string {
+	if d.Dependency == "" {
+		return fmt.Sprintf("%s: %s", d.Source, d.Message)
+	}
+	return fmt.Sprintf("%s: dependency %s of chart %s: %s", d.Source, d.Dependency, d.Chart, d.Message)
+}
+
+// Diagnostics returns the dependencies which could not be resolved while rendering the chart, and the values files
+// and repository index which could not be loaded
+func (p *Parser) Diagnostics() []Diagnostic {
+	return p.diagnostics
+}
+
+func (p *Parser) addDiagnostic(message string) {
+	p.debug.Log("Chart %s: %s", p.ChartSource, message)
+	p.diagnostics = append(p.diagnostics, Diagnostic{
+		Source:  p.ChartSource,
+		Message: message,
+	})
+}
+
+// isPackagedDependency returns true if the archive is a packaged dependency in the charts/ directory of a chart,
+// which helm loads along with the chart rather than it being a chart of its own
+func (p *Parser) isPackagedDependency(archivePath string) bool {
+	dir := path.Dir(archivePath)
+	if path.Base(dir) != "charts" {
+		return false
+	}
+	_, err := fs.Stat(p.workingFS, path.Join(path.Dir(dir), "Chart.yaml"))
+	return err == nil
+}
+
+// resolveDependencies adds the dependencies declared in Chart.yaml which are missing from the charts/ directory,
+// from sibling charts referenced by file:// repositories or from the local repository index. chartDir is the path of
+// the chart in fsys, which is nil for packaged charts.
+func (p *Parser) resolveDependencies(c *chart.Chart, fsys fs.FS, chartDir string) {
+	if fsys != nil {
+		// the chart is tracked while its dependencies load, so file:// references back to it are reported as cycles
+		chartDir = path.Clean(chartDir)
+		if p.loadingCharts == nil {
+			p.loadingCharts = make(map[string]bool)
+		}
+		p.loadingCharts[chartDir] = true
+		defer delete(p.loadingCharts, chartDir)
+	}
+
+	for _, dependency := range c.Metadata.Dependencies {
+		if hasDependency(c, dependency.Name) {
+			continue
+		}
+		resolved, err := p.loadDependency(dependency, fsys, chartDir)
+		if err != nil {
+			p.diagnostics = append(p.diagnostics, Diagnostic{
+				Source:     p.ChartSource,
+				Chart:      c.Name(),
+				Dependency: dependency.Name,
+				Message:    err.Error(),
+			})
+			continue
+		}
+		p.debug.Log("Resolved dependency %s of chart %s from %s", dependency.Name, c.Name(), dependency.Repository)
+		c.AddDependency(resolved)
+	}
+}
+
+func hasDependency(c *chart.Chart, name string) bool {
+	for _, dependency := range c.Dependencies() {
+		if dependency.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Parser) loadDependency(dependency *chart.Dependency, fsys fs.FS, chartDir string) (*chart.Chart, error) {
+	switch {
+	case strings.HasPrefix(dependency.Repository, "file://"):
+		if fsys == nil {
+			return nil, fmt.Errorf("repository %s is relative to a packaged chart", dependency.Repository)
+		}
+		target := path.Join(chartDir, strings.TrimPrefix(dependency.Repository, "file://"))
+		if !fs.ValidPath(target) {
+			return nil, fmt.Errorf("repository %s is outside of the scanned filesystem", dependency.Repository)
+		}
+		if p.loadingCharts[target] {
+			return nil, fmt.Errorf("repository %s is a dependency cycle, as the chart at %s is already being loaded", dependency.Repository, target)
+		}
+		return p.loadLocalChart(fsys, target)
+	case p.index != nil:
+		return p.loadIndexedChart(dependency)
+	default:
+		return nil, fmt.Errorf("not found in the charts/ directory, and repository %s is not available locally", dependency.Repository)
+	}
+}
+
+// loadLocalChart loads a chart directory or archive from the scanned filesystem
+func (p *Parser) loadLocalChart(fsys fs.FS, target string) (*chart.Chart, error) {
+	info, err := fs.Stat(fsys, target)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		if !detection.IsArchive(target) {
+			return nil, fmt.Errorf("%s is not a chart directory or archive", target)
+		}
+		return loadArchive(fsys, target)
+	}
+
+	var files []*loader.BufferedFile
+	if err := fs.WalkDir(fsys, target, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		content, err := fs.ReadFile(fsys, filePath)
+		if err != nil {
+			return err
+		}
+		name, _ := chartFileName(target, filePath)
+		files = append(files, &loader.BufferedFile{
+			Name: name,
+			Data: content,
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	loaded, err := loader.LoadFiles(files)
+	if err != nil {
+		return nil, err
+	}
+	p.resolveDependencies(loaded, fsys, target)
+	return loaded, nil
+}
+
+// loadIndexedChart loads the newest version of the dependency which satisfies its version constraint from the local
+// repository index. The index is used for every repository which is not file://, as it usually mirrors them.
+func (p *Parser) loadIndexedChart(dependency *chart.Dependency) (*chart.Chart, error) {
+	version, err := p.index.Get(dependency.Name, dependency.Version)
+	if err != nil {
+		return nil, fmt.Errorf("not found in repository index %s: %w", p.indexPath, err)
+	}
+	if len(version.URLs) == 0 {
+		return nil, fmt.Errorf("repository index %s has no url for version %s", p.indexPath, version.Version)
+	}
+
+	url := version.URLs[0]
+	if strings.Contains(url, "://") {
+		return nil, fmt.Errorf("version %s is not available locally: %s", version.Version, url)
+	}
+
+	loaded, err := loadArchive(p.targetFS, path.Join(path.Dir(p.indexPath), url))
+	if err != nil {
+		return nil, err
+	}
+	p.resolveDependencies(loaded, nil, "")
+	return loaded, nil
+}
+
+func loadArchive(fsys fs.FS, archivePath string) (*chart.Chart, error) {
+	content, err := fs.ReadFile(fsys, archivePath)
+	if err != nil {
+		return nil, err
+	}
+	return loader.LoadArchive(bytes.NewReader(content))
+}
+
+func (p *Parser) loadRepositoryIndex() (*repo.IndexFile, error) {
+	content, err := fs.ReadFile(p.targetFS, path.Clean(p.indexPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read repository index %s: %w", p.indexPath, err)
+	}
+	index := repo.NewIndexFile()
+	if err := yaml.Unmarshal(content, index); err != nil {
+		return nil, fmt.Errorf("failed to parse repository index %s: %w", p.indexPath, err)
+	}
+	index.SortEntries()
+	return index, nil
+}
@@ -0,0 +1,106 @@
+package parser
+
+import (
+	"github.com/aquasecurity/defsec/pkg/scanners/options"
+)
+
+type ConfigurableHelmParser interface {
+	options.ConfigurableParser
+	SetValuesFiles(...string)
+	SetChartValuesFiles(string, ...string)
+	SetValues(...string)
+	SetStringValues(...string)
+	SetReleaseName(string)
+	SetNamespace(string)
+	SetKubeVersion(string)
+	SetAPIVersions(...string)
+	SetRepositoryIndex(string)
+}
+
+// OptionWithValuesFile loads values from the given files, which are read from the scanned filesystem, in addition to
+// the values.yaml of the chart. Later files take precedence over earlier ones, as with 'helm install -f'. The files
+// apply to every chart parsed, and files which can't be read or parsed are reported as diagnostics and skipped.
+func OptionWithValuesFile(paths ...string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if helmParser, ok := p.(ConfigurableHelmParser); ok {
+			helmParser.SetValuesFiles(paths...)
+		}
+	}
+}
+
+// OptionWithChartValuesFile loads values from the given files, as with OptionWithValuesFile, but only for the chart at
+// chartPath in the scanned filesystem. These take precedence over values files which apply to every chart.
+func OptionWithChartValuesFile(chartPath string, paths ...string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if helmParser, ok := p.(ConfigurableHelmParser); ok {
+			helmParser.SetChartValuesFiles(chartPath, paths...)
+		}
+	}
+}
+
+// OptionWithValues sets values in the same format as 'helm install --set', e.g. "image.tag=1.2.3,replicaCount=2".
+// These take precedence over values files.
+func OptionWithValues(values ...string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if helmParser, ok := p.(ConfigurableHelmParser); ok {
+			helmParser.SetValues(values...)
+		}
+	}
+}
+
+// OptionWithStringValues sets values in the same format as 'helm install --set-string', where every value is treated
+// as a string. These take precedence over values files and values set with OptionWithValues.
+func OptionWithStringValues(values ...string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if helmParser, ok := p.(ConfigurableHelmParser); ok {
+			helmParser.SetStringValues(values...)
+		}
+	}
+}
+
+// OptionWithReleaseName sets the name of the release, which otherwise defaults to the name of the chart
+func OptionWithReleaseName(name string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if helmParser, ok := p.(ConfigurableHelmParser); ok {
+			helmParser.SetReleaseName(name)
+		}
+	}
+}
+
+func OptionWithNamespace(namespace string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if helmParser, ok := p.(ConfigurableHelmParser); ok {
+			helmParser.SetNamespace(namespace)
+		}
+	}
+}
+
+// OptionWithKubeVersion sets the Kubernetes version available to templates as .Capabilities.KubeVersion, e.g. "1.24.0"
+func OptionWithKubeVersion(version string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if helmParser, ok := p.(ConfigurableHelmParser); ok {
+			helmParser.SetKubeVersion(version)
+		}
+	}
+}
+
+// OptionWithAPIVersions adds API versions available to templates as .Capabilities.APIVersions, e.g.
+// "monitoring.coreos.com/v1" or "monitoring.coreos.com/v1/ServiceMonitor"
+func OptionWithAPIVersions(versions ...string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if helmParser, ok := p.(ConfigurableHelmParser); ok {
+			helmParser.SetAPIVersions(versions...)
+		}
+	}
+}
+
+// OptionWithRepositoryIndex sets the path of the index.yaml of a local chart repository in the scanned filesystem.
+// Dependencies which are missing from the charts/ directory, and are not file:// references, are loaded from the
+// chart archives it lists.
+func OptionWithRepositoryIndex(path string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if helmParser, ok := p.(ConfigurableHelmParser); ok {
+			helmParser.SetRepositoryIndex(path)
+		}
+	}
+}
@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
-	"io/ioutil"
-	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -17,11 +15,14 @@ import (
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/releaseutil"
+	"helm.sh/helm/v3/pkg/repo"
 
 	"github.com/aquasecurity/defsec/internal/debug"
 	"github.com/aquasecurity/defsec/pkg/detection"
+	"github.com/aquasecurity/defsec/pkg/scan"
 	"github.com/aquasecurity/defsec/pkg/scanners/options"
 )
 
@@ -35,11 +36,39 @@ type Parser struct {
 	debug        debug.Logger
 	skipRequired bool
 	workingFS    fs.FS
+	targetFS     fs.FS
+	valuesFiles  []string
+	// chartValuesFiles are values files which only apply to the chart at the path they are keyed by
+	chartValuesFiles map[string][]string
+	values           []string
+	stringValues     []string
+	releaseName      string
+	kubeVersion      string
+	chartValues      map[string]interface{}
+	indexPath        string
+	index            *repo.IndexFile
+	diagnostics      []Diagnostic
+	// loadingCharts are the paths of the charts whose dependencies are being resolved
+	loadingCharts map[string]bool
+
+	valuesSources []valuesSource
+	setValues     map[string]interface{}
+	templates     []*templateSource
 }
 
 type ChartFile struct {
 	TemplateFilePath string
 	ManifestContent  string
+	sources          []*scan.RenderedSource
+}
+
+// RenderedSource returns the template line, and the values key if any, which produced the given line of the
+// manifest, or nil if it is not known
+func (f ChartFile) RenderedSource(line int) *scan.RenderedSource {
+	if line < 1 || line > len(f.sources) {
+		return nil
+	}
+	return f.sources[line-1]
 }
 
 func (p *Parser) SetDebugWriter(writer io.Writer) {
@@ -50,6 +79,47 @@ func (p *Parser) SetSkipRequiredCheck(b bool) {
 	p.skipRequired = b
 }
 
+func (p *Parser) SetValuesFiles(paths ...string) {
+	p.valuesFiles = append(p.valuesFiles, paths...)
+}
+
+func (p *Parser) SetChartValuesFiles(chartPath string, paths ...string) {
+	if p.chartValuesFiles == nil {
+		p.chartValuesFiles = make(map[string][]string)
+	}
+	chartPath = cleanChartPath(chartPath)
+	p.chartValuesFiles[chartPath] = append(p.chartValuesFiles[chartPath], paths...)
+}
+
+func (p *Parser) SetValues(values ...string) {
+	p.values = append(p.values, values...)
+}
+
+func (p *Parser) SetStringValues(values ...string) {
+	p.stringValues = append(p.stringValues, values...)
+}
+
+func (p *Parser) SetReleaseName(name string) {
+	p.releaseName = name
+	p.helmClient.ReleaseName = name
+}
+
+func (p *Parser) SetNamespace(namespace string) {
+	p.helmClient.Namespace = namespace
+}
+
+func (p *Parser) SetKubeVersion(version string) {
+	p.kubeVersion = version
+}
+
+func (p *Parser) SetAPIVersions(versions ...string) {
+	p.helmClient.APIVersions = append(p.helmClient.APIVersions, versions...)
+}
+
+func (p *Parser) SetRepositoryIndex(path string) {
+	p.indexPath = path
+}
+
 func New(path string, options ...options.ParserOption) *Parser {
 
 	client := action.NewInstall(&action.Configuration{})
@@ -69,6 +139,39 @@ func New(path string, options ...options.ParserOption) *Parser {
 }
 
 func (p *Parser) ParseFS(ctx context.Context, target fs.FS, path string) error {
+	p.targetFS = target
+
+	if err := p.parseFS(ctx, target, path); err != nil {
+		return err
+	}
+
+	if p.kubeVersion != "" {
+		kubeVersion, err := chartutil.ParseKubeVersion(p.kubeVersion)
+		if err != nil {
+			return fmt.Errorf("invalid kubernetes version %q: %w", p.kubeVersion, err)
+		}
+		p.helmClient.KubeVersion = kubeVersion
+	}
+
+	values, err := p.loadValues()
+	if err != nil {
+		return err
+	}
+	p.chartValues = values
+
+	// dependencies which need a missing or invalid index are reported as unresolved when the chart is rendered
+	if p.indexPath != "" {
+		index, err := p.loadRepositoryIndex()
+		if err != nil {
+			p.addDiagnostic(err.Error())
+		} else {
+			p.index = index
+		}
+	}
+	return nil
+}
+
+func (p *Parser) parseFS(ctx context.Context, target fs.FS, path string) error {
 	p.workingFS = target
 
 	if err := fs.WalkDir(p.workingFS, filepath.ToSlash(path), func(path string, entry fs.DirEntry, err error) error {
@@ -88,12 +191,12 @@ func (p *Parser) ParseFS(ctx context.Context, target fs.FS, path string) error {
 			return nil
 		}
 
-		if detection.IsArchive(path) {
+		if detection.IsArchive(path) && !p.isPackagedDependency(path) {
 			tarFS, err := p.addTarToFS(path)
 			if err != nil {
 				return err
 			}
-			if err := p.ParseFS(ctx, tarFS, "."); err != nil {
+			if err := p.parseFS(ctx, tarFS, "."); err != nil {
 				return err
 			}
 			return nil
@@ -138,7 +241,7 @@ func (p *Parser) extractChartName(chartPath string) error {
 
 	if name, ok := chartContent["name"]; !ok {
 		return fmt.Errorf("could not extract the chart name from %s", chartPath)
-	} else {
+	} else if p.releaseName == "" {
 		p.helmClient.ReleaseName = name.(string)
 	}
 	return nil
@@ -146,27 +249,34 @@ func (p *Parser) extractChartName(chartPath string) error {
 
 func (p *Parser) RenderedChartFiles() ([]ChartFile, error) {
 
-	tempDir, err := ioutil.TempDir(os.TempDir(), "defsec")
+	workingChart, err := p.loadChart()
 	if err != nil {
 		return nil, err
 	}
 
-	if err := p.writeBuildFiles(tempDir); err != nil {
-		return nil, err
-	}
+	p.instrumentTemplates(workingChart)
+	instrumentedRelease, instrumentErr := p.getRelease(workingChart)
+	p.restoreTemplates()
 
-	workingChart, err := loadChart(tempDir)
+	workingRelease, err := p.getRelease(workingChart)
 	if err != nil {
 		return nil, err
 	}
 
-	workingRelease, err := p.getRelease(workingChart)
-	if err != nil {
-		return nil, err
+	// the markers are only kept if removing them gives the same output as the chart as it is, as templates may
+	// transform their text in ways which can't be undone, such as hashing or encoding it
+	manifest := workingRelease.Manifest
+	switch {
+	case instrumentErr != nil:
+		p.debug.Log("Failed to render instrumented chart, matching sources by their text: %s", instrumentErr)
+	case sourceMarkerRegex.ReplaceAllString(instrumentedRelease.Manifest, "") != manifest:
+		p.debug.Log("Instrumented chart rendered differently, matching sources by their text")
+	default:
+		manifest = instrumentedRelease.Manifest
 	}
 
 	var manifests bytes.Buffer
-	_, _ = fmt.Fprintln(&manifests, strings.TrimSpace(workingRelease.Manifest))
+	_, _ = fmt.Fprintln(&manifests, strings.TrimSpace(manifest))
 
 	splitManifests := releaseutil.SplitManifests(manifests.String())
 	manifestsKeys := make([]string, 0, len(splitManifests))
@@ -178,7 +288,7 @@ func (p *Parser) RenderedChartFiles() ([]ChartFile, error) {
 
 func (p *Parser) getRelease(chart *chart.Chart) (*release.Release, error) {
 
-	r, err := p.helmClient.RunWithContext(context.Background(), chart, nil)
+	r, err := p.helmClient.RunWithContext(context.Background(), chart, p.chartValues)
 	if err != nil {
 		return nil, err
 	}
@@ -189,12 +299,33 @@ func (p *Parser) getRelease(chart *chart.Chart) (*release.Release, error) {
 	return r, nil
 }
 
-func loadChart(tempFs string) (*chart.Chart, error) {
-	loadedChart, err := loader.Load(tempFs)
+// loadChart loads the chart from the parsed files in memory, rather than writing them out for loader.Load
+func (p *Parser) loadChart() (*chart.Chart, error) {
+	rootPath := filepath.ToSlash(p.rootPath)
+
+	var files []*loader.BufferedFile
+	for _, filePath := range p.filepaths {
+		name, ok := chartFileName(rootPath, filePath)
+		if !ok {
+			continue
+		}
+		content, err := fs.ReadFile(p.workingFS, filePath)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, &loader.BufferedFile{
+			Name: name,
+			Data: content,
+		})
+	}
+
+	loadedChart, err := loader.LoadFiles(files)
 	if err != nil {
 		return nil, err
 	}
 
+	p.resolveDependencies(loadedChart, p.workingFS, rootPath)
+
 	if req := loadedChart.Metadata.Dependencies; req != nil {
 		if err := action.CheckDependencies(loadedChart, req); err != nil {
 			return nil, err
@@ -203,7 +334,7 @@ func loadChart(tempFs string) (*chart.Chart, error) {
 	return loadedChart, nil
 }
 
-func (*Parser) getRenderedManifests(manifestsKeys []string, splitManifests map[string]string) []ChartFile {
+func (p *Parser) getRenderedManifests(manifestsKeys []string, splitManifests map[string]string) []ChartFile {
 	sort.Sort(releaseutil.BySplitManifestsOrder(manifestsKeys))
 	var manifestsToRender []ChartFile
 	for _, manifestKey := range manifestsKeys {
@@ -212,9 +343,12 @@ func (*Parser) getRenderedManifests(manifestsKeys []string, splitManifests map[s
 		if len(submatch) == 0 {
 			continue
 		}
+		templatePath := getManifestPath(manifest)
+		content, sources := p.stripSourceMarkers(manifest, templatePath)
 		manifestsToRender = append(manifestsToRender, ChartFile{
-			TemplateFilePath: getManifestPath(manifest),
-			ManifestContent:  manifest,
+			TemplateFilePath: templatePath,
+			ManifestContent:  content,
+			sources:          sources,
 		})
 	}
 	return manifestsToRender
@@ -232,22 +366,15 @@ func getManifestPath(manifest string) string {
 	return manifestFilePathParts[0]
 }
 
-func (p *Parser) writeBuildFiles(tempFs string) error {
-	for _, path := range p.filepaths {
-		content, err := fs.ReadFile(p.workingFS, path)
-		if err != nil {
-			return err
-		}
-		workingPath := strings.TrimPrefix(path, p.rootPath)
-		workingPath = filepath.Join(tempFs, workingPath)
-		if err := os.MkdirAll(filepath.Dir(workingPath), os.ModePerm); err != nil {
-			return err
-		}
-		if err := os.WriteFile(workingPath, content, os.ModePerm); err != nil {
-			return err
-		}
+// chartFileName returns the path of a file relative to the chart directory, and false if it is outside of it
+func chartFileName(chartDir string, filePath string) (string, bool) {
+	if chartDir == "." {
+		return filePath, true
 	}
-	return nil
+	if !strings.HasPrefix(filePath, chartDir+"/") {
+		return "", false
+	}
+	return strings.TrimPrefix(filePath, chartDir+"/"), true
 }
 
 func (p *Parser) required(path string, workingFS fs.FS) bool {
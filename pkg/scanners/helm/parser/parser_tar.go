@@ -21,8 +21,10 @@ func (p *Parser) addTarToFS(path string) (fs.FS, error) {
 	var file io.ReadCloser
 	var err error
 
-	tarFS := memoryfs.CloneFS(p.workingFS)
-	file, err = tarFS.Open(path)
+	// only the contents of the archive are extracted, so that charts next to it in the scanned filesystem are not
+	// mistaken for the chart it contains
+	tarFS := memoryfs.New()
+	file, err = p.workingFS.Open(path)
 	if err != nil {
 		return nil, err
 	}
@@ -81,13 +83,5 @@ func (p *Parser) addTarToFS(path string) (fs.FS, error) {
 		}
 	}
 
-	// force close the file for Windows so we can remove it from FS
-	_ = file.Close()
-
-	// remove the tarball from the fs
-	if err := tarFS.Remove(path); err != nil {
-		return nil, err
-	}
-
 	return tarFS, nil
 }
@@ -0,0 +1,333 @@
+package parser
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/chart"
+
+	"github.com/aquasecurity/defsec/pkg/scan"
+)
+
+// Templates are rendered with a marker comment appended to each line of literal text, so that the lines of the
+// rendered manifests can be traced back to the template lines which produced them. The markers are removed before
+// the manifests are scanned. Lines in define and block actions aren't marked, as their output may be included
+// elsewhere and transformed, e.g. by b64enc or sha256sum.
+var (
+	sourceMarkerRegex  = regexp.MustCompile(`\s?#defsec:(\d+):(\d+)`)
+	actionRegex        = regexp.MustCompile(`{{.*?}}`)
+	valuesActionRegex  = regexp.MustCompile(`^{{-?\s*(?:toYaml\s+)?\$?\.Values\.([\w.]+)\s*(?:\|\s*(?:quote|squote|toYaml|trim|nindent\s+\d+|indent\s+\d+)\s*)*-?}}$`)
+	controlActionRegex = regexp.MustCompile(`^{{-?\s*(?:/\*|if\b|else\b|end\b|with\b|range\b|define\b|\$[\w]*\s*:?=)`)
+	includedPathRegex  = regexp.MustCompile(`Template\.BasePath\s+"/([^"]+)"`)
+	openActionRegex    = regexp.MustCompile(`^{{-?\s*(define|block|if|range|with)\b`)
+	endActionRegex     = regexp.MustCompile(`^{{-?\s*end\b`)
+)
+
+type lineKind int
+
+const (
+	lineNone lineKind = iota
+	lineLiteral
+	lineOutput
+)
+
+// templateSource is a template of the chart, or of one of its dependencies, which has been instrumented
+type templateSource struct {
+	id           int
+	path         string
+	lines        []string
+	kinds        []lineKind
+	patterns     []*regexp.Regexp
+	original     []byte
+	file         *chart.File
+	valuesPrefix []string
+	chartValues  []valuesSource
+	valuesPaths  map[int][]string
+	cache        map[int]*scan.RenderedSource
+}
+
+// instrumentTemplates adds source markers to the manifest templates of the chart and its dependencies
+func (p *Parser) instrumentTemplates(c *chart.Chart) {
+	p.templates = nil
+	p.instrumentChart(c, "", nil, nil)
+}
+
+func (p *Parser) instrumentChart(c *chart.Chart, prefix string, valuesPrefix []string, parentValues []valuesSource) {
+	chartValues := append([]valuesSource{}, parentValues...)
+	for _, file := range c.Raw {
+		if file.Name != "values.yaml" {
+			continue
+		}
+		var node yaml.Node
+		if err := yaml.Unmarshal(file.Data, &node); err == nil {
+			chartValues = append(chartValues, valuesSource{
+				file:   path.Join(p.ChartSource, prefix, "values.yaml"),
+				node:   &node,
+				prefix: len(valuesPrefix),
+			})
+		}
+	}
+
+	// templates which are included by path are usually hashed, e.g. for a checksum/config annotation, so are left
+	// as they are to keep the rendered output the same
+	included := make(map[string]bool)
+	for _, file := range c.Templates {
+		for _, match := range includedPathRegex.FindAllStringSubmatch(string(file.Data), -1) {
+			included[path.Join("templates", match[1])] = true
+		}
+	}
+
+	for _, file := range c.Templates {
+		if !strings.HasPrefix(file.Name, "templates/") || strings.HasPrefix(path.Base(file.Name), "_") || included[file.Name] {
+			continue
+		}
+		if ext := path.Ext(file.Name); ext != ".yaml" && ext != ".yml" {
+			continue
+		}
+		source := &templateSource{
+			id:           len(p.templates),
+			path:         prefix + file.Name,
+			original:     file.Data,
+			file:         file,
+			valuesPrefix: valuesPrefix,
+			chartValues:  chartValues,
+			valuesPaths:  make(map[int][]string),
+			cache:        make(map[int]*scan.RenderedSource),
+		}
+		file.Data = []byte(source.instrument(string(file.Data)))
+		p.templates = append(p.templates, source)
+	}
+
+	for _, dependency := range c.Dependencies() {
+		p.instrumentChart(
+			dependency,
+			fmt.Sprintf("%scharts/%s/", prefix, dependency.Name()),
+			append(append([]string{}, valuesPrefix...), dependency.Name()),
+			chartValues,
+		)
+	}
+}
+
+// restoreTemplates removes the source markers from the templates. The templates are still used to trace the lines of
+// manifests rendered without markers, by matching their literal text.
+func (p *Parser) restoreTemplates() {
+	for _, source := range p.templates {
+		source.file.Data = source.original
+	}
+}
+
+func (t *templateSource) instrument(content string) string {
+	t.lines = strings.Split(content, "\n")
+	t.kinds = make([]lineKind, len(t.lines))
+	t.patterns = make([]*regexp.Regexp, len(t.lines))
+
+	inAction := false
+	// blocks holds whether each enclosing action which is closed by an end is a define or block
+	var blocks []bool
+	eligible := make([]bool, len(t.lines))
+	for i, line := range t.lines {
+		startsInAction := inAction
+		opens, closes := strings.Count(line, "{{"), strings.Count(line, "}}")
+		inAction = opens > closes || (inAction && closes == 0)
+		if startsInAction || inAction {
+			continue
+		}
+
+		actions := actionRegex.FindAllString(line, -1)
+		defined := isDefined(blocks)
+		for _, action := range actions {
+			if match := openActionRegex.FindStringSubmatch(action); match != nil {
+				blocks = append(blocks, match[1] == "define" || match[1] == "block")
+			} else if endActionRegex.MatchString(action) && len(blocks) > 0 {
+				blocks = blocks[:len(blocks)-1]
+			}
+			defined = defined || isDefined(blocks)
+		}
+		if defined {
+			continue
+		}
+
+		literal := strings.TrimSpace(actionRegex.ReplaceAllString(line, ""))
+		switch {
+		case literal != "":
+			t.kinds[i] = lineLiteral
+			t.patterns[i] = literalPattern(line)
+			eligible[i] = !strings.HasSuffix(strings.TrimSpace(line), "-}}")
+		case len(actions) > 0 && !controlActionRegex.MatchString(actions[0]):
+			t.kinds[i] = lineOutput
+		}
+
+		if len(actions) == 1 {
+			if match := valuesActionRegex.FindStringSubmatch(actions[0]); match != nil {
+				t.valuesPaths[i+1] = strings.Split(match[1], ".")
+			}
+		}
+	}
+
+	instrumented := make([]string, len(t.lines))
+	for i, line := range t.lines {
+		instrumented[i] = line
+		if eligible[i] && !chompsFollowingNewline(t.lines, i) {
+			instrumented[i] = fmt.Sprintf("%s #defsec:%d:%d", line, t.id, i+1)
+		}
+	}
+	return strings.Join(instrumented, "\n")
+}
+
+// isDefined returns true if any of the enclosing actions is a define or block
+func isDefined(blocks []bool) bool {
+	for _, defined := range blocks {
+		if defined {
+			return true
+		}
+	}
+	return false
+}
+
+// chompsFollowingNewline returns true if the next line removes the newline after the given line, and the newline
+// after itself, which would join the output following it onto the marker comment
+func chompsFollowingNewline(lines []string, i int) bool {
+	for _, next := range lines[i+1:] {
+		next = strings.TrimSpace(next)
+		if next == "" {
+			continue
+		}
+		return strings.HasPrefix(next, "{{-") && strings.HasSuffix(next, "-}}")
+	}
+	return false
+}
+
+// literalPattern matches the output of a template line, where each action can produce any text
+func literalPattern(line string) *regexp.Regexp {
+	var parts []string
+	for _, part := range actionRegex.Split(strings.TrimSpace(line), -1) {
+		parts = append(parts, regexp.QuoteMeta(part))
+	}
+	pattern, err := regexp.Compile(`^\s*` + strings.Join(parts, `.*`) + `\s*$`)
+	if err != nil {
+		return nil
+	}
+	return pattern
+}
+
+// stripSourceMarkers removes the source markers from a rendered manifest, returning the manifest and the source of
+// each of its lines
+func (p *Parser) stripSourceMarkers(manifest string, templatePath string) (string, []*scan.RenderedSource) {
+	lines := strings.Split(manifest, "\n")
+	if len(p.templates) == 0 {
+		return manifest, nil
+	}
+
+	var template *templateSource
+	for _, source := range p.templates {
+		if source.path == templatePath {
+			template = source
+			break
+		}
+	}
+
+	templateLines := make([]int, len(lines))
+	for i, line := range lines {
+		for _, match := range sourceMarkerRegex.FindAllStringSubmatch(line, -1) {
+			id, _ := strconv.Atoi(match[1])
+			if template == nil && id < len(p.templates) {
+				template = p.templates[id]
+			}
+			if templateLines[i] == 0 && template != nil && id == template.id {
+				templateLines[i], _ = strconv.Atoi(match[2])
+			}
+		}
+		lines[i] = sourceMarkerRegex.ReplaceAllString(line, "")
+	}
+
+	if template == nil {
+		return strings.Join(lines, "\n"), nil
+	}
+
+	sources := make([]*scan.RenderedSource, len(lines))
+	previous := 0
+	for i, line := range lines {
+		if strings.HasPrefix(line, "# Source: ") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		templateLine := templateLines[i]
+		if templateLine == 0 {
+			next := 0
+			for _, candidate := range templateLines[i+1:] {
+				if candidate > 0 {
+					next = candidate
+					break
+				}
+			}
+			templateLine = template.findLine(line, previous, next)
+		} else {
+			previous = templateLine
+		}
+		if templateLine > 0 {
+			sources[i] = p.renderedSource(template, templateLine)
+		}
+	}
+	return strings.Join(lines, "\n"), sources
+}
+
+// findLine finds the template line which produced a rendered line without a marker, which lies between the template
+// lines previous and next (exclusive, and 0 if unknown). It is the literal line matching the rendered line, otherwise
+// the first line which only outputs the result of an action, such as {{ toYaml .Values.x | nindent 4 }}.
+func (t *templateSource) findLine(rendered string, previous int, next int) int {
+	end := next - 1
+	if next == 0 || next <= previous {
+		end = len(t.lines)
+	}
+	for i := previous; i < end; i++ {
+		if t.kinds[i] == lineLiteral && t.patterns[i] != nil && t.patterns[i].MatchString(rendered) {
+			return i + 1
+		}
+	}
+	for i := previous; i < end; i++ {
+		if t.kinds[i] == lineOutput {
+			return i + 1
+		}
+	}
+	return next
+}
+
+func (p *Parser) renderedSource(template *templateSource, line int) *scan.RenderedSource {
+	if source, ok := template.cache[line]; ok {
+		return source
+	}
+
+	source := &scan.RenderedSource{
+		TemplateFile: path.Join(p.ChartSource, template.path),
+		TemplateLine: line,
+	}
+	if valuesPath, ok := template.valuesPaths[line]; ok {
+		source.ValuesPath = strings.Join(valuesPath, ".")
+		source.ValuesFile, source.ValuesLine = p.findValue(template, valuesPath)
+	}
+	template.cache[line] = source
+	return source
+}
+
+// findValue returns the file and line the value is set on, in order of precedence: --set style values, values files,
+// and then the values.yaml of the chart and the charts which include it
+func (p *Parser) findValue(template *templateSource, valuesPath []string) (string, int) {
+	fullPath := append(append([]string{}, template.valuesPrefix...), valuesPath...)
+	if hasValue(p.setValues, fullPath) {
+		return "", 0
+	}
+	for i := len(p.valuesSources) - 1; i >= 0; i-- {
+		if line := p.valuesSources[i].findValue(fullPath); line > 0 {
+			return p.valuesSources[i].file, line
+		}
+	}
+	for _, source := range template.chartValues {
+		if line := source.findValue(fullPath[source.prefix:]); line > 0 {
+			return source.file, line
+		}
+	}
+	return "", 0
+}
@@ -0,0 +1,134 @@
+package parser
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/strvals"
+)
+
+// loadValues merges the configured values files, --set and --set-string style values, in the same order of
+// precedence as helm. The defaults from the values.yaml of the chart are applied by helm when rendering. Values files
+// which can't be loaded are reported as diagnostics, so that one chart missing its file doesn't stop others rendering.
+func (p *Parser) loadValues() (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+
+	paths := append(append([]string{}, p.valuesFiles...), p.chartValuesFiles[cleanChartPath(p.ChartSource)]...)
+	for _, path := range paths {
+		content, err := fs.ReadFile(p.targetFS, filepath.ToSlash(path))
+		if err != nil {
+			p.addDiagnostic(fmt.Sprintf("failed to read values file %s: %s", path, err))
+			continue
+		}
+		fileValues, err := chartutil.ReadValues(content)
+		if err != nil {
+			p.addDiagnostic(fmt.Sprintf("failed to parse values file %s: %s", path, err))
+			continue
+		}
+		values = mergeMaps(values, fileValues)
+
+		var node yaml.Node
+		if err := yaml.Unmarshal(content, &node); err == nil {
+			p.valuesSources = append(p.valuesSources, valuesSource{
+				file: path,
+				node: &node,
+			})
+		}
+	}
+
+	p.setValues = make(map[string]interface{})
+	for _, value := range p.values {
+		if err := strvals.ParseInto(value, values); err != nil {
+			return nil, fmt.Errorf("failed to parse value %q: %w", value, err)
+		}
+		_ = strvals.ParseInto(value, p.setValues)
+	}
+
+	for _, value := range p.stringValues {
+		if err := strvals.ParseIntoString(value, values); err != nil {
+			return nil, fmt.Errorf("failed to parse string value %q: %w", value, err)
+		}
+		_ = strvals.ParseIntoString(value, p.setValues)
+	}
+
+	return values, nil
+}
+
+// cleanChartPath normalises the path of a chart so that values files can be keyed to it
+func cleanChartPath(chartPath string) string {
+	return path.Clean(filepath.ToSlash(chartPath))
+}
+
+// mergeMaps merges b into a, recursing into nested maps so that values files can override individual keys
+func mergeMaps(a, b map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(a))
+	for k, v := range a {
+		out[k] = v
+	}
+	for k, v := range b {
+		if v, ok := v.(map[string]interface{}); ok {
+			if bv, ok := out[k]; ok {
+				if bv, ok := bv.(map[string]interface{}); ok {
+					out[k] = mergeMaps(bv, v)
+					continue
+				}
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// valuesSource is a values file, kept so that values keys can be traced back to the line they are set on
+type valuesSource struct {
+	file string
+	node *yaml.Node
+	// prefix is the number of leading keys of a values path which select the values of the chart the file belongs to
+	prefix int
+}
+
+// findValue returns the line the key at the given path is set on, or 0 if it is not set in the file
+func (v valuesSource) findValue(path []string) int {
+	node := v.node
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	line := 0
+	for _, key := range path {
+		if node.Kind != yaml.MappingNode {
+			return 0
+		}
+		var next *yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				line = node.Content[i].Line
+				next = node.Content[i+1]
+				break
+			}
+		}
+		if next == nil {
+			return 0
+		}
+		node = next
+	}
+	return line
+}
+
+// hasValue returns true if the key at the given path is set in values parsed from --set style options
+func hasValue(values map[string]interface{}, path []string) bool {
+	var current interface{} = values
+	for _, key := range path {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if current, ok = m[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -6,7 +6,9 @@ import (
 	"io"
 	"io/fs"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/aquasecurity/defsec/pkg/detection"
 	"github.com/liamg/memoryfs"
@@ -30,6 +32,16 @@ type Scanner struct {
 	loadEmbedded  bool
 	policyFS      fs.FS
 	skipRequired  bool
+	parserOptions []options.ParserOption
+	profiles      []valueProfile
+	diagnostics   []parser.Diagnostic
+	// useSingleThread renders and scans one chart at a time, rather than using a worker per CPU
+	useSingleThread bool
+}
+
+type valueProfile struct {
+	name    string
+	options []options.ParserOption
 }
 
 // New creates a new Scanner
@@ -44,6 +56,21 @@ func New(options ...options.ScannerOption) *Scanner {
 	return s
 }
 
+func (s *Scanner) AddParserOptions(options ...options.ParserOption) {
+	s.parserOptions = append(s.parserOptions, options...)
+}
+
+func (s *Scanner) AddValueProfile(name string, options ...options.ParserOption) {
+	s.profiles = append(s.profiles, valueProfile{
+		name:    name,
+		options: options,
+	})
+}
+
+func (s *Scanner) SetUseSingleThread(single bool) {
+	s.useSingleThread = single
+}
+
 func (s *Scanner) SetUseEmbeddedPolicies(b bool) {
 	s.loadEmbedded = b
 }
@@ -88,9 +115,17 @@ func (s *Scanner) SetPolicyFilesystem(policyFS fs.FS) {
 	s.policyFS = policyFS
 }
 
+// Diagnostics returns the problems with charts found by the last scan, such as dependencies which could not be
+// resolved or values files which could not be loaded
+func (s *Scanner) Diagnostics() []parser.Diagnostic {
+	return s.diagnostics
+}
+
 func (s *Scanner) ScanFS(ctx context.Context, target fs.FS, path string) (scan.Results, error) {
 
-	var results []scan.Result
+	s.diagnostics = nil
+
+	var chartPaths []string
 	if err := fs.WalkDir(target, path, func(path string, d fs.DirEntry, err error) error {
 		select {
 		case <-ctx.Done():
@@ -103,23 +138,21 @@ func (s *Scanner) ScanFS(ctx context.Context, target fs.FS, path string) (scan.R
 		}
 
 		if d.IsDir() {
+			// dependencies in the charts/ directory are rendered along with the chart which includes them
+			if d.Name() == "charts" {
+				if _, err := fs.Stat(target, filepath.ToSlash(filepath.Join(filepath.Dir(path), "Chart.yaml"))); err == nil {
+					return fs.SkipDir
+				}
+			}
 			return nil
 		}
 
 		if detection.IsArchive(path) {
-			if scanResults, err := s.getScanResults(path, ctx, target); err != nil {
-				return err
-			} else {
-				results = append(results, scanResults...)
-			}
+			chartPaths = append(chartPaths, path)
 		}
 
 		if strings.HasSuffix(path, "Chart.yaml") {
-			if scanResults, err := s.getScanResults(filepath.Dir(path), ctx, target); err != nil {
-				return err
-			} else {
-				results = append(results, scanResults...)
-			}
+			chartPaths = append(chartPaths, filepath.Dir(path))
 		}
 
 		return nil
@@ -127,22 +160,11 @@ func (s *Scanner) ScanFS(ctx context.Context, target fs.FS, path string) (scan.R
 		return nil, err
 	}
 
-	return results, nil
-
-}
-
-func (s *Scanner) getScanResults(path string, ctx context.Context, target fs.FS) (results []scan.Result, err error) {
-	helmParser := parser.New(path)
-
-	if err := helmParser.ParseFS(ctx, target, path); err != nil {
-		return nil, err
-	}
-
-	chartFiles, err := helmParser.RenderedChartFiles()
-	if err != nil { // not valid helm, maybe some other yaml etc., abort
+	if len(chartPaths) == 0 {
 		return nil, nil
 	}
 
+	// the policies are compiled once and shared by all charts
 	regoScanner := rego.NewScanner(s.options...)
 	s.loadEmbedded = len(s.policyDirs)+len(s.policyReaders) == 0
 	policyFS := target
@@ -152,7 +174,135 @@ func (s *Scanner) getScanResults(path string, ctx context.Context, target fs.FS)
 	if err := regoScanner.LoadPolicies(s.loadEmbedded, policyFS, s.policyDirs, s.policyReaders); err != nil {
 		return nil, fmt.Errorf("policies load: %w", err)
 	}
+
+	charts := make([]chartScan, len(chartPaths))
+	for i, chartPath := range chartPaths {
+		charts[i].path = chartPath
+	}
+	if err := s.scanCharts(ctx, target, regoScanner, charts); err != nil {
+		return nil, err
+	}
+
+	// results and diagnostics are gathered in the order the charts were found, regardless of when they were scanned
+	var results []scan.Result
+	for _, chart := range charts {
+		results = append(results, chart.results...)
+		s.addDiagnostics(chart.diagnostics...)
+	}
+	return results, nil
+
+}
+
+// chartScan is a chart to be rendered and scanned by the worker pool, along with its outcome
+type chartScan struct {
+	path        string
+	results     scan.Results
+	diagnostics []parser.Diagnostic
+	err         error
+}
+
+// scanCharts renders and scans the charts using a bounded pool of workers, returning the first error encountered
+func (s *Scanner) scanCharts(ctx context.Context, target fs.FS, regoScanner *rego.Scanner, charts []chartScan) error {
+	threads := runtime.NumCPU()
+	if threads > 1 {
+		threads--
+	}
+	if s.useSingleThread {
+		threads = 1
+	}
+	if threads > len(charts) {
+		threads = len(charts)
+	}
+
+	jobs := make(chan *chartScan)
+	var wg sync.WaitGroup
+	for i := 0; i < threads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for chart := range jobs {
+				chart.results, chart.diagnostics, chart.err = s.getScanResults(chart.path, ctx, target, regoScanner)
+			}
+		}()
+	}
+
+	for i := range charts {
+		if ctx.Err() != nil {
+			break
+		}
+		jobs <- &charts[i]
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	for _, chart := range charts {
+		if chart.err != nil {
+			return chart.err
+		}
+	}
+	return nil
+}
+
+func (s *Scanner) getScanResults(path string, ctx context.Context, target fs.FS, regoScanner *rego.Scanner) (results []scan.Result, diagnostics []parser.Diagnostic, err error) {
+	profiles := s.profiles
+	if len(profiles) == 0 {
+		profiles = []valueProfile{{}}
+	}
+
+	for _, profile := range profiles {
+		parserOptions := append(append([]options.ParserOption{}, s.parserOptions...), profile.options...)
+		helmParser := parser.New(path, parserOptions...)
+
+		if err := helmParser.ParseFS(ctx, target, path); err != nil {
+			return nil, diagnostics, err
+		}
+
+		chartFiles, err := helmParser.RenderedChartFiles()
+		diagnostics = append(diagnostics, helmParser.Diagnostics()...)
+		if err != nil { // not valid helm, maybe some other yaml etc., abort
+			s.debug.Log("Failed to render chart %s: %s", path, err)
+			diagnostics = append(diagnostics, parser.Diagnostic{
+				Source:  path,
+				Message: fmt.Sprintf("failed to render chart: %s", err),
+			})
+			return nil, diagnostics, nil
+		}
+
+		if profile.name != "" {
+			s.debug.Log("Scanning chart %s with value profile %s", path, profile.name)
+		}
+		profileResults, err := s.scanChartFiles(ctx, regoScanner, helmParser.ChartSource, chartFiles)
+		if err != nil {
+			return nil, diagnostics, err
+		}
+		profileResults.SetProfile(profile.name)
+		results = append(results, profileResults...)
+	}
+	return results, diagnostics, nil
+}
+
+// addDiagnostics records diagnostics which were not already reported, e.g. when rendering another value profile
+func (s *Scanner) addDiagnostics(diagnostics ...parser.Diagnostic) {
+	for _, diagnostic := range diagnostics {
+		exists := false
+		for _, existing := range s.diagnostics {
+			if existing == diagnostic {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			s.diagnostics = append(s.diagnostics, diagnostic)
+		}
+	}
+}
+
+func (s *Scanner) scanChartFiles(ctx context.Context, regoScanner *rego.Scanner, chartSource string, chartFiles []parser.ChartFile) (results scan.Results, err error) {
 	for _, file := range chartFiles {
+		file := file
 		s.debug.Log("Processing rendered chart file: %s", file.TemplateFilePath)
 
 		manifests, err := kparser.New().Parse(strings.NewReader(file.ManifestContent), file.TemplateFilePath)
@@ -160,7 +310,7 @@ func (s *Scanner) getScanResults(path string, ctx context.Context, target fs.FS)
 			return nil, fmt.Errorf("unmarshal yaml: %w", err)
 		}
 		for _, manifest := range manifests {
-			fileResults, err := regoScanner.ScanInput(context.Background(), rego.Input{
+			fileResults, err := regoScanner.ScanInput(ctx, rego.Input{
 				Path:     file.TemplateFilePath,
 				Contents: manifest,
 				Type:     types.SourceKubernetes,
@@ -179,7 +329,12 @@ func (s *Scanner) getScanResults(path string, ctx context.Context, target fs.FS)
 				}, fs.ModePerm); err != nil {
 					return nil, err
 				}
-				fileResults.SetSourceAndFilesystem(helmParser.ChartSource, renderedFS, detection.IsArchive(helmParser.ChartSource))
+				fileResults.SetSourceAndFilesystem(chartSource, renderedFS, detection.IsArchive(chartSource))
+				for i := range fileResults {
+					if rng := fileResults[i].Range(); rng != nil {
+						fileResults[i].SetRenderedSource(file.RenderedSource(rng.GetStartLine()))
+					}
+				}
 			}
 
 			results = append(results, fileResults...)
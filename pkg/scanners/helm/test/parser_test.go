@@ -2,6 +2,9 @@ package test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"os"
 	"path/filepath"
 	"strings"
@@ -12,6 +15,7 @@ import (
 
 	"github.com/aquasecurity/defsec/pkg/detection"
 	"github.com/aquasecurity/defsec/pkg/scanners/helm/parser"
+	"github.com/aquasecurity/defsec/pkg/scanners/options"
 )
 
 func Test_helm_parser(t *testing.T) {
@@ -50,6 +54,242 @@ func Test_helm_parser(t *testing.T) {
 	}
 }
 
+func Test_helm_parser_with_values(t *testing.T) {
+
+	helmParser := parser.New("testchart",
+		parser.OptionWithValuesFile("values/hardened.yaml", "values/ingress.yaml"),
+		parser.OptionWithValues("replicaCount=5"),
+		parser.OptionWithStringValues("image.tag=1.21"),
+		parser.OptionWithReleaseName("prod"),
+		parser.OptionWithNamespace("production"),
+		parser.OptionWithKubeVersion("1.18.0"),
+	)
+	require.NoError(t, helmParser.ParseFS(context.TODO(), os.DirFS("testdata"), "testchart"))
+	manifests, err := helmParser.RenderedChartFiles()
+	require.NoError(t, err)
+
+	rendered := make(map[string]string)
+	for _, manifest := range manifests {
+		rendered[manifest.TemplateFilePath] = manifest.ManifestContent
+	}
+
+	require.Contains(t, rendered, "templates/deployment.yaml")
+	deployment := rendered["templates/deployment.yaml"]
+	assert.Contains(t, deployment, "name: prod-testchart")
+	assert.Contains(t, deployment, "replicas: 5")
+	assert.Contains(t, deployment, `image: "nginx:1.21"`)
+	assert.Contains(t, deployment, "readOnlyRootFilesystem: true")
+
+	require.Contains(t, rendered, "templates/ingress.yaml")
+	assert.Contains(t, rendered["templates/ingress.yaml"], "apiVersion: networking.k8s.io/v1beta1")
+}
+
+func Test_helm_parser_with_missing_values_file(t *testing.T) {
+	helmParser := parser.New("testchart", parser.OptionWithValuesFile("values/missing.yaml"))
+	require.NoError(t, helmParser.ParseFS(context.TODO(), os.DirFS("testdata"), "testchart"))
+	_, err := helmParser.RenderedChartFiles()
+	require.NoError(t, err)
+
+	diagnostics := helmParser.Diagnostics()
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "testchart", diagnostics[0].Source)
+	assert.Contains(t, diagnostics[0].Message, "values/missing.yaml")
+}
+
+func Test_helm_parser_with_chart_values_file(t *testing.T) {
+	render := func(chartPath string) string {
+		helmParser := parser.New(chartPath, parser.OptionWithChartValuesFile("./testchart/", "values/hardened.yaml"))
+		require.NoError(t, helmParser.ParseFS(context.TODO(), os.DirFS("testdata"), chartPath))
+		manifests, err := helmParser.RenderedChartFiles()
+		require.NoError(t, err)
+		assert.Empty(t, helmParser.Diagnostics())
+
+		var rendered string
+		for _, manifest := range manifests {
+			rendered += manifest.ManifestContent
+		}
+		return rendered
+	}
+
+	assert.Contains(t, render("testchart"), "readOnlyRootFilesystem: true")
+	assert.NotContains(t, render("sourcemap"), "readOnlyRootFilesystem: true")
+}
+
+func Test_helm_parser_with_dependencies(t *testing.T) {
+
+	helmParser := parser.New("umbrella", parser.OptionWithRepositoryIndex("repo/index.yaml"))
+	require.NoError(t, helmParser.ParseFS(context.TODO(), os.DirFS(filepath.Join("testdata", "dependencies")), "umbrella"))
+	manifests, err := helmParser.RenderedChartFiles()
+	require.NoError(t, err)
+	assert.Empty(t, helmParser.Diagnostics())
+
+	var paths []string
+	for _, manifest := range manifests {
+		paths = append(paths, manifest.TemplateFilePath)
+	}
+	assert.ElementsMatch(t, []string{
+		"templates/deployment.yaml",
+		"charts/packaged/templates/deployment.yaml",
+		"charts/sibling/templates/deployment.yaml",
+		"charts/indexed/templates/deployment.yaml",
+	}, paths)
+}
+
+func Test_helm_parser_with_unresolved_dependencies(t *testing.T) {
+
+	helmParser := parser.New("umbrella")
+	require.NoError(t, helmParser.ParseFS(context.TODO(), os.DirFS(filepath.Join("testdata", "dependencies")), "umbrella"))
+	_, err := helmParser.RenderedChartFiles()
+	require.Error(t, err)
+
+	diagnostics := helmParser.Diagnostics()
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "umbrella", diagnostics[0].Chart)
+	assert.Equal(t, "indexed", diagnostics[0].Dependency)
+}
+
+func Test_helm_parser_with_missing_repository_index(t *testing.T) {
+
+	helmParser := parser.New("umbrella", parser.OptionWithRepositoryIndex("repo/missing.yaml"))
+	require.NoError(t, helmParser.ParseFS(context.TODO(), os.DirFS(filepath.Join("testdata", "dependencies")), "umbrella"))
+	_, err := helmParser.RenderedChartFiles()
+	require.Error(t, err)
+
+	diagnostics := helmParser.Diagnostics()
+	require.Len(t, diagnostics, 2)
+	assert.Equal(t, "umbrella", diagnostics[0].Source)
+	assert.Contains(t, diagnostics[0].Message, "repo/missing.yaml")
+	assert.Equal(t, "indexed", diagnostics[1].Dependency)
+}
+
+func Test_helm_parser_with_dependency_cycles(t *testing.T) {
+
+	tests := []struct {
+		chart              string
+		expectedChart      string
+		expectedDependency string
+	}{
+		{
+			chart:              "first",
+			expectedChart:      "second",
+			expectedDependency: "first",
+		},
+		{
+			chart:              "self",
+			expectedChart:      "self",
+			expectedDependency: "self",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.chart, func(t *testing.T) {
+			helmParser := parser.New(test.chart)
+			require.NoError(t, helmParser.ParseFS(context.TODO(), os.DirFS(filepath.Join("testdata", "cycles")), test.chart))
+			_, _ = helmParser.RenderedChartFiles()
+
+			diagnostics := helmParser.Diagnostics()
+			require.Len(t, diagnostics, 1)
+			assert.Equal(t, test.expectedChart, diagnostics[0].Chart)
+			assert.Equal(t, test.expectedDependency, diagnostics[0].Dependency)
+			assert.Contains(t, diagnostics[0].Message, "dependency cycle")
+		})
+	}
+}
+
+func Test_helm_parser_rendered_sources(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		options            []options.ParserOption
+		expectedValuesFile string
+		expectedValuesLine int
+	}{
+		{
+			name:               "chart values",
+			expectedValuesFile: "sourcemap/values.yaml",
+			expectedValuesLine: 5,
+		},
+		{
+			name:               "values file",
+			options:            []options.ParserOption{parser.OptionWithValuesFile("values/hostnetwork.yaml")},
+			expectedValuesFile: "values/hostnetwork.yaml",
+			expectedValuesLine: 3,
+		},
+		{
+			name: "set value",
+			options: []options.ParserOption{
+				parser.OptionWithValuesFile("values/hostnetwork.yaml"),
+				parser.OptionWithValues("hostNetwork=true"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			helmParser := parser.New("sourcemap", test.options...)
+			require.NoError(t, helmParser.ParseFS(context.TODO(), os.DirFS("testdata"), "sourcemap"))
+			manifests, err := helmParser.RenderedChartFiles()
+			require.NoError(t, err)
+			require.Len(t, manifests, 1)
+
+			manifest := manifests[0]
+			assert.NotContains(t, manifest.ManifestContent, "#defsec")
+
+			lines := strings.Split(manifest.ManifestContent, "\n")
+			require.Equal(t, "  hostNetwork: true", lines[6])
+
+			source := manifest.RenderedSource(7)
+			require.NotNil(t, source)
+			assert.Equal(t, "sourcemap/templates/pod.yaml", source.TemplateFile)
+			assert.Equal(t, 6, source.TemplateLine)
+			assert.Equal(t, "hostNetwork", source.ValuesPath)
+			assert.Equal(t, test.expectedValuesFile, source.ValuesFile)
+			assert.Equal(t, test.expectedValuesLine, source.ValuesLine)
+
+			source = manifest.RenderedSource(9)
+			require.NotNil(t, source)
+			assert.Equal(t, 8, source.TemplateLine)
+			assert.Empty(t, source.ValuesPath)
+		})
+	}
+}
+
+func Test_helm_parser_rendered_sources_with_transformed_templates(t *testing.T) {
+
+	helmParser := parser.New("transformed")
+	require.NoError(t, helmParser.ParseFS(context.TODO(), os.DirFS("testdata"), "transformed"))
+	manifests, err := helmParser.RenderedChartFiles()
+	require.NoError(t, err)
+
+	rendered := make(map[string]parser.ChartFile)
+	for _, manifest := range manifests {
+		assert.NotContains(t, manifest.ManifestContent, "#defsec")
+		rendered[manifest.TemplateFilePath] = manifest
+	}
+
+	require.Contains(t, rendered, "templates/secret.yaml")
+	secret := strings.Split(rendered["templates/secret.yaml"].ManifestContent, "\n")
+	require.True(t, strings.HasPrefix(secret[6], "  config: "))
+	config, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret[6], "  config: "))
+	require.NoError(t, err)
+	assert.Equal(t, "\nmode: strict\nlevel: 3", string(config))
+
+	configMap, err := os.ReadFile(filepath.Join("testdata", "transformed", "templates", "configmap.yaml"))
+	require.NoError(t, err)
+	checksum := sha256.Sum256(configMap)
+
+	require.Contains(t, rendered, "templates/pod.yaml")
+	pod := rendered["templates/pod.yaml"]
+	lines := strings.Split(pod.ManifestContent, "\n")
+	assert.Equal(t, "    checksum/config: "+hex.EncodeToString(checksum[:]), lines[6])
+
+	require.Equal(t, "      image: nginx:1.21", lines[10])
+	source := pod.RenderedSource(11)
+	require.NotNil(t, source)
+	assert.Equal(t, "transformed/templates/pod.yaml", source.TemplateFile)
+	assert.Equal(t, 10, source.TemplateLine)
+}
+
 func Test_tar_is_chart(t *testing.T) {
 
 	tests := []struct {
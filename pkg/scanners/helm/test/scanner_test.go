@@ -2,18 +2,22 @@ package test
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 
+	"github.com/aquasecurity/defsec/pkg/scan"
 	"github.com/aquasecurity/defsec/pkg/scanners/options"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/aquasecurity/defsec/pkg/scanners/helm"
+	"github.com/aquasecurity/defsec/pkg/scanners/helm/parser"
 )
 
 func Test_helm_scanner_with_archive(t *testing.T) {
@@ -114,6 +118,160 @@ func Test_helm_scanner_with_dir(t *testing.T) {
 	}
 }
 
+func Test_helm_scanner_with_value_profiles(t *testing.T) {
+
+	helmScanner := helm.New(
+		options.ScannerWithEmbeddedPolicies(true),
+		helm.ScannerWithValueProfile("default"),
+		helm.ScannerWithValueProfile("hardened", parser.OptionWithValuesFile("values/hardened.yaml")),
+	)
+
+	results, err := helmScanner.ScanFS(context.TODO(), os.DirFS("testdata"), "testchart")
+	require.NoError(t, err)
+
+	failedByProfile := make(map[string][]string)
+	for _, result := range results.GetFailed() {
+		failedByProfile[result.Profile()] = append(failedByProfile[result.Profile()], result.Rule().AVDID)
+		assert.Equal(t, result.Profile(), result.Flatten().Profile)
+	}
+
+	require.Len(t, failedByProfile, 2)
+	assert.Len(t, failedByProfile["default"], 10)
+	assert.Less(t, len(failedByProfile["hardened"]), len(failedByProfile["default"]))
+	assert.NotContains(t, failedByProfile["hardened"], "AVD-KSV-0014")
+	assert.Contains(t, failedByProfile["default"], "AVD-KSV-0014")
+}
+
+func Test_helm_scanner_with_values(t *testing.T) {
+
+	helmScanner := helm.New(
+		options.ScannerWithEmbeddedPolicies(true),
+		helm.ScannerWithValues("securityContext.readOnlyRootFilesystem=true"),
+	)
+
+	results, err := helmScanner.ScanFS(context.TODO(), os.DirFS(filepath.Join("testdata", "testchart")), ".")
+	require.NoError(t, err)
+
+	var errorCodes []string
+	for _, result := range results.GetFailed() {
+		assert.Empty(t, result.Profile())
+		errorCodes = append(errorCodes, result.Rule().AVDID)
+	}
+	assert.Len(t, errorCodes, 9)
+	assert.NotContains(t, errorCodes, "AVD-KSV-0014")
+}
+
+func Test_helm_scanner_with_chart_values_files(t *testing.T) {
+
+	helmScanner := helm.New(
+		options.ScannerWithEmbeddedPolicies(true),
+		helm.ScannerWithChartValuesFile("testchart", "values/hardened.yaml"),
+		helm.ScannerWithChartValuesFile("sourcemap", "values/missing.yaml"),
+	)
+
+	results, err := helmScanner.ScanFS(context.TODO(), os.DirFS("testdata"), ".")
+	require.NoError(t, err)
+
+	failedByChart := make(map[string][]string)
+	for _, result := range results.GetFailed() {
+		chart := strings.SplitN(result.Range().GetFilename(), "/", 2)[0]
+		failedByChart[chart] = append(failedByChart[chart], result.Rule().AVDID)
+	}
+	assert.NotContains(t, failedByChart["testchart"], "AVD-KSV-0014")
+	assert.Contains(t, failedByChart["sourcemap"], "AVD-KSV-0014")
+
+	var sourcemapDiagnostics []parser.Diagnostic
+	for _, diagnostic := range helmScanner.Diagnostics() {
+		if diagnostic.Source == "sourcemap" {
+			sourcemapDiagnostics = append(sourcemapDiagnostics, diagnostic)
+		}
+	}
+	require.Len(t, sourcemapDiagnostics, 1)
+	assert.Contains(t, sourcemapDiagnostics[0].Message, "values/missing.yaml")
+}
+
+func Test_helm_scanner_with_dependencies(t *testing.T) {
+
+	helmScanner := helm.New(
+		options.ScannerWithEmbeddedPolicies(true),
+		helm.ScannerWithRepositoryIndex("repo/index.yaml"),
+	)
+
+	results, err := helmScanner.ScanFS(context.TODO(), os.DirFS(filepath.Join("testdata", "dependencies")), ".")
+	require.NoError(t, err)
+
+	filenames := make(map[string]bool)
+	for _, result := range results.GetFailed() {
+		filenames[result.Range().GetFilename()] = true
+	}
+	assert.Contains(t, filenames, "umbrella/templates/deployment.yaml")
+	assert.Contains(t, filenames, "umbrella/charts/packaged/templates/deployment.yaml")
+	assert.Contains(t, filenames, "umbrella/charts/sibling/templates/deployment.yaml")
+	assert.Contains(t, filenames, "umbrella/charts/indexed/templates/deployment.yaml")
+
+	var broken []string
+	for _, diagnostic := range helmScanner.Diagnostics() {
+		if diagnostic.Source == "broken" {
+			broken = append(broken, diagnostic.Dependency)
+		}
+	}
+	assert.Equal(t, []string{"absent", ""}, broken)
+}
+
+func Test_helm_scanner_with_invalid_repository_index(t *testing.T) {
+
+	helmScanner := helm.New(
+		options.ScannerWithEmbeddedPolicies(true),
+		helm.ScannerWithRepositoryIndex("repo/indexed-0.1.0.tgz"),
+	)
+
+	results, err := helmScanner.ScanFS(context.TODO(), os.DirFS(filepath.Join("testdata", "dependencies")), ".")
+	require.NoError(t, err)
+
+	filenames := make(map[string]bool)
+	for _, result := range results.GetFailed() {
+		filenames[result.Range().GetFilename()] = true
+	}
+	assert.Contains(t, filenames, "sibling/templates/deployment.yaml")
+	assert.NotContains(t, filenames, "umbrella/templates/deployment.yaml")
+
+	var reported bool
+	for _, diagnostic := range helmScanner.Diagnostics() {
+		if diagnostic.Source == "umbrella" && strings.Contains(diagnostic.Message, "failed to parse repository index") {
+			reported = true
+		}
+	}
+	assert.True(t, reported)
+}
+
+func Test_helm_scanner_rendered_sources(t *testing.T) {
+
+	helmScanner := helm.New(options.ScannerWithEmbeddedPolicies(true))
+
+	results, err := helmScanner.ScanFS(context.TODO(), os.DirFS("testdata"), "sourcemap")
+	require.NoError(t, err)
+
+	var found bool
+	for _, result := range results.GetFailed() {
+		source := result.RenderedSource()
+		require.NotNil(t, source)
+		assert.Equal(t, "sourcemap/templates/pod.yaml", source.TemplateFile)
+		if result.Rule().AVDID != "AVD-KSV-0009" {
+			continue
+		}
+		found = true
+		assert.Equal(t, &scan.RenderedSource{
+			TemplateFile: "sourcemap/templates/pod.yaml",
+			TemplateLine: 6,
+			ValuesPath:   "hostNetwork",
+			ValuesFile:   "sourcemap/values.yaml",
+			ValuesLine:   5,
+		}, source)
+		assert.Equal(t, source, result.Flatten().RenderedSource)
+	}
+	assert.True(t, found, "expected a host network finding")
+}
+
 func copyArchive(src, dst string) error {
 	in, err := os.Open(src)
 	if err != nil {
@@ -132,3 +290,34 @@ func copyArchive(src, dst string) error {
 	}
 	return nil
 }
+
+func Test_helm_scanner_with_worker_pool(t *testing.T) {
+
+	// charts are loaded in memory, so nothing should be written to the temp directory
+	tempDir := t.TempDir()
+	t.Setenv("TMPDIR", tempDir)
+
+	scanCharts := func(scannerOptions ...options.ScannerOption) ([]string, []parser.Diagnostic) {
+		helmScanner := helm.New(append(scannerOptions, options.ScannerWithEmbeddedPolicies(true))...)
+		results, err := helmScanner.ScanFS(context.TODO(), os.DirFS("testdata"), ".")
+		require.NoError(t, err)
+
+		var failures []string
+		for _, result := range results.GetFailed() {
+			failures = append(failures, fmt.Sprintf("%s:%s:%d", result.Range().GetFilename(), result.Rule().AVDID, result.Range().GetStartLine()))
+		}
+		sort.Strings(failures)
+		return failures, helmScanner.Diagnostics()
+	}
+
+	singleFailures, singleDiagnostics := scanCharts(helm.ScannerWithSingleThread(true))
+	pooledFailures, pooledDiagnostics := scanCharts()
+
+	require.NotEmpty(t, singleFailures)
+	assert.Equal(t, singleFailures, pooledFailures)
+	assert.Equal(t, singleDiagnostics, pooledDiagnostics)
+
+	entries, err := os.ReadDir(tempDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
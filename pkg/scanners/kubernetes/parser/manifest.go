@@ -3,6 +3,7 @@ package parser
 import (
 	"fmt"
 
+	"github.com/liamg/jfather"
 	"gopkg.in/yaml.v3"
 )
 
@@ -28,6 +29,100 @@ func (m *Manifest) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+func (m *Manifest) UnmarshalJSONWithMetadata(node jfather.Node) error {
+
+	switch node.Kind() {
+	case jfather.KindObject:
+		manifestNode := new(ManifestNode)
+		manifestNode.Path = m.Path
+		if err := node.Decode(manifestNode); err != nil {
+			return err
+		}
+		m.Content = manifestNode
+	default:
+		return fmt.Errorf("failed to handle kind: %d", node.Kind())
+	}
+
+	return nil
+}
+
+// Items returns the manifests in the items of a List, such as the output of 'kubectl get -o yaml', or the manifest
+// itself for any other kind, so that each item is checked individually
+func (m *Manifest) Items() []*Manifest {
+	if m.Content == nil || m.Content.Type != TagMap {
+		return []*Manifest{m}
+	}
+	content := m.Content.Value.(map[string]ManifestNode)
+	kind, ok := content["kind"]
+	if !ok || kind.Value != "List" {
+		return []*Manifest{m}
+	}
+	items, ok := content["items"]
+	if !ok || items.Type != TagSlice {
+		return nil
+	}
+
+	var manifests []*Manifest
+	for _, item := range items.Value.([]ManifestNode) {
+		if item.Type != TagMap {
+			continue
+		}
+		item := item
+		manifests = append(manifests, &Manifest{
+			Path:    m.Path,
+			Content: &item,
+		})
+	}
+	return manifests
+}
+
+// clusterScopedKinds are the built-in kinds which do not belong to a namespace
+var clusterScopedKinds = map[string]bool{
+	"APIService":                     true,
+	"ClusterRole":                    true,
+	"ClusterRoleBinding":             true,
+	"CSIDriver":                      true,
+	"CSINode":                        true,
+	"CustomResourceDefinition":       true,
+	"IngressClass":                   true,
+	"MutatingWebhookConfiguration":   true,
+	"Namespace":                      true,
+	"Node":                           true,
+	"PersistentVolume":               true,
+	"PodSecurityPolicy":              true,
+	"PriorityClass":                  true,
+	"RuntimeClass":                   true,
+	"StorageClass":                   true,
+	"ValidatingWebhookConfiguration": true,
+	"VolumeAttachment":               true,
+}
+
+// Namespace returns the namespace the manifest is applied to, which is "default" for namespaced kinds which do not
+// set one, and empty for cluster-scoped kinds
+func (m *Manifest) Namespace() string {
+	if m.Content == nil || m.Content.Type != TagMap {
+		return ""
+	}
+	content := m.Content.Value.(map[string]ManifestNode)
+	if kind, _ := content["kind"].Value.(string); clusterScopedKinds[kind] {
+		return ""
+	}
+	if metadata := content["metadata"]; metadata.Type == TagMap {
+		if namespace, ok := metadata.Value.(map[string]ManifestNode)["namespace"].Value.(string); ok && namespace != "" {
+			return namespace
+		}
+	}
+	return "default"
+}
+
+// ToRego converts the manifest for rego, recording the namespace it is applied to in its metadata so that checks
+// which combine manifests can tell which of them can refer to each other
 func (m *Manifest) ToRego() interface{} {
-	return m.Content.ToRego()
+	output := m.Content.ToRego()
+	if content, ok := output.(map[string]interface{}); ok {
+		if metadata, ok := content["__defsec_metadata"].(map[string]interface{}); ok {
+			metadata["namespace"] = m.Namespace()
+		}
+	}
+	return output
 }
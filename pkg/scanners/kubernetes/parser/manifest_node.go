@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/liamg/jfather"
 	"gopkg.in/yaml.v3"
 )
 
@@ -12,9 +13,11 @@ type TagType string
 const (
 	TagBool   TagType = "!!bool"
 	TagInt    TagType = "!!int"
+	TagFloat  TagType = "!!float"
 	TagString TagType = "!!str"
 	TagSlice  TagType = "!!seq"
 	TagMap    TagType = "!!map"
+	TagNull   TagType = "!!null"
 )
 
 type ManifestNode struct {
@@ -30,7 +33,7 @@ func (r *ManifestNode) ToRego() interface{} {
 		return nil
 	}
 	switch r.Type {
-	case TagBool, TagInt, TagString:
+	case TagBool, TagInt, TagFloat, TagString, TagNull:
 		return r.Value
 	case TagSlice:
 		var output []interface{}
@@ -68,6 +71,14 @@ func (r *ManifestNode) UnmarshalYAML(node *yaml.Node) error {
 			return err
 		}
 		r.Value = val
+	case TagFloat:
+		var val float64
+		if err := node.Decode(&val); err != nil {
+			return err
+		}
+		r.Value = val
+	case TagNull:
+		r.Value = nil
 	case TagBool:
 		val, err := strconv.ParseBool(node.Value)
 		if err != nil {
@@ -116,3 +127,65 @@ func (r *ManifestNode) UnmarshalYAML(node *yaml.Node) error {
 	}
 	return nil
 }
+
+func (r *ManifestNode) UnmarshalJSONWithMetadata(node jfather.Node) error {
+
+	r.StartLine = node.Range().Start.Line
+	r.EndLine = node.Range().End.Line
+
+	switch node.Kind() {
+	case jfather.KindString:
+		r.Type = TagString
+		return node.Decode(&r.Value)
+	case jfather.KindNumber:
+		var val interface{}
+		if err := node.Decode(&val); err != nil {
+			return err
+		}
+		if i, ok := val.(int64); ok {
+			r.Type = TagInt
+			r.Value = int(i)
+			return nil
+		}
+		r.Type = TagFloat
+		r.Value = val
+	case jfather.KindBoolean:
+		r.Type = TagBool
+		return node.Decode(&r.Value)
+	case jfather.KindNull:
+		r.Type = TagNull
+		r.Value = nil
+	case jfather.KindObject:
+		r.Type = TagMap
+		output := make(map[string]ManifestNode)
+		content := node.Content()
+		for i := 0; i+1 < len(content); i += 2 {
+			var key string
+			if err := content[i].Decode(&key); err != nil {
+				return err
+			}
+			newNode := new(ManifestNode)
+			newNode.Path = r.Path
+			if err := content[i+1].Decode(newNode); err != nil {
+				return err
+			}
+			output[key] = *newNode
+		}
+		r.Value = output
+	case jfather.KindArray:
+		r.Type = TagSlice
+		var nodes []ManifestNode
+		for _, contentNode := range node.Content() {
+			newNode := new(ManifestNode)
+			newNode.Path = r.Path
+			if err := contentNode.Decode(newNode); err != nil {
+				return err
+			}
+			nodes = append(nodes, *newNode)
+		}
+		r.Value = nodes
+	default:
+		return fmt.Errorf("node kind is not supported %d", node.Kind())
+	}
+	return nil
+}
@@ -3,14 +3,14 @@ package parser
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
 	"io/ioutil"
 	"path/filepath"
-	"strings"
 
+	"github.com/liamg/jfather"
 	"gopkg.in/yaml.v3"
 
 	"github.com/aquasecurity/defsec/internal/debug"
@@ -43,7 +43,23 @@ func New(options ...options.ParserOption) *Parser {
 }
 
 func (p *Parser) ParseFS(ctx context.Context, target fs.FS, path string) (map[string][]interface{}, error) {
+	manifests, err := p.ParseFSManifests(ctx, target, path)
+	if err != nil {
+		return nil, err
+	}
 	files := make(map[string][]interface{})
+	for path, fileManifests := range manifests {
+		for _, manifest := range fileManifests {
+			files[path] = append(files[path], manifest.ToRego())
+		}
+	}
+	return files, nil
+}
+
+// ParseFSManifests parses the Kubernetes manifests found in the filesystem, keeping them typed rather than converting
+// them for rego, so that they can be adapted to the Kubernetes provider
+func (p *Parser) ParseFSManifests(ctx context.Context, target fs.FS, path string) (map[string][]*Manifest, error) {
+	files := make(map[string][]*Manifest)
 	if err := fs.WalkDir(target, filepath.ToSlash(path), func(path string, entry fs.DirEntry, err error) error {
 		select {
 		case <-ctx.Done():
@@ -59,7 +75,12 @@ func (p *Parser) ParseFS(ctx context.Context, target fs.FS, path string) (map[st
 		if !p.required(target, path) {
 			return nil
 		}
-		parsed, err := p.ParseFile(ctx, target, path)
+		f, err := target.Open(path)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		parsed, err := p.ParseManifests(f, path)
 		if err != nil {
 			p.debug.Log("Parse error in '%s': %s", path, err)
 			return nil
@@ -98,40 +119,69 @@ func (p *Parser) required(fs fs.FS, path string) bool {
 }
 
 func (p *Parser) Parse(r io.Reader, path string) ([]interface{}, error) {
+	manifests, err := p.ParseManifests(r, path)
+	if err != nil {
+		return nil, err
+	}
+	var results []interface{}
+	for _, manifest := range manifests {
+		results = append(results, manifest.ToRego())
+	}
+	return results, nil
+}
+
+// ParseManifests parses each document of a YAML stream, or a JSON document, into a manifest. The items of a List are
+// returned as manifests of their own.
+func (p *Parser) ParseManifests(r io.Reader, path string) ([]*Manifest, error) {
 
 	contents, err := ioutil.ReadAll(r)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(contents) == 0 {
+	if len(bytes.TrimSpace(contents)) == 0 {
 		return nil, nil
 	}
 
-	if strings.TrimSpace(string(contents))[0] == '{' {
-		var target interface{}
-		if err := json.Unmarshal(contents, &target); err != nil {
-			return nil, err
+	var manifests []*Manifest
+	if bytes.TrimSpace(contents)[0] == '{' {
+		manifest := &Manifest{Path: path}
+		if err := jfather.Unmarshal(contents, manifest); err != nil {
+			return nil, fmt.Errorf("unmarshal json: %w", err)
 		}
-		return []interface{}{target}, nil
+		manifests = append(manifests, manifest)
+	} else if manifests, err = p.parseYAML(contents, path); err != nil {
+		return nil, err
 	}
 
-	var results []interface{}
-
-	marker := "\n---\n"
-	altMarker := "\r\n---\r\n"
-	if bytes.Contains(contents, []byte(altMarker)) {
-		marker = altMarker
+	var items []*Manifest
+	for _, manifest := range manifests {
+		items = append(items, manifest.Items()...)
 	}
+	return items, nil
+}
 
-	for _, partial := range strings.Split(string(contents), marker) {
-		var result Manifest
-		result.Path = path
-		if err := yaml.Unmarshal([]byte(partial), &result); err != nil {
+// parseYAML decodes each document of a YAML stream, skipping empty documents. The line numbers of each document are
+// relative to the start of the stream rather than the document.
+func (p *Parser) parseYAML(contents []byte, path string) ([]*Manifest, error) {
+	var manifests []*Manifest
+	decoder := yaml.NewDecoder(bytes.NewReader(contents))
+	for {
+		var document yaml.Node
+		if err := decoder.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
 			return nil, fmt.Errorf("unmarshal yaml: %w", err)
 		}
-		results = append(results, result.ToRego())
+		if len(document.Content) == 0 || document.Content[0].Tag == string(TagNull) {
+			continue
+		}
+		manifest := &Manifest{Path: path}
+		if err := document.Decode(manifest); err != nil {
+			return nil, fmt.Errorf("unmarshal yaml: %w", err)
+		}
+		manifests = append(manifests, manifest)
 	}
-
-	return results, nil
+	return manifests, nil
 }
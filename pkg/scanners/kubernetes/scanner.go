@@ -6,9 +6,14 @@ import (
 	"io/fs"
 	"io/ioutil"
 	"path/filepath"
+	"sort"
 	"sync"
 
+	adapter "github.com/aquasecurity/defsec/internal/adapters/kubernetes"
 	"github.com/aquasecurity/defsec/internal/debug"
+	"github.com/aquasecurity/defsec/internal/rules"
+	"github.com/aquasecurity/defsec/pkg/providers"
+	"github.com/aquasecurity/defsec/pkg/state"
 
 	"github.com/aquasecurity/defsec/pkg/scanners/options"
 
@@ -21,6 +26,7 @@ import (
 
 	"github.com/aquasecurity/defsec/pkg/scan"
 
+	_ "github.com/aquasecurity/defsec/pkg/rules"
 	"github.com/aquasecurity/defsec/pkg/scanners"
 )
 
@@ -122,7 +128,7 @@ func (s *Scanner) ScanReader(ctx context.Context, filename string, reader io.Rea
 
 func (s *Scanner) ScanFS(ctx context.Context, target fs.FS, dir string) (scan.Results, error) {
 
-	k8sFilesets, err := s.parser.ParseFS(ctx, target, dir)
+	k8sFilesets, err := s.parser.ParseFSManifests(ctx, target, dir)
 	if err != nil {
 		return nil, err
 	}
@@ -131,27 +137,61 @@ func (s *Scanner) ScanFS(ctx context.Context, target fs.FS, dir string) (scan.Re
 		return nil, nil
 	}
 
+	paths := make([]string, 0, len(k8sFilesets))
+	for path := range k8sFilesets {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var manifests []*parser.Manifest
 	var inputs []rego.Input
-	for path, k8sFiles := range k8sFilesets {
-		for _, content := range k8sFiles {
+	for _, path := range paths {
+		for _, manifest := range k8sFilesets[path] {
+			manifests = append(manifests, manifest)
 			inputs = append(inputs, rego.Input{
 				Path:     path,
-				Contents: content,
+				Contents: manifest.ToRego(),
 				Type:     types.SourceKubernetes,
 			})
 		}
 	}
 
+	results, err := s.scanState(ctx, adapter.Adapt(target, manifests))
+	if err != nil {
+		return nil, err
+	}
+
 	regoScanner, err := s.initRegoScanner(target)
 	if err != nil {
 		return nil, err
 	}
 
 	s.debug.Log("Scanning %d files...", len(inputs))
-	results, err := regoScanner.ScanInput(ctx, inputs...)
+	regoResults, err := regoScanner.ScanInput(ctx, inputs...)
 	if err != nil {
 		return nil, err
 	}
-	results.SetSourceAndFilesystem("", target, false)
+	regoResults.SetSourceAndFilesystem("", target, false)
+	return append(results, regoResults...), nil
+}
+
+// scanState runs the Go rules of the Kubernetes provider against the manifests adapted to it, which is shared with
+// the kubernetes_* Terraform resources. Rules for other providers are skipped, as the state holds nothing for them.
+func (s *Scanner) scanState(ctx context.Context, state *state.State) (results scan.Results, err error) {
+	for _, rule := range rules.GetRegistered() {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		if rule.Rule().RegoPackage != "" || rule.Rule().Provider != providers.KubernetesProvider {
+			continue
+		}
+		evalResult := rule.Evaluate(state)
+		if len(evalResult) > 0 {
+			s.debug.Log("Found %d results for %s", len(evalResult), rule.Rule().AVDID)
+			results = append(results, evalResult...)
+		}
+	}
 	return results, nil
 }
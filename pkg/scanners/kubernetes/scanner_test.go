@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strings"
 	"testing"
@@ -356,6 +357,34 @@ spec:
 	assert.Greater(t, len(results.GetFailed()), 0)
 }
 
+func Test_FileScan_NetworkPolicy(t *testing.T) {
+
+	results, err := NewScanner(options.ScannerWithEmbeddedPolicies(true)).ScanReader(context.TODO(), "k8s.yaml", strings.NewReader(`
+apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: open
+spec:
+  podSelector: {}
+  egress:
+  - to:
+    - ipBlock:
+        cidr: 0.0.0.0/0
+`))
+	require.NoError(t, err)
+
+	var failed []string
+	var line int
+	for _, result := range results.GetFailed() {
+		failed = append(failed, result.Rule().AVDID)
+		if result.Rule().AVDID == "AVD-KUBE-0002" {
+			line = result.Range().GetStartLine()
+		}
+	}
+	assert.Contains(t, failed, "AVD-KUBE-0002")
+	assert.Equal(t, 11, line)
+}
+
 func Test_FileScan_WithSeparator(t *testing.T) {
 
 	results, err := NewScanner(options.ScannerWithEmbeddedPolicies(true)).ScanReader(context.TODO(), "k8s.yaml", strings.NewReader(`
@@ -574,3 +603,315 @@ spec:
 	assert.Equal(t, 14, firstResult.Metadata().Range().GetEndLine())
 	assert.Equal(t, "k8s.yaml", firstResult.Metadata().Range().GetFilename())
 }
+
+const podResultPolicy = `package defsec
+
+import data.lib.result
+
+deny[res] {
+  input.kind == "Pod"
+  res := result.new(sprintf("pod %s", [input.metadata.name]), input)
+}
+`
+
+func scanWithPodResultPolicy(t *testing.T, filename string, content string) scan.Results {
+	results, err := NewScanner(
+		options.ScannerWithPolicyFilesystem(os.DirFS("../../../internal/rules")),
+		options.ScannerWithPolicyDirs("defsec/lib"),
+		options.OptionWithPolicyReaders(strings.NewReader(podResultPolicy)),
+	).ScanReader(context.TODO(), filename, strings.NewReader(content))
+	require.NoError(t, err)
+	return results
+}
+
+func Test_FileScan_MultipleDocuments(t *testing.T) {
+
+	results := scanWithPodResultPolicy(t, "k8s.yaml", `--- # the first pod
+apiVersion: v1
+kind: Pod
+metadata:
+  name: first
+spec: {}
+---
+# an empty document
+---   
+apiVersion: v1
+kind: Pod
+metadata:
+  name: second
+spec: {}
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: third
+spec: {}`)
+
+	failed := results.GetFailed()
+	require.Len(t, failed, 3)
+
+	lines := make(map[string][]int)
+	for _, result := range failed {
+		lines[result.Description()] = []int{result.Range().GetStartLine(), result.Range().GetEndLine()}
+	}
+	assert.Equal(t, map[string][]int{
+		"pod first":  {2, 6},
+		"pod second": {10, 14},
+		"pod third":  {16, 20},
+	}, lines)
+}
+
+func Test_FileScanJSON_WithLines(t *testing.T) {
+
+	results := scanWithPodResultPolicy(t, "k8s.json", `{
+  "apiVersion": "v1",
+  "kind": "Pod",
+  "metadata": {
+    "name": "json",
+    "labels": {
+      "replicas": 2,
+      "ratio": 0.5,
+      "enabled": true,
+      "owner": null
+    }
+  },
+  "spec": {}
+}`)
+
+	failed := results.GetFailed()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "pod json", failed[0].Description())
+	assert.Equal(t, 1, failed[0].Range().GetStartLine())
+	assert.Equal(t, 14, failed[0].Range().GetEndLine())
+}
+
+func Test_FileScan_List(t *testing.T) {
+
+	results := scanWithPodResultPolicy(t, "k8s.yaml", `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: first
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: second
+`)
+
+	failed := results.GetFailed()
+	require.Len(t, failed, 2)
+
+	lines := make(map[string]int)
+	for _, result := range failed {
+		lines[result.Description()] = result.Range().GetStartLine()
+	}
+	assert.Equal(t, map[string]int{
+		"pod first":  4,
+		"pod second": 8,
+	}, lines)
+}
+
+func Test_FileScan_RBAC(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"/code/role.yaml": `apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: secret-reader
+rules:
+- apiGroups: [""]
+  resources: ["pods"]
+  verbs: ["get", "list"]
+- apiGroups: [""]
+  resources: ["secrets"]
+  verbs: ["get"]
+`,
+		"/code/binding.yaml": `apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: read-secrets
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: secret-reader
+subjects:
+- kind: User
+  name: jane
+- kind: ServiceAccount
+  name: default
+  namespace: apps
+`,
+	})
+
+	results, err := NewScanner(
+		options.ScannerWithPolicyFilesystem(os.DirFS("../../../internal/rules")),
+		options.ScannerWithPolicyDirs("defsec/lib", "kubernetes/lib", "kubernetes/policies/rbac"),
+	).ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+
+	failed := results.GetFailed()
+	require.Len(t, failed, 2)
+
+	locations := make(map[string][]string)
+	for _, result := range failed {
+		location := []string{fmt.Sprintf("%s:%d", result.Range().GetFilename(), result.Range().GetStartLine())}
+		for _, related := range result.Related() {
+			location = append(location, fmt.Sprintf("%s %s:%d", related.Resource, related.Range.GetFilename(), related.Range.GetStartLine()))
+		}
+		locations[result.Rule().AVDID] = location
+	}
+	assert.Equal(t, map[string][]string{
+		"AVD-KSV-0043": {"code/role.yaml:9", "ClusterRoleBinding/read-secrets code/binding.yaml:1"},
+		"AVD-KSV-0044": {"code/binding.yaml:12", "ClusterRole/secret-reader code/role.yaml:1"},
+	}, locations)
+}
+
+func Test_FileScan_CrossManifest(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"/code/web.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: apps
+spec:
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - name: web
+        image: nginx
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+  namespace: apps
+spec:
+  type: LoadBalancer
+  selector:
+    app: web
+  ports:
+  - port: 80
+`,
+		"/code/policy.yaml": `apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: default-deny
+spec:
+  podSelector: {}
+`,
+		"/code/ingress.yaml": `apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: web
+  namespace: apps
+spec:
+  rules:
+  - host: web.example.com
+    http:
+      paths:
+      - path: /
+        pathType: Prefix
+        backend:
+          service:
+            name: web
+            port:
+              number: 80
+`,
+	})
+
+	results, err := NewScanner(
+		options.ScannerWithPolicyFilesystem(os.DirFS("../../../internal/rules")),
+		options.ScannerWithPolicyDirs("defsec/lib", "kubernetes/lib", "kubernetes/policies/network"),
+	).ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+
+	failed := results.GetFailed()
+	require.Len(t, failed, 2)
+
+	locations := make(map[string][]string)
+	for _, result := range failed {
+		location := []string{fmt.Sprintf("%s:%d", result.Range().GetFilename(), result.Range().GetStartLine())}
+		for _, related := range result.Related() {
+			location = append(location, fmt.Sprintf("%s %s:%d", related.Resource, related.Range.GetFilename(), related.Range.GetStartLine()))
+		}
+		locations[result.Rule().AVDID] = location
+	}
+	assert.Equal(t, map[string][]string{
+		"AVD-KSV-0045": {"code/web.yaml:1", "Service/web code/web.yaml:19"},
+		"AVD-KSV-0046": {"code/ingress.yaml:11", "Service/web code/web.yaml:19"},
+	}, locations)
+}
+
+func Test_FileScan_PodSecurityAdmission(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"/code/namespace.yaml": `apiVersion: v1
+kind: Namespace
+metadata:
+  name: apps
+  labels:
+    pod-security.kubernetes.io/enforce: baseline
+`,
+		"/code/pods.yaml": `apiVersion: v1
+kind: Pod
+metadata:
+  name: privileged
+  namespace: apps
+spec:
+  hostNetwork: true
+  containers:
+  - name: app
+    image: app
+    securityContext:
+      privileged: true
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: unlabelled
+spec:
+  hostNetwork: true
+  containers:
+  - name: app
+    image: app
+`,
+	})
+
+	results, err := NewScanner(
+		options.ScannerWithPolicyFilesystem(os.DirFS("../../../internal/rules")),
+		options.ScannerWithPolicyDirs("defsec/lib", "kubernetes/lib", "kubernetes/policies/pss"),
+	).ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+
+	var admission scan.Results
+	for _, result := range results.GetFailed() {
+		if result.Rule().AVDID == "AVD-KSV-0047" {
+			admission = append(admission, result)
+		}
+	}
+	require.Len(t, admission, 1)
+	assert.Equal(t, "Pod 'privileged' satisfies the 'privileged' Pod Security Standard, but namespace 'apps' enforces 'baseline' (failed checks: KSV009, KSV017)", admission[0].Description())
+	assert.Equal(t, "code/pods.yaml", admission[0].Range().GetFilename())
+	assert.Equal(t, 1, admission[0].Range().GetStartLine())
+
+	assert.Empty(t, admission[0].Provenance())
+	related := admission[0].Related()
+	require.Len(t, related, 1)
+	assert.Equal(t, "Namespace/apps", related[0].Resource)
+	assert.Equal(t, "code/namespace.yaml", related[0].Range.GetFilename())
+	assert.Equal(t, []scan.FlatRelated{
+		{
+			Resource: "Namespace/apps",
+			Location: &scan.FlatRange{Filename: "code/namespace.yaml", StartLine: 1, EndLine: 6},
+		},
+	}, admission[0].Flatten().Related)
+}
@@ -38,6 +38,8 @@ type Executor struct {
 	regoScanner               *rego.Scanner
 	regoOnly                  bool
 	stateFuncs                []func(*state.State)
+	trackUnresolved           bool
+	requireIgnoreReason       bool
 }
 
 type Metrics struct {
@@ -46,13 +48,20 @@ type Metrics struct {
 		RunningChecks time.Duration
 	}
 	Counts struct {
-		Ignored  int
-		Failed   int
-		Passed   int
-		Critical int
-		High     int
-		Medium   int
-		Low      int
+		Ignored    int
+		Failed     int
+		Passed     int
+		Unresolved int
+		Critical   int
+		High       int
+		Medium     int
+		Low        int
+	}
+	Ignores struct {
+		// Unused ignores did not suppress any failed result, so are likely to be stale
+		Unused terraform.Ignores
+		// Rejected ignores were not applied because they did not give a reason, and a reason is required
+		Rejected terraform.Ignores
 	}
 }
 
@@ -127,12 +136,18 @@ func (e *Executor) Execute(modules terraform.Modules) (scan.Results, Metrics, er
 	metrics.Timings.RunningChecks = time.Since(checksTime)
 	e.debug("Finished applying rules.")
 
+	if e.trackUnresolved {
+		results.MarkUnresolved()
+	}
+
 	if e.enableIgnores {
 		var ignores terraform.Ignores
 		for _, module := range modules {
 			ignores = append(ignores, module.Ignores()...)
 		}
+		ignores, metrics.Ignores.Rejected = e.rejectIgnores(ignores)
 
+		used := make(map[string]bool)
 		for i, result := range results {
 			allIDs := []string{
 				result.Rule().LongID(),
@@ -141,16 +156,27 @@ func (e *Executor) Execute(modules terraform.Modules) (scan.Results, Metrics, er
 			if e.alternativeIDProviderFunc != nil {
 				allIDs = append(allIDs, e.alternativeIDProviderFunc(result.Rule().LongID())...)
 			}
-			if ignores.Covering(
-				modules,
-				result.Metadata(),
-				e.workspaceName,
-				allIDs...,
-			) != nil {
+			var covered bool
+			for _, ignore := range ignores {
+				if !ignore.Covering(
+					modules,
+					result.Metadata(),
+					e.workspaceName,
+					allIDs...,
+				) {
+					continue
+				}
+				covered = true
+				if result.Status() == scan.StatusFailed {
+					used[ignoreKey(ignore)] = true
+				}
+			}
+			if covered {
 				e.debug("Ignored '%s' at '%s'.", result.Rule().LongID(), result.Range())
 				results[i].OverrideStatus(scan.StatusIgnored)
 			}
 		}
+		metrics.Ignores.Unused = e.unusedIgnores(ignores, used)
 	}
 
 	results = e.updateSeverity(results)
@@ -158,6 +184,7 @@ func (e *Executor) Execute(modules terraform.Modules) (scan.Results, Metrics, er
 	metrics.Counts.Ignored = len(results.GetIgnored())
 	metrics.Counts.Passed = len(results.GetPassed())
 	metrics.Counts.Failed = len(results.GetFailed())
+	metrics.Counts.Unresolved = len(results.GetUnresolved())
 
 	for _, res := range results.GetFailed() {
 		switch res.Severity() {
@@ -176,6 +203,49 @@ func (e *Executor) Execute(modules terraform.Modules) (scan.Results, Metrics, er
 	return results, metrics, nil
 }
 
+// rejectIgnores removes ignores which do not give a reason when one is required. Modules which are used more than
+// once produce a copy of each ignore per use, so rejected ignores are deduplicated.
+func (e *Executor) rejectIgnores(ignores terraform.Ignores) (accepted terraform.Ignores, rejected terraform.Ignores) {
+	if !e.requireIgnoreReason {
+		return ignores, nil
+	}
+	seen := make(map[string]bool)
+	for _, ignore := range ignores {
+		if ignore.Reason != "" {
+			accepted = append(accepted, ignore)
+			continue
+		}
+		if key := ignoreKey(ignore); !seen[key] {
+			seen[key] = true
+			e.debug("Rejected ignore for '%s' at '%s' as it does not give a reason.", ignore.RuleID, ignore.Range)
+			rejected = append(rejected, ignore)
+		}
+	}
+	return accepted, rejected
+}
+
+func (e *Executor) unusedIgnores(ignores terraform.Ignores, used map[string]bool) terraform.Ignores {
+	var unused terraform.Ignores
+	reported := make(map[string]bool)
+	for _, ignore := range ignores {
+		if !ignore.IsActive(e.workspaceName) {
+			continue
+		}
+		key := ignoreKey(ignore)
+		if used[key] || reported[key] {
+			continue
+		}
+		reported[key] = true
+		e.debug("Ignore for '%s' at '%s' did not match any failed result.", ignore.RuleID, ignore.Range)
+		unused = append(unused, ignore)
+	}
+	return unused
+}
+
+func ignoreKey(ignore terraform.Ignore) string {
+	return fmt.Sprintf("%s:%s", ignore.Range, ignore.RuleID)
+}
+
 func (e *Executor) updateSeverity(results []scan.Result) scan.Results {
 	if len(e.severityOverrides) == 0 {
 		return results
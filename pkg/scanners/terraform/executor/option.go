@@ -89,3 +89,18 @@ func OptionWithRegoOnly(regoOnly bool) Option {
 		e.regoOnly = regoOnly
 	}
 }
+
+// OptionWithUnresolvedTracking flags results reported against values that could not be resolved, e.g. a pass for an
+// attribute which was unknown.
+func OptionWithUnresolvedTracking(enabled bool) Option {
+	return func(e *Executor) {
+		e.trackUnresolved = enabled
+	}
+}
+
+// OptionWithIgnoreReasonRequired rejects ignores which do not give a reason, so the results they cover are reported
+func OptionWithIgnoreReasonRequired(required bool) Option {
+	return func(e *Executor) {
+		e.requireIgnoreReason = required
+	}
+}
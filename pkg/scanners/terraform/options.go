@@ -7,6 +7,7 @@ import (
 	"github.com/aquasecurity/defsec/pkg/scanners/options"
 	"github.com/aquasecurity/defsec/pkg/scanners/terraform/executor"
 	"github.com/aquasecurity/defsec/pkg/scanners/terraform/parser"
+	"github.com/aquasecurity/defsec/pkg/scanners/terraform/parser/resolvers"
 	"github.com/aquasecurity/defsec/pkg/severity"
 	"github.com/aquasecurity/defsec/pkg/state"
 )
@@ -27,6 +28,14 @@ func ScannerWithTFVarsPaths(paths ...string) options.ScannerOption {
 	}
 }
 
+func ScannerWithInputVars(vars map[string]string) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if tf, ok := s.(ConfigurableTerraformScanner); ok {
+			tf.AddParserOptions(parser.OptionWithInputVars(vars))
+		}
+	}
+}
+
 func ScannerWithAlternativeIDProvider(f func(string) []string) options.ScannerOption {
 	return func(s options.ConfigurableScanner) {
 		if tf, ok := s.(ConfigurableTerraformScanner); ok {
@@ -51,6 +60,16 @@ func ScannerWithNoIgnores() options.ScannerOption {
 	}
 }
 
+// ScannerWithIgnoreReasonRequired only honours ignores which are followed by a reason, e.g.
+// "tfsec:ignore:aws-s3-enable-bucket-logging -- access logs are collected centrally"
+func ScannerWithIgnoreReasonRequired(required bool) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if tf, ok := s.(ConfigurableTerraformScanner); ok {
+			tf.AddExecutorOptions(executor.OptionWithIgnoreReasonRequired(required))
+		}
+	}
+}
+
 func ScannerWithExcludedRules(ruleIDs []string) options.ScannerOption {
 	return func(s options.ConfigurableScanner) {
 		if tf, ok := s.(ConfigurableTerraformScanner); ok {
@@ -188,6 +207,24 @@ func ScannerWithDownloadsAllowed(allowed bool) options.ScannerOption {
 	}
 }
 
+func ScannerWithModuleSourceMap(sourceMap *resolvers.SourceMap) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if tf, ok := s.(ConfigurableTerraformScanner); ok {
+			tf.AddParserOptions(parser.OptionWithModuleSourceMap(sourceMap))
+		}
+	}
+}
+
+// ScannerWithUnresolvedReporting flags results reported against values which could not be resolved, such as
+// unknown module outputs or data sources, so they can be reported separately from real passes and failures.
+func ScannerWithUnresolvedReporting(enabled bool) options.ScannerOption {
+	return func(s options.ConfigurableScanner) {
+		if tf, ok := s.(ConfigurableTerraformScanner); ok {
+			tf.AddExecutorOptions(executor.OptionWithUnresolvedTracking(enabled))
+		}
+	}
+}
+
 func ScannerWithRegoOnly(regoOnly bool) options.ScannerOption {
 	return func(s options.ConfigurableScanner) {
 		if tf, ok := s.(ConfigurableTerraformScanner); ok {
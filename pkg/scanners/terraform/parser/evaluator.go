@@ -11,10 +11,12 @@ import (
 	"github.com/aquasecurity/defsec/internal/types"
 
 	tfcontext "github.com/aquasecurity/defsec/pkg/scanners/terraform/context"
+	"github.com/aquasecurity/defsec/pkg/scanners/terraform/parser/funcs"
 	"github.com/aquasecurity/defsec/pkg/terraform"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
 	"github.com/zclconf/go-cty/cty/gocty"
 )
 
@@ -27,6 +29,7 @@ type evaluator struct {
 	ctx             *tfcontext.Context
 	blocks          terraform.Blocks
 	inputVars       map[string]cty.Value
+	inputSources    map[string][]types.ProvenanceStep
 	moduleMetadata  *modulesMetadata
 	projectRootPath string // root of the current scan
 	modulePath      string
@@ -35,6 +38,14 @@ type evaluator struct {
 	parentParser    *Parser
 	debugWriter     io.Writer
 	allowDownloads  bool
+	variableTypes   map[string]*variableType
+	diagnostics     hcl.Diagnostics
+}
+
+type variableType struct {
+	constraint cty.Type
+	defaults   *typeDefaults
+	diags      hcl.Diagnostics
 }
 
 func newEvaluator(
@@ -46,16 +57,18 @@ func newEvaluator(
 	moduleName string,
 	blocks terraform.Blocks,
 	inputVars map[string]cty.Value,
+	inputSources map[string][]types.ProvenanceStep,
 	moduleMetadata *modulesMetadata,
 	workspace string,
 	ignores []terraform.Ignore,
 	debugWriter io.Writer,
 	allowDownloads bool,
+	planTimestamp time.Time,
 ) *evaluator {
 
 	// create a context to store variables and make functions available
 	ctx := tfcontext.NewContext(&hcl.EvalContext{
-		Functions: Functions(target, modulePath),
+		Functions: functions(target, modulePath, planTimestamp),
 	}, nil)
 
 	// these variables are made available by terraform to each module
@@ -78,10 +91,12 @@ func newEvaluator(
 		ctx:             ctx,
 		blocks:          blocks,
 		inputVars:       inputVars,
+		inputSources:    inputSources,
 		moduleMetadata:  moduleMetadata,
 		ignores:         ignores,
 		debugWriter:     debugWriter,
 		allowDownloads:  allowDownloads,
+		variableTypes:   make(map[string]*variableType),
 	}
 }
 
@@ -106,6 +121,40 @@ func (e *evaluator) evaluateStep() {
 
 	e.ctx.Set(e.getValuesByBlockType("data"), "data")
 	e.ctx.Set(e.getValuesByBlockType("output"), "output")
+
+	e.evaluateCheckScopes()
+}
+
+// evaluateCheckScopes makes the data sources nested in each check block visible within that block only, matching
+// terraform's scoping rules for check blocks
+func (e *evaluator) evaluateCheckScopes() {
+	global := e.ctx.Get("data")
+	for _, check := range e.blocks.OfType("check") {
+		scoped := e.getValuesByBlocks(check.AllBlocks().OfType("data"))
+		check.Context().Inner().Variables["data"] = mergeDataSources(global, scoped)
+	}
+}
+
+func mergeDataSources(global cty.Value, scoped cty.Value) cty.Value {
+	merged := make(map[string]cty.Value)
+	if global != cty.NilVal && global.Type().IsObjectType() {
+		for typeName, sources := range global.AsValueMap() {
+			merged[typeName] = sources
+		}
+	}
+	for typeName, sources := range scoped.AsValueMap() {
+		combined := make(map[string]cty.Value)
+		if existing, ok := merged[typeName]; ok && existing.Type().IsObjectType() {
+			for name, val := range existing.AsValueMap() {
+				combined[name] = val
+			}
+		}
+		for name, val := range sources.AsValueMap() {
+			combined[name] = val
+		}
+		merged[typeName] = cty.ObjectVal(combined)
+	}
+	return cty.ObjectVal(merged)
 }
 
 // exportOutputs is used to export module outputs to the parent module
@@ -187,8 +236,13 @@ func (e *evaluator) EvaluateAll(ctx context.Context) (terraform.Modules, map[str
 		}
 	}
 
+	e.validateVariables()
+	e.recordProvenance()
+
 	parseDuration += time.Since(start)
-	return append([]*terraform.Module{terraform.NewModule(e.projectRootPath, e.modulePath, e.blocks, e.ignores)}, modules...), fsMap, parseDuration
+	module := terraform.NewModule(e.projectRootPath, e.modulePath, e.blocks, e.ignores)
+	module.SetDebugWriter(e.debugWriter)
+	return append([]*terraform.Module{module}, modules...), fsMap, parseDuration
 }
 
 func (e *evaluator) expandBlocks(blocks terraform.Blocks) terraform.Blocks {
@@ -225,7 +279,7 @@ func (e *evaluator) expandBlockForEaches(blocks terraform.Blocks) terraform.Bloc
 
 		forEachAttr := block.GetAttribute("for_each")
 
-		if forEachAttr.IsNil() || block.IsCountExpanded() || (block.Type() != "resource" && block.Type() != "module" && block.Type() != "dynamic") {
+		if forEachAttr.IsNil() || block.IsCountExpanded() || (block.Type() != "resource" && block.Type() != "module" && block.Type() != "dynamic" && block.Type() != "import") {
 			forEachFiltered = append(forEachFiltered, block)
 			continue
 		}
@@ -255,10 +309,18 @@ func (e *evaluator) expandBlockForEaches(blocks terraform.Blocks) terraform.Bloc
 				forEachFiltered = append(forEachFiltered, clone)
 
 				clones = append(clones, clone.Values())
+				if block.Type() == "import" {
+					// import blocks cannot be referenced, so there are no values to expose
+					return
+				}
 				metadata := clone.GetMetadata()
 				e.ctx.SetByDot(clone.Values(), metadata.Reference().String())
 			})
 			metadata := block.GetMetadata()
+			if block.Type() == "import" {
+				e.debug("Expanded block '%s' into %d clones via 'for_each' attribute.", block.LocalName(), len(clones))
+				continue
+			}
 			if len(clones) == 0 {
 				e.ctx.SetByDot(cty.EmptyTupleVal, metadata.Reference().String())
 			} else {
@@ -340,18 +402,112 @@ func (e *evaluator) evaluateVariable(b *terraform.Block) (cty.Value, error) {
 		return cty.NilVal, fmt.Errorf("empty label - cannot resolve")
 	}
 	if override, exists := e.inputVars[b.Label()]; exists {
-		return override, nil
+		return e.markSensitive(b, e.coerceVariable(b, override)), nil
 	}
 	attributes := b.Attributes()
 	if attributes == nil {
 		return cty.NilVal, fmt.Errorf("cannot resolve variable with no attributes")
 	}
 	if def, exists := attributes["default"]; exists {
-		return def.Value(), nil
+		return e.markSensitive(b, e.coerceVariable(b, def.Value())), nil
 	}
 	return cty.NilVal, fmt.Errorf("no value found")
 }
 
+func (e *evaluator) markSensitive(b *terraform.Block, val cty.Value) cty.Value {
+	if val == cty.NilVal || !b.GetAttribute("sensitive").IsTrue() {
+		return val
+	}
+	return val.Mark(funcs.MarkedSensitive)
+}
+
+// getVariableType parses (and caches) the type constraint of a variable block, if it has one
+func (e *evaluator) getVariableType(b *terraform.Block) *variableType {
+	if vt, ok := e.variableTypes[b.ID()]; ok {
+		return vt
+	}
+	vt := &variableType{
+		constraint: cty.DynamicPseudoType,
+	}
+	if typeAttr := b.GetAttribute("type"); typeAttr.IsNotNil() {
+		vt.constraint, vt.defaults, vt.diags = typeConstraintWithDefaults(typeAttr.HCLAttribute().Expr)
+	}
+	e.variableTypes[b.ID()] = vt
+	return vt
+}
+
+// coerceVariable applies optional attribute defaults and converts the value to the declared type of the variable,
+// returning the value unmodified if it does not conform - this is reported by validateVariables instead.
+func (e *evaluator) coerceVariable(b *terraform.Block, val cty.Value) cty.Value {
+	if val == cty.NilVal || val.IsNull() {
+		return val
+	}
+	vt := e.getVariableType(b)
+	if vt.diags.HasErrors() {
+		return val
+	}
+	converted, err := convert.Convert(vt.defaults.apply(val), vt.constraint)
+	if err != nil {
+		return val
+	}
+	return converted
+}
+
+// validateVariables checks the final value of each variable against its type constraint and validation blocks
+func (e *evaluator) validateVariables() {
+	for _, b := range e.blocks.OfType("variable") {
+		if b.Label() == "" {
+			continue
+		}
+		vt := e.getVariableType(b)
+		if vt.diags.HasErrors() {
+			e.addDiagnostics(vt.diags...)
+			continue
+		}
+		val, err := e.evaluateVariable(b)
+		if err != nil || val == cty.NilVal {
+			continue
+		}
+		val, _ = val.UnmarkDeep()
+		if _, err := convert.Convert(vt.defaults.apply(val), vt.constraint); err != nil {
+			e.addDiagnostics(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid value for input variable",
+				Detail:   fmt.Sprintf("The value for variable %q is not compatible with its type constraint: %s.", b.Label(), err),
+				Subject:  b.GetAttribute("type").HCLAttribute().Range.Ptr(),
+			})
+			continue
+		}
+		for _, validation := range b.GetBlocks("validation") {
+			condition := validation.GetAttribute("condition")
+			if condition.IsNil() {
+				continue
+			}
+			result := condition.Value()
+			if result == cty.NilVal || result.Type() != cty.Bool || result.True() {
+				continue
+			}
+			message := fmt.Sprintf("Validation failed for variable %q.", b.Label())
+			if errorMessage := validation.GetAttribute("error_message"); errorMessage.IsString() {
+				message = errorMessage.Value().AsString()
+			}
+			e.addDiagnostics(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid value for variable",
+				Detail:   message,
+				Subject:  condition.HCLAttribute().Range.Ptr(),
+			})
+		}
+	}
+}
+
+func (e *evaluator) addDiagnostics(diags ...*hcl.Diagnostic) {
+	for _, diag := range diags {
+		e.debug("%s", diag.Error())
+	}
+	e.diagnostics = append(e.diagnostics, diags...)
+}
+
 func (e *evaluator) evaluateOutput(b *terraform.Block) (cty.Value, error) {
 	if b.Label() == "" {
 		return cty.NilVal, fmt.Errorf("empty label - cannot resolve")
@@ -366,11 +522,14 @@ func (e *evaluator) evaluateOutput(b *terraform.Block) (cty.Value, error) {
 
 // returns true if all evaluations were successful
 func (e *evaluator) getValuesByBlockType(blockType string) cty.Value {
+	return e.getValuesByBlocks(e.blocks.OfType(blockType))
+}
+
+func (e *evaluator) getValuesByBlocks(blocks terraform.Blocks) cty.Value {
 
-	blocksOfType := e.blocks.OfType(blockType)
 	values := make(map[string]cty.Value)
 
-	for _, b := range blocksOfType {
+	for _, b := range blocks {
 
 		switch b.Type() {
 		case "variable": // variables are special in that their value comes from the "default" attribute
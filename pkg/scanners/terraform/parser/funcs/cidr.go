@@ -210,3 +210,68 @@ func CidrSubnets(prefix cty.Value, newbits ...cty.Value) (cty.Value, error) {
 	copy(args[1:], newbits)
 	return CidrSubnetsFunc.Call(args)
 }
+
+// CidrContainsFunc constructs a function that checks whether a given IP address
+// is within a given IP network address prefix.
+var CidrContainsFunc = function.New(&function.Spec{
+	Params: []function.Parameter{
+		{
+			Name: "containing_prefix",
+			Type: cty.String,
+		},
+		{
+			Name: "contained_ip_or_prefix",
+			Type: cty.String,
+		},
+	},
+	Type: function.StaticReturnType(cty.Bool),
+	Impl: func(args []cty.Value, retType cty.Type) (ret cty.Value, err error) {
+		prefix := args[0].AsString()
+		addr := args[1].AsString()
+
+		// The first argument must be a CIDR prefix.
+		_, containing, err := net.ParseCIDR(prefix)
+		if err != nil {
+			return cty.UnknownVal(cty.Bool), err
+		}
+
+		// The second argument can be either an IP address or a CIDR prefix.
+		// We will try parsing it as an IP address first.
+		startIP := net.ParseIP(addr)
+		var endIP net.IP
+
+		// If the second argument did not parse as an IP, we will try parsing it
+		// as a CIDR prefix.
+		if startIP == nil {
+			_, contained, err := net.ParseCIDR(addr)
+
+			// If that also fails, we'll return an error.
+			if err != nil {
+				return cty.UnknownVal(cty.Bool), fmt.Errorf("invalid IP address or prefix: %s", addr)
+			}
+
+			// Otherwise, we will want to know the start and the end IP of the
+			// prefix, so that we can check whether both are contained in the
+			// containing prefix.
+			startIP, endIP = cidr.AddressRange(contained)
+		}
+
+		// We require that both addresses are of the same type, to avoid
+		// confusion caused by the IPv4-mapped IPv6 addresses.
+		if (containing.IP.To4() == nil) != (startIP.To4() == nil) {
+			return cty.UnknownVal(cty.Bool), fmt.Errorf("address family mismatch: %s vs. %s", prefix, addr)
+		}
+
+		result := containing.Contains(startIP)
+		if endIP != nil {
+			result = result && containing.Contains(endIP)
+		}
+
+		return cty.BoolVal(result), nil
+	},
+})
+
+// CidrContains checks whether a given IP address or prefix is within a given IP network address prefix.
+func CidrContains(prefix, addr cty.Value) (cty.Value, error) {
+	return CidrContainsFunc.Call([]cty.Value{prefix, addr})
+}
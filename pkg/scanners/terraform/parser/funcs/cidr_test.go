@@ -0,0 +1,37 @@
+package funcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestCidrContains(t *testing.T) {
+	tests := []struct {
+		prefix  string
+		addr    string
+		want    cty.Value
+		wantErr bool
+	}{
+		{"192.168.2.0/20", "192.168.2.1", cty.True, false},
+		{"192.168.2.0/20", "192.168.2.0/24", cty.True, false},
+		{"192.168.2.0/20", "192.126.2.1", cty.False, false},
+		{"192.168.2.0/20", "192.168.0.0/16", cty.False, false},
+		{"fe80::/48", "fe80::1", cty.True, false},
+		{"fe80::/48", "fe80:1::/64", cty.False, false},
+		{"192.168.2.0/20", "fe80::1", cty.UnknownVal(cty.Bool), true},
+		{"not-a-cidr", "192.168.2.1", cty.UnknownVal(cty.Bool), true},
+		{"192.168.2.0/20", "not-an-ip", cty.UnknownVal(cty.Bool), true},
+	}
+	for _, test := range tests {
+		got, err := CidrContains(cty.StringVal(test.prefix), cty.StringVal(test.addr))
+		if test.wantErr {
+			assert.Error(t, err, "cidrcontains(%q, %q)", test.prefix, test.addr)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, test.want, got, "cidrcontains(%q, %q)", test.prefix, test.addr)
+	}
+}
@@ -0,0 +1,41 @@
+package funcs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestTimeCmp(t *testing.T) {
+	tests := []struct {
+		a, b    string
+		want    cty.Value
+		wantErr bool
+	}{
+		{"2017-11-22T00:00:00Z", "2017-11-22T00:00:00Z", cty.NumberIntVal(0), false},
+		{"2017-11-22T00:00:00Z", "2017-11-22T01:00:00+01:00", cty.NumberIntVal(0), false},
+		{"2017-11-22T00:00:01Z", "2017-11-22T01:00:00+01:00", cty.NumberIntVal(1), false},
+		{"2017-11-22T01:00:00Z", "2017-11-22T00:59:00-01:00", cty.NumberIntVal(-1), false},
+		{"2017-11-22 00:00:00Z", "2017-11-22T00:00:00Z", cty.UnknownVal(cty.Number), true},
+		{"2017-11-22T00:00:00Z", "bloop", cty.UnknownVal(cty.Number), true},
+	}
+	for _, test := range tests {
+		got, err := TimeCmp(cty.StringVal(test.a), cty.StringVal(test.b))
+		if test.wantErr {
+			assert.Error(t, err, "timecmp(%q, %q)", test.a, test.b)
+			continue
+		}
+		require.NoError(t, err)
+		assert.True(t, test.want.RawEquals(got), "timecmp(%q, %q) = %#v", test.a, test.b, got)
+	}
+}
+
+func TestStaticTimestamp(t *testing.T) {
+	static := time.Date(2022, 6, 1, 12, 30, 0, 0, time.FixedZone("", 3600))
+	got, err := MakeStaticTimestampFunc(static).Call(nil)
+	require.NoError(t, err)
+	assert.Equal(t, cty.StringVal("2022-06-01T11:30:00Z"), got)
+}
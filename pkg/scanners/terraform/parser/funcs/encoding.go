@@ -6,6 +6,7 @@ import (
 	"compress/gzip"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"log"
 	"net/url"
 	"unicode/utf8"
@@ -252,3 +253,37 @@ func TextEncodeBase64(str, enc cty.Value) (cty.Value, error) {
 func TextDecodeBase64(str, enc cty.Value) (cty.Value, error) {
 	return TextDecodeBase64Func.Call([]cty.Value{str, enc})
 }
+
+// Base64GunzipFunc constructs a function that Base64 decodes a string and decompresses the result with gunzip.
+var Base64GunzipFunc = function.New(&function.Spec{
+	Params: []function.Parameter{
+		{
+			Name: "str",
+			Type: cty.String,
+		},
+	},
+	Type: function.StaticReturnType(cty.String),
+	Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+		s := args[0].AsString()
+		sDec, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return cty.UnknownVal(cty.String), fmt.Errorf("failed to decode base64 data '%s'", s)
+		}
+		gzipReader, err := gzip.NewReader(bytes.NewReader(sDec))
+		if err != nil {
+			return cty.UnknownVal(cty.String), fmt.Errorf("failed to gunzip bytestream from base64 data '%s'", s)
+		}
+		gunzip, err := io.ReadAll(gzipReader)
+		if err != nil {
+			return cty.UnknownVal(cty.String), fmt.Errorf("failed to read gunzip raw data from base64 data '%s'", s)
+		}
+		return cty.StringVal(string(gunzip)), nil
+	},
+})
+
+// Base64Gunzip decodes a Base64-encoded string and uncompresses the result with gzip.
+//
+// Terraform uses the "standard" Base64 alphabet as defined in RFC 4648 section 4.
+func Base64Gunzip(str cty.Value) (cty.Value, error) {
+	return Base64GunzipFunc.Call([]cty.Value{str})
+}
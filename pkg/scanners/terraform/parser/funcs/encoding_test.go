@@ -0,0 +1,47 @@
+package funcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestBase64Gunzip(t *testing.T) {
+	tests := []struct {
+		str     string
+		want    cty.Value
+		wantErr bool
+	}{
+		{"H4sIAAAAAAACAytJLS4BAAx+f9gEAAAA", cty.StringVal("test"), false},
+		{"hello", cty.UnknownVal(cty.String), true},
+		{"dGVzdA==", cty.UnknownVal(cty.String), true},
+	}
+	for _, test := range tests {
+		got, err := Base64Gunzip(cty.StringVal(test.str))
+		if test.wantErr {
+			assert.Error(t, err, "base64gunzip(%q)", test.str)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, test.want, got)
+	}
+}
+
+func TestBase64GzipRoundTrip(t *testing.T) {
+	zipped, err := Base64Gzip(cty.StringVal("some text to compress"))
+	require.NoError(t, err)
+	got, err := Base64Gunzip(zipped)
+	require.NoError(t, err)
+	assert.Equal(t, cty.StringVal("some text to compress"), got)
+}
+
+func TestTextBase64RoundTrip(t *testing.T) {
+	encoded, err := TextEncodeBase64(cty.StringVal("abc123!?$*&()'-=@~"), cty.StringVal("UTF-16LE"))
+	require.NoError(t, err)
+	assert.Equal(t, cty.StringVal("YQBiAGMAMQAyADMAIQA/ACQAKgAmACgAKQAnAC0APQBAAH4A"), encoded)
+	decoded, err := TextDecodeBase64(encoded, cty.StringVal("UTF-16LE"))
+	require.NoError(t, err)
+	assert.Equal(t, cty.StringVal("abc123!?$*&()'-=@~"), decoded)
+}
@@ -360,6 +360,7 @@ func openFile(target fs.FS, baseDir, path string) (fs.File, error) {
 		return nil, fmt.Errorf("failed to expand ~: %s", err)
 	}
 
+	original := path
 	if !filepath.IsAbs(path) {
 		path = filepath.Join(baseDir, path)
 	}
@@ -368,7 +369,15 @@ func openFile(target fs.FS, baseDir, path string) (fs.File, error) {
 	path = filepath.Clean(path)
 
 	if target != nil {
-		return target.Open(path)
+		f, err := target.Open(filepath.ToSlash(path))
+		if err != nil && !filepath.IsAbs(original) {
+			// path.module and path.root are relative to the root of the target filesystem, so paths built from
+			// them (e.g. "${path.module}/file.txt") are already resolved and must not be joined to the base dir
+			if alt, altErr := target.Open(filepath.ToSlash(filepath.Clean(original))); altErr == nil {
+				return alt, nil
+			}
+		}
+		return f, err
 	}
 	return os.Open(path)
 }
@@ -0,0 +1,41 @@
+package funcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestSensitiveRoundTrip(t *testing.T) {
+	tests := []cty.Value{
+		cty.NumberIntVal(1),
+		cty.StringVal("hello"),
+		cty.ListVal([]cty.Value{cty.StringVal("a")}),
+		cty.UnknownVal(cty.String),
+		cty.NullVal(cty.String),
+	}
+	for _, input := range tests {
+		sensitive, err := Sensitive(input)
+		require.NoError(t, err)
+		assert.True(t, sensitive.HasMark(MarkedSensitive))
+
+		isSensitive, err := IsSensitive(sensitive)
+		require.NoError(t, err)
+		assert.Equal(t, cty.True, isSensitive)
+
+		unmarked, err := Nonsensitive(sensitive)
+		require.NoError(t, err)
+		assert.False(t, unmarked.HasMark(MarkedSensitive))
+		assert.True(t, input.RawEquals(unmarked))
+
+		isSensitive, err = IsSensitive(unmarked)
+		require.NoError(t, err)
+		assert.Equal(t, cty.False, isSensitive)
+
+		// calling nonsensitive on a value which isn't sensitive is permitted
+		_, err = Nonsensitive(input)
+		require.NoError(t, err)
+	}
+}
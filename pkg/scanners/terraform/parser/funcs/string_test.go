@@ -0,0 +1,63 @@
+package funcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestStartsWith(t *testing.T) {
+	tests := []struct {
+		str, prefix string
+		want        cty.Value
+	}{
+		{"hello world", "hello", cty.True},
+		{"hey world", "hello", cty.False},
+		{"", "", cty.True},
+		{"a", "", cty.True},
+		{"", "a", cty.False},
+	}
+	for _, test := range tests {
+		got, err := StartsWith(cty.StringVal(test.str), cty.StringVal(test.prefix))
+		require.NoError(t, err)
+		assert.Equal(t, test.want, got, "startswith(%q, %q)", test.str, test.prefix)
+	}
+}
+
+func TestEndsWith(t *testing.T) {
+	tests := []struct {
+		str, suffix string
+		want        cty.Value
+	}{
+		{"hello world", "world", cty.True},
+		{"hello world", "hello", cty.False},
+		{"", "", cty.True},
+		{"a", "", cty.True},
+		{"", "a", cty.False},
+	}
+	for _, test := range tests {
+		got, err := EndsWith(cty.StringVal(test.str), cty.StringVal(test.suffix))
+		require.NoError(t, err)
+		assert.Equal(t, test.want, got, "endswith(%q, %q)", test.str, test.suffix)
+	}
+}
+
+func TestStrContains(t *testing.T) {
+	tests := []struct {
+		str, substr string
+		want        cty.Value
+	}{
+		{"hello", "hel", cty.True},
+		{"hello", "lo", cty.True},
+		{"hello1", "1", cty.True},
+		{"hello1", "heo", cty.False},
+		{"hello1", "", cty.True},
+	}
+	for _, test := range tests {
+		got, err := StrContains(cty.StringVal(test.str), cty.StringVal(test.substr))
+		require.NoError(t, err)
+		assert.Equal(t, test.want, got, "strcontains(%q, %q)", test.str, test.substr)
+	}
+}
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"io/fs"
+	"time"
 
 	"github.com/aquasecurity/defsec/pkg/scanners/terraform/parser/funcs"
 	"github.com/hashicorp/hcl/v2/ext/tryfunc"
@@ -14,12 +15,21 @@ import (
 // Functions returns the set of functions that should be used to when evaluating
 // expressions in the receiving scope.
 func Functions(target fs.FS, baseDir string) map[string]function.Function {
-	return map[string]function.Function{
+	return functions(target, baseDir, time.Now())
+}
+
+// functions returns the functions for a scope, where plantimestamp returns planTimestamp so that every module
+// evaluated in the same plan sees the same value
+func functions(target fs.FS, baseDir string, planTimestamp time.Time) map[string]function.Function {
+	functions := map[string]function.Function{
 		"abs":              stdlib.AbsoluteFunc,
 		"abspath":          funcs.AbsPathFunc,
+		"alltrue":          funcs.AllTrueFunc,
+		"anytrue":          funcs.AnyTrueFunc,
 		"basename":         funcs.BasenameFunc,
 		"base64decode":     funcs.Base64DecodeFunc,
 		"base64encode":     funcs.Base64EncodeFunc,
+		"base64gunzip":     funcs.Base64GunzipFunc,
 		"base64gzip":       funcs.Base64GzipFunc,
 		"base64sha256":     funcs.Base64Sha256Func,
 		"base64sha512":     funcs.Base64Sha512Func,
@@ -27,6 +37,7 @@ func Functions(target fs.FS, baseDir string) map[string]function.Function {
 		"can":              tryfunc.CanFunc,
 		"ceil":             stdlib.CeilFunc,
 		"chomp":            stdlib.ChompFunc,
+		"cidrcontains":     funcs.CidrContainsFunc,
 		"cidrhost":         funcs.CidrHostFunc,
 		"cidrnetmask":      funcs.CidrNetmaskFunc,
 		"cidrsubnet":       funcs.CidrSubnetFunc,
@@ -41,6 +52,7 @@ func Functions(target fs.FS, baseDir string) map[string]function.Function {
 		"distinct":         stdlib.DistinctFunc,
 		"element":          stdlib.ElementFunc,
 		"chunklist":        stdlib.ChunklistFunc,
+		"endswith":         funcs.EndsWithFunc,
 		"file":             funcs.MakeFileFunc(target, baseDir, false),
 		"fileexists":       funcs.MakeFileExistsFunc(baseDir),
 		"fileset":          funcs.MakeFileSetFunc(baseDir),
@@ -58,6 +70,7 @@ func Functions(target fs.FS, baseDir string) map[string]function.Function {
 		"formatlist":       stdlib.FormatListFunc,
 		"indent":           stdlib.IndentFunc,
 		"index":            funcs.IndexFunc, // stdlib.IndexFunc is not compatible
+		"issensitive":      funcs.IsSensitiveFunc,
 		"join":             stdlib.JoinFunc,
 		"jsondecode":       stdlib.JSONDecodeFunc,
 		"jsonencode":       stdlib.JSONEncodeFunc,
@@ -73,8 +86,11 @@ func Functions(target fs.FS, baseDir string) map[string]function.Function {
 		"md5":              funcs.Md5Func,
 		"merge":            stdlib.MergeFunc,
 		"min":              stdlib.MinFunc,
+		"nonsensitive":     funcs.NonsensitiveFunc,
+		"one":              funcs.OneFunc,
 		"parseint":         stdlib.ParseIntFunc,
 		"pathexpand":       funcs.PathExpandFunc,
+		"plantimestamp":    funcs.MakeStaticTimestampFunc(planTimestamp),
 		"pow":              stdlib.PowFunc,
 		"range":            stdlib.RangeFunc,
 		"regex":            stdlib.RegexFunc,
@@ -82,6 +98,7 @@ func Functions(target fs.FS, baseDir string) map[string]function.Function {
 		"replace":          funcs.ReplaceFunc,
 		"reverse":          stdlib.ReverseListFunc,
 		"rsadecrypt":       funcs.RsaDecryptFunc,
+		"sensitive":        funcs.SensitiveFunc,
 		"setintersection":  stdlib.SetIntersectionFunc,
 		"setproduct":       stdlib.SetProductFunc,
 		"setsubtract":      stdlib.SetSubtractFunc,
@@ -93,8 +110,14 @@ func Functions(target fs.FS, baseDir string) map[string]function.Function {
 		"slice":            stdlib.SliceFunc,
 		"sort":             stdlib.SortFunc,
 		"split":            stdlib.SplitFunc,
+		"startswith":       funcs.StartsWithFunc,
+		"strcontains":      funcs.StrContainsFunc,
 		"strrev":           stdlib.ReverseFunc,
 		"substr":           stdlib.SubstrFunc,
+		"sum":              funcs.SumFunc,
+		"textdecodebase64": funcs.TextDecodeBase64Func,
+		"textencodebase64": funcs.TextEncodeBase64Func,
+		"timecmp":          funcs.TimeCmpFunc,
 		"timestamp":        funcs.TimestampFunc,
 		"timeadd":          stdlib.TimeAddFunc,
 		"title":            stdlib.TitleFunc,
@@ -110,6 +133,7 @@ func Functions(target fs.FS, baseDir string) map[string]function.Function {
 		"trimspace":        stdlib.TrimSpaceFunc,
 		"trimsuffix":       stdlib.TrimSuffixFunc,
 		"try":              tryfunc.TryFunc,
+		"type":             funcs.TypeFunc,
 		"upper":            stdlib.UpperFunc,
 		"urlencode":        funcs.URLEncodeFunc,
 		"uuid":             funcs.UUIDFunc,
@@ -120,4 +144,10 @@ func Functions(target fs.FS, baseDir string) map[string]function.Function {
 		"zipmap":           stdlib.ZipmapFunc,
 	}
 
+	// templatefile needs access to the other functions, so they're available when rendering the template
+	functions["templatefile"] = funcs.MakeTemplateFileFunc(target, baseDir, func() map[string]function.Function {
+		return functions
+	})
+
+	return functions
 }
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"fmt"
+	"io/fs"
 	"regexp"
 	"strings"
 	"time"
@@ -13,8 +14,8 @@ import (
 	"github.com/hashicorp/hcl/v2"
 )
 
-func loadBlocksFromFile(file sourceFile, moduleSource string) (hcl.Blocks, []terraform.Ignore, error) {
-	ignores := parseIgnores(file.file.Bytes, file.path, moduleSource)
+func loadBlocksFromFile(file sourceFile, moduleSource string, moduleFS fs.FS) (hcl.Blocks, []terraform.Ignore, error) {
+	ignores := parseIgnores(file.file.Bytes, file.path, moduleSource, moduleFS)
 	contents, diagnostics := file.file.Body.Content(terraform.Schema)
 	if diagnostics != nil && diagnostics.HasErrors() {
 		return nil, nil, diagnostics
@@ -25,13 +26,13 @@ func loadBlocksFromFile(file sourceFile, moduleSource string) (hcl.Blocks, []ter
 	return contents.Blocks, ignores, nil
 }
 
-func parseIgnores(data []byte, path string, moduleSource string) []terraform.Ignore {
+func parseIgnores(data []byte, path string, moduleSource string, moduleFS fs.FS) []terraform.Ignore {
 	var ignores []terraform.Ignore
 	for i, line := range strings.Split(string(data), "\n") {
 		line = strings.TrimSpace(line)
 		lineIgnores := parseIgnoresFromLine(line)
 		for _, lineIgnore := range lineIgnores {
-			lineIgnore.Range = types.NewRange(path, i+1, i+1, moduleSource, nil)
+			lineIgnore.Range = types.NewRange(path, i+1, i+1, moduleSource, moduleFS)
 			ignores = append(ignores, lineIgnore)
 		}
 	}
@@ -58,6 +59,7 @@ var commentPattern = regexp.MustCompile(`^\s*([/]+|/\*|#)\s*tfsec:`)
 func parseIgnoresFromLine(input string) []terraform.Ignore {
 
 	var ignores []terraform.Ignore
+	var reason []string
 	input = commentPattern.ReplaceAllString(input, "tfsec:")
 	bits := strings.Split(strings.TrimSpace(input), " ")
 	for i, bit := range bits {
@@ -67,19 +69,41 @@ func parseIgnoresFromLine(input string) []terraform.Ignore {
 		bit = strings.TrimPrefix(bit, "/*")
 
 		if strings.HasPrefix(bit, "tfsec:") {
-			ignore, err := parseIgnoreFromComment(bit)
+			parsed, err := parseIgnoreFromComment(bit)
 			if err != nil {
 				continue
 			}
-			ignore.Block = i == 0
-			ignores = append(ignores, *ignore)
+			for _, ignore := range parsed {
+				ignore.Block = i == 0 && !ignore.File
+				ignores = append(ignores, ignore)
+			}
+			continue
+		}
+
+		// anything following an ignore in the same comment is the reason for it
+		if len(ignores) > 0 && bit != "" {
+			reason = append(reason, bit)
+		}
+	}
+
+	if text := ignoreReason(reason); text != "" {
+		for i := range ignores {
+			ignores[i].Reason = text
 		}
 	}
 
 	return ignores
 }
 
-func parseIgnoreFromComment(input string) (*terraform.Ignore, error) {
+func ignoreReason(words []string) string {
+	// skip separators such as "--" between the ignore and its reason
+	for len(words) > 0 && strings.Trim(words[0], "-:/#*") == "" {
+		words = words[1:]
+	}
+	return strings.TrimSpace(strings.TrimSuffix(strings.Join(words, " "), "*/"))
+}
+
+func parseIgnoreFromComment(input string) ([]terraform.Ignore, error) {
 	var ignore terraform.Ignore
 	if !strings.HasPrefix(input, "tfsec:") {
 		return nil, fmt.Errorf("invalid ignore")
@@ -89,16 +113,18 @@ func parseIgnoreFromComment(input string) (*terraform.Ignore, error) {
 
 	segments := strings.Split(input, ":")
 
+	var ids []string
 	for i := 0; i < len(segments)-1; i += 2 {
 		key := segments[i]
 		val := segments[i+1]
 		switch key {
-		case "ignore":
-			ignore.RuleID, ignore.Params = parseIDWithParams(val)
+		case "ignore", "ignore-file":
+			ids = splitIgnoreIDs(val)
+			ignore.File = key == "ignore-file"
 		case "exp":
 			parsed, err := time.Parse("2006-01-02", val)
 			if err != nil {
-				return &ignore, err
+				return nil, err
 			}
 			ignore.Expiry = &parsed
 		case "ws":
@@ -106,7 +132,41 @@ func parseIgnoreFromComment(input string) (*terraform.Ignore, error) {
 		}
 	}
 
-	return &ignore, nil
+	var ignores []terraform.Ignore
+	for _, id := range ids {
+		idIgnore := ignore
+		idIgnore.RuleID, idIgnore.Params = parseIDWithParams(id)
+		ignores = append(ignores, idIgnore)
+	}
+	return ignores, nil
+}
+
+// splitIgnoreIDs splits a comma separated list of rule IDs, leaving commas between parameters intact
+func splitIgnoreIDs(input string) []string {
+	var ids []string
+	var depth, start int
+	for i, c := range input {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				ids = append(ids, input[start:i])
+				start = i + 1
+			}
+		}
+	}
+	ids = append(ids, input[start:])
+
+	var nonEmpty []string
+	for _, id := range ids {
+		if id = strings.TrimSpace(id); id != "" {
+			nonEmpty = append(nonEmpty, id)
+		}
+	}
+	return nonEmpty
 }
 
 func parseIDWithParams(input string) (string, map[string]string) {
@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseIgnoresFromLine(t *testing.T) {
+	type ignore struct {
+		id     string
+		file   bool
+		block  bool
+		reason string
+		params map[string]string
+	}
+	tests := []struct {
+		name     string
+		line     string
+		expected []ignore
+	}{
+		{
+			name:     "block ignore",
+			line:     "# tfsec:ignore:aws-s3-enable-bucket-logging",
+			expected: []ignore{{id: "aws-s3-enable-bucket-logging", block: true, params: map[string]string{}}},
+		},
+		{
+			name: "inline ignore with reason",
+			line: `acl = "public-read" // tfsec:ignore:aws-s3-no-public-access-with-acl -- serves the public website`,
+			expected: []ignore{
+				{id: "aws-s3-no-public-access-with-acl", reason: "serves the public website", params: map[string]string{}},
+			},
+		},
+		{
+			name: "multiple ids with params",
+			line: "# tfsec:ignore:aws-a,aws-b[acl=private,versioning=true] not applicable here",
+			expected: []ignore{
+				{id: "aws-a", block: true, reason: "not applicable here", params: map[string]string{}},
+				{id: "aws-b", block: true, reason: "not applicable here", params: map[string]string{"acl": "private", "versioning": "true"}},
+			},
+		},
+		{
+			name:     "file ignore",
+			line:     "/* tfsec:ignore-file:aws-a: generated code */",
+			expected: []ignore{{id: "aws-a", file: true, reason: "generated code", params: map[string]string{}}},
+		},
+		{
+			name: "invalid expiry",
+			line: "# tfsec:ignore:aws-a:exp:2020-13-01",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var actual []ignore
+			for _, parsed := range parseIgnoresFromLine(test.line) {
+				actual = append(actual, ignore{
+					id:     parsed.RuleID,
+					file:   parsed.File,
+					block:  parsed.Block,
+					reason: parsed.Reason,
+					params: parsed.Params,
+				})
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
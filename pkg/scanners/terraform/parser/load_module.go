@@ -66,6 +66,7 @@ func (e *evaluator) loadModules(ctx context.Context) []*ModuleDefinition {
 			e.debug("Failed to load module '%s'. Maybe try 'terraform init'?", err)
 			continue
 		}
+		moduleDefinition.Parser.inputSources = e.moduleInputSources(moduleBlock)
 		e.debug("Loaded module '%s' from '%s'.", moduleDefinition.Name, moduleDefinition.Path)
 		moduleDefinitions = append(moduleDefinitions, moduleDefinition)
 	}
@@ -158,12 +159,13 @@ func (e *evaluator) loadExternalModule(ctx context.Context, b *terraform.Block,
 		DebugWriter:     e.debugWriter,
 		AllowDownloads:  e.allowDownloads,
 		AllowCache:      e.allowDownloads,
+		SourceMap:       e.parentParser.sourceMap,
 	}
 	filesystem, prefix, path, err := resolveModule(ctx, e.filesystem, opt)
 	if err != nil {
 		return nil, err
 	}
-	prefix = filepath.Join(e.parentParser.moduleSource, prefix)
+	prefix = joinSourcePrefix(e.parentParser.moduleSource, prefix)
 	e.debug("Module '%s' resolved to path '%s' in filesystem '%s' with prefix '%s'", b.FullName(), path, filesystem, prefix)
 	moduleParser := e.parentParser.newModuleParser(filesystem, prefix, path, b.Label(), b)
 	if err := moduleParser.ParseFS(ctx, path); err != nil {
@@ -178,3 +180,15 @@ func (e *evaluator) loadExternalModule(ctx context.Context, b *terraform.Block,
 		External:   true,
 	}, nil
 }
+
+// joinSourcePrefix avoids cleaning remote sources, so results retain the module source exactly as it was written
+func joinSourcePrefix(parent string, child string) string {
+	switch {
+	case child == "":
+		return parent
+	case parent == "":
+		return child
+	default:
+		return filepath.Join(parent, child)
+	}
+}
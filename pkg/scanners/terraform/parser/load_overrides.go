@@ -0,0 +1,285 @@
+package parser
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// isOverrideFile returns true for files which terraform merges into existing blocks rather than loading as
+// ordinary configuration, i.e. override.tf, *_override.tf and their JSON equivalents.
+func isOverrideFile(path string) bool {
+	name := filepath.Base(path)
+	name = strings.TrimSuffix(name, ".json")
+	name = strings.TrimSuffix(name, ".tf")
+	return name == "override" || strings.HasSuffix(name, "_override")
+}
+
+// applyOverride merges the top-level blocks of an override file into the existing blocks, following terraform's
+// override rules: attributes replace those of the same name, and nested blocks replace all nested blocks of the same
+// type. Locals are overridden individually, regardless of which locals block defined them. Terraform rejects overrides
+// of blocks and locals which do not exist, so these are reported as diagnostics and skipped.
+func (p *Parser) applyOverride(blocks hcl.Blocks, overrides hcl.Blocks) hcl.Blocks {
+	for _, override := range overrides {
+		switch override.Type {
+		case "locals":
+			blocks = p.overrideLocals(blocks, override)
+			continue
+		case "moved", "import", "removed":
+			p.debug("Ignoring '%s' block in override file %s - it cannot be overridden.", override.Type, override.DefRange.Filename)
+			continue
+		}
+		base := findOverrideBase(blocks, override)
+		if base == nil && override.Type == "terraform" {
+			// settings in a terraform block apply to the module, so there is no need for an existing block
+			blocks = append(blocks, override)
+			continue
+		}
+		if base == nil {
+			// terraform rejects configuration which overrides a block that does not exist
+			p.diagnostics = append(p.diagnostics, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Missing base block to override",
+				Detail:   fmt.Sprintf("There is no %s block to override, so the block in %s is ignored.", overrideName(override), override.DefRange.Filename),
+				Subject:  override.DefRange.Ptr(),
+			})
+			continue
+		}
+		base.Body = mergeBodies(base.Body, override.Body, nil)
+	}
+	return blocks
+}
+
+func (p *Parser) overrideLocals(blocks hcl.Blocks, override *hcl.Block) hcl.Blocks {
+	attrs, diags := override.Body.JustAttributes()
+	if diags.HasErrors() {
+		p.debug("Failed to read locals in override file %s: %s", override.DefRange.Filename, diags)
+		return blocks
+	}
+	remaining := make(map[string]bool)
+	for name := range attrs {
+		remaining[name] = true
+	}
+	for _, base := range blocks {
+		if base.Type != "locals" {
+			continue
+		}
+		baseAttrs, _ := base.Body.JustAttributes()
+		names := make(map[string]bool)
+		for name := range baseAttrs {
+			if remaining[name] {
+				names[name] = true
+				delete(remaining, name)
+			}
+		}
+		if len(names) > 0 {
+			base.Body = mergeBodies(base.Body, override.Body, names)
+		}
+	}
+	names := make([]string, 0, len(remaining))
+	for name := range remaining {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p.diagnostics = append(p.diagnostics, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Missing base local value definition to override",
+			Detail:   fmt.Sprintf("There is no local value named %q to override, so the definition in %s is ignored.", name, override.DefRange.Filename),
+			Subject:  attrs[name].NameRange.Ptr(),
+		})
+	}
+	return blocks
+}
+
+func overrideName(block *hcl.Block) string {
+	name := block.Type
+	for _, label := range block.Labels {
+		name += fmt.Sprintf(" %q", label)
+	}
+	return name
+}
+
+func findOverrideBase(blocks hcl.Blocks, override *hcl.Block) *hcl.Block {
+	for _, block := range blocks {
+		if block.Type != override.Type || len(block.Labels) != len(override.Labels) {
+			continue
+		}
+		matches := true
+		for i, label := range block.Labels {
+			if override.Labels[i] != label {
+				matches = false
+				break
+			}
+		}
+		if !matches {
+			continue
+		}
+		// providers are additionally identified by their alias
+		if block.Type == "provider" && providerAlias(block) != providerAlias(override) {
+			continue
+		}
+		return block
+	}
+	return nil
+}
+
+func providerAlias(block *hcl.Block) string {
+	content, _, _ := block.Body.PartialContent(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "alias"}},
+	})
+	if content == nil {
+		return ""
+	}
+	attr, ok := content.Attributes["alias"]
+	if !ok {
+		return ""
+	}
+	val, diags := attr.Expr.Value(nil)
+	if diags.HasErrors() || !val.IsKnown() || val.IsNull() || val.Type() != cty.String {
+		return ""
+	}
+	return val.AsString()
+}
+
+// mergeBodies combines a base body with an override body. If filter is non-nil, only the attributes it names are
+// taken from the override body, and no nested blocks are.
+func mergeBodies(base hcl.Body, override hcl.Body, filter map[string]bool) hcl.Body {
+	baseSyntax, baseOK := base.(*hclsyntax.Body)
+	overrideSyntax, overrideOK := override.(*hclsyntax.Body)
+	if baseOK && overrideOK {
+		return mergeSyntaxBodies(baseSyntax, overrideSyntax, filter)
+	}
+	return &mergedBody{
+		base:     base,
+		override: override,
+		filter:   filter,
+	}
+}
+
+func mergeSyntaxBodies(base *hclsyntax.Body, override *hclsyntax.Body, filter map[string]bool) *hclsyntax.Body {
+	merged := *base
+	merged.Attributes = make(hclsyntax.Attributes, len(base.Attributes))
+	for name, attr := range base.Attributes {
+		merged.Attributes[name] = attr
+	}
+	for name, attr := range override.Attributes {
+		if filter == nil || filter[name] {
+			merged.Attributes[name] = attr
+		}
+	}
+	if filter != nil {
+		return &merged
+	}
+
+	replaced := make(map[string]bool)
+	for _, block := range override.Blocks {
+		replaced[nestedBlockType(block.Type, block.Labels)] = true
+	}
+
+	merged.Blocks = nil
+	var baseLifecycle *hclsyntax.Block
+	for _, block := range base.Blocks {
+		blockType := nestedBlockType(block.Type, block.Labels)
+		if blockType == "lifecycle" && baseLifecycle == nil {
+			baseLifecycle = block
+		}
+		if replaced[blockType] {
+			continue
+		}
+		merged.Blocks = append(merged.Blocks, block)
+	}
+	for _, block := range override.Blocks {
+		// lifecycle arguments are merged individually rather than replacing the whole block
+		if block.Type == "lifecycle" && baseLifecycle != nil {
+			lifecycle := *baseLifecycle
+			lifecycle.Body = mergeSyntaxBodies(baseLifecycle.Body, block.Body, nil)
+			merged.Blocks = append(merged.Blocks, &lifecycle)
+			continue
+		}
+		merged.Blocks = append(merged.Blocks, block)
+	}
+	return &merged
+}
+
+// nestedBlockType treats dynamic blocks as the type of block they generate
+func nestedBlockType(blockType string, labels []string) string {
+	if blockType == "dynamic" && len(labels) > 0 {
+		return labels[0]
+	}
+	return blockType
+}
+
+// mergedBody applies the override rules to bodies which are not both native syntax, such as JSON configuration
+type mergedBody struct {
+	base     hcl.Body
+	override hcl.Body
+	filter   map[string]bool
+}
+
+var _ hcl.Body = (*mergedBody)(nil)
+
+func (b *mergedBody) Content(schema *hcl.BodySchema) (*hcl.BodyContent, hcl.Diagnostics) {
+	content, _, diags := b.PartialContent(schema)
+	return content, diags
+}
+
+func (b *mergedBody) PartialContent(schema *hcl.BodySchema) (*hcl.BodyContent, hcl.Body, hcl.Diagnostics) {
+	baseContent, baseRemain, diags := b.base.PartialContent(schema)
+	overrideContent, overrideRemain, overrideDiags := b.override.PartialContent(schema)
+	diags = append(diags, overrideDiags...)
+
+	content := &hcl.BodyContent{
+		Attributes:       b.mergeAttributes(baseContent.Attributes, overrideContent.Attributes),
+		MissingItemRange: baseContent.MissingItemRange,
+	}
+
+	if b.filter != nil {
+		content.Blocks = baseContent.Blocks
+	} else {
+		replaced := make(map[string]bool)
+		for _, block := range overrideContent.Blocks {
+			replaced[block.Type] = true
+		}
+		for _, block := range baseContent.Blocks {
+			if !replaced[block.Type] {
+				content.Blocks = append(content.Blocks, block)
+			}
+		}
+		content.Blocks = append(content.Blocks, overrideContent.Blocks...)
+	}
+
+	return content, &mergedBody{
+		base:     baseRemain,
+		override: overrideRemain,
+		filter:   b.filter,
+	}, diags
+}
+
+func (b *mergedBody) JustAttributes() (hcl.Attributes, hcl.Diagnostics) {
+	baseAttrs, diags := b.base.JustAttributes()
+	overrideAttrs, overrideDiags := b.override.JustAttributes()
+	return b.mergeAttributes(baseAttrs, overrideAttrs), append(diags, overrideDiags...)
+}
+
+func (b *mergedBody) MissingItemRange() hcl.Range {
+	return b.base.MissingItemRange()
+}
+
+func (b *mergedBody) mergeAttributes(base hcl.Attributes, override hcl.Attributes) hcl.Attributes {
+	merged := make(hcl.Attributes, len(base))
+	for name, attr := range base {
+		merged[name] = attr
+	}
+	for name, attr := range override {
+		if b.filter == nil || b.filter[name] {
+			merged[name] = attr
+		}
+	}
+	return merged
+}
@@ -5,49 +5,141 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/terraform"
+
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	hcljson "github.com/hashicorp/hcl/v2/json"
 	"github.com/zclconf/go-cty/cty"
 )
 
-func loadTFVars(srcFS fs.FS, filenames []string) (map[string]cty.Value, error) {
-	combinedVars := make(map[string]cty.Value)
+const envVarPrefix = "TF_VAR_"
+
+// loadInputVars gathers values for root module variables from the same sources as terraform, in order of
+// increasing precedence: environment variables, terraform.tfvars, terraform.tfvars.json, *.auto.tfvars(.json) in
+// lexical order, explicitly provided tfvars files and finally explicit -var style overrides. The source of each
+// value is returned alongside it.
+func (p *Parser) loadInputVars(variables terraform.Blocks) (map[string]cty.Value, map[string][]types.ProvenanceStep, error) {
+	inputVars := make(map[string]cty.Value)
+	sources := make(map[string][]types.ProvenanceStep)
 
 	for _, env := range os.Environ() {
-		split := strings.Split(env, "=")
-		key := split[0]
-		if !strings.HasPrefix(key, "TF_VAR_") {
+		key, val, _ := strings.Cut(env, "=")
+		if !strings.HasPrefix(key, envVarPrefix) {
 			continue
 		}
-		key = strings.TrimPrefix(key, "TF_VAR_")
-		var val string
-		if len(split) > 1 {
-			val = split[1]
+		name := strings.TrimPrefix(key, envVarPrefix)
+		inputVars[name] = p.parseRawVar(name, val, variables)
+		sources[name] = []types.ProvenanceStep{{Kind: types.ProvenanceEnvironment, Name: key}}
+	}
+
+	filenames := append(discoverTFVarsFiles(p.moduleFS, p.modulePath), p.tfvarsPaths...)
+	fileVars, fileRanges, err := loadTFVars(p.moduleFS, filenames)
+	if err != nil {
+		return nil, nil, err
+	}
+	for name, val := range fileVars {
+		inputVars[name] = val
+		r := fileRanges[name]
+		sources[name] = []types.ProvenanceStep{{
+			Kind:  types.ProvenanceTFVars,
+			Name:  name,
+			Range: types.NewRange(r.Filename, r.Start.Line, r.End.Line, p.moduleSource, p.moduleFS),
+		}}
+	}
+
+	for name, raw := range p.inputVars {
+		inputVars[name] = p.parseRawVar(name, raw, variables)
+		sources[name] = []types.ProvenanceStep{{Kind: types.ProvenanceInputVar, Name: name}}
+	}
+
+	return inputVars, sources, nil
+}
+
+// discoverTFVarsFiles returns the variable definition files which terraform loads automatically from a root module
+func discoverTFVarsFiles(srcFS fs.FS, dir string) []string {
+	var filenames []string
+	for _, name := range []string{"terraform.tfvars", "terraform.tfvars.json"} {
+		path := filepath.Join(dir, name)
+		if info, err := fs.Stat(srcFS, filepath.ToSlash(path)); err == nil && !info.IsDir() {
+			filenames = append(filenames, path)
 		}
-		combinedVars[key] = cty.StringVal(val)
 	}
+	entries, err := fs.ReadDir(srcFS, filepath.ToSlash(dir))
+	if err != nil {
+		return filenames
+	}
+	var autoFiles []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if name := entry.Name(); strings.HasSuffix(name, ".auto.tfvars") || strings.HasSuffix(name, ".auto.tfvars.json") {
+			autoFiles = append(autoFiles, filepath.Join(dir, name))
+		}
+	}
+	sort.Strings(autoFiles)
+	return append(filenames, autoFiles...)
+}
+
+// parseRawVar interprets a value supplied as a string, such as from the environment or the command line. As with
+// terraform, the value is taken literally for primitive (or undeclared) types, and as an HCL expression otherwise.
+func (p *Parser) parseRawVar(name string, raw string, variables terraform.Blocks) cty.Value {
+	for _, variable := range variables {
+		if variable.Label() != name {
+			continue
+		}
+		typeAttr := variable.GetAttribute("type")
+		if typeAttr.IsNil() {
+			break
+		}
+		ty, _, diags := typeConstraintWithDefaults(typeAttr.HCLAttribute().Expr)
+		if diags.HasErrors() || ty.IsPrimitiveType() {
+			break
+		}
+		expr, diags := hclsyntax.ParseExpression([]byte(raw), fmt.Sprintf("<value for var.%s>", name), hcl.Pos{Line: 1, Column: 1})
+		if diags.HasErrors() {
+			p.debug("Failed to parse value for variable '%s': %s", name, diags)
+			break
+		}
+		val, diags := expr.Value(nil)
+		if diags.HasErrors() {
+			p.debug("Failed to evaluate value for variable '%s': %s", name, diags)
+			break
+		}
+		return val
+	}
+	return cty.StringVal(raw)
+}
+
+// loadTFVars reads variable values from the given files, with later files taking precedence. The range of each
+// value's definition is also returned.
+func loadTFVars(srcFS fs.FS, filenames []string) (map[string]cty.Value, map[string]hcl.Range, error) {
+	combinedVars := make(map[string]cty.Value)
+	combinedRanges := make(map[string]hcl.Range)
 
 	for _, filename := range filenames {
-		vars, err := loadTFVarsFile(srcFS, filename)
+		attrs, err := loadTFVarsFile(srcFS, filename)
 		if err != nil {
-			return nil, fmt.Errorf("failed to load tfvars from %s: %w", filename, err)
+			return nil, nil, fmt.Errorf("failed to load tfvars from %s: %w", filename, err)
 		}
-		for k, v := range vars {
-			combinedVars[k] = v
+		for k, attr := range attrs {
+			combinedVars[k], _ = attr.Expr.Value(&hcl.EvalContext{})
+			combinedRanges[k] = attr.Range
 		}
 	}
 
-	return combinedVars, nil
+	return combinedVars, combinedRanges, nil
 }
 
-func loadTFVarsFile(srcFS fs.FS, filename string) (map[string]cty.Value, error) {
+func loadTFVarsFile(srcFS fs.FS, filename string) (hcl.Attributes, error) {
 
-	inputVars := make(map[string]cty.Value)
 	if filename == "" {
-		return inputVars, nil
+		return nil, nil
 	}
 
 	src, err := fs.ReadFile(srcFS, filepath.ToSlash(filename))
@@ -76,9 +168,5 @@ func loadTFVarsFile(srcFS fs.FS, filename string) (map[string]cty.Value, error)
 		}
 	}
 
-	for _, attr := range attrs {
-		inputVars[attr.Name], _ = attr.Expr.Value(&hcl.EvalContext{})
-	}
-
-	return inputVars, nil
+	return attrs, nil
 }
@@ -1,8 +1,11 @@
 package parser
 
 import (
+	"context"
 	"testing"
 
+	"github.com/aquasecurity/defsec/pkg/scanners/options"
+
 	"github.com/stretchr/testify/require"
 
 	"github.com/aquasecurity/defsec/test/testutil"
@@ -28,10 +31,160 @@ func Test_JsonVarsFile(t *testing.T) {
 `,
 	})
 
-	vars, err := loadTFVars(fs, []string{"test.tfvars.json"})
+	vars, _, err := loadTFVars(fs, []string{"test.tfvars.json"})
 	require.NoError(t, err)
 	assert.Equal(t, "bar", vars["variable"].GetAttr("foo").GetAttr("default").AsString())
 	assert.Equal(t, "qux", vars["variable"].GetAttr("baz").AsString())
 	assert.Equal(t, true, vars["foo2"].True())
 	assert.Equal(t, true, vars["foo3"].Equals(cty.NumberIntVal(3)).True())
 }
+
+func Test_InputVarPrecedence(t *testing.T) {
+
+	t.Setenv("TF_VAR_from_env", "env")
+	t.Setenv("TF_VAR_overridden", "env")
+	t.Setenv("TF_VAR_tags", `{ owner = "env" }`)
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/main.tf": `
+variable "from_env" {}
+variable "overridden" {}
+variable "tags" {
+	type = map(string)
+}
+variable "count" {
+	type = number
+}
+`,
+		"code/terraform.tfvars":       `overridden = "tfvars"`,
+		"code/terraform.tfvars.json":  `{"overridden": "tfvars.json"}`,
+		"code/a.auto.tfvars":          `overridden = "a.auto"`,
+		"code/b.auto.tfvars.json":     `{"overridden": "b.auto"}`,
+		"vars/explicit.tfvars":        `overridden = "explicit"`,
+		"code/unrelated/other.tfvars": `overridden = "unrelated"`,
+	})
+
+	tests := []struct {
+		name     string
+		opts     []options.ParserOption
+		expected string
+	}{
+		{
+			name:     "auto files in lexical order",
+			expected: "b.auto",
+		},
+		{
+			name:     "explicit tfvars file",
+			opts:     []options.ParserOption{OptionWithTFVarsPaths("vars/explicit.tfvars")},
+			expected: "explicit",
+		},
+		{
+			name: "var override",
+			opts: []options.ParserOption{
+				OptionWithTFVarsPaths("vars/explicit.tfvars"),
+				OptionWithInputVars(map[string]string{"overridden": "override", "count": "3"}),
+			},
+			expected: "override",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parser := New(fs, "", test.opts...)
+			require.NoError(t, parser.ParseFS(context.TODO(), "code"))
+			modules, _, err := parser.EvaluateAll(context.TODO())
+			require.NoError(t, err)
+			require.Len(t, modules, 1)
+
+			values := make(map[string]cty.Value)
+			for _, variable := range modules[0].GetBlocks().OfType("variable") {
+				values[variable.Label()] = variable.Context().Root().Get("var", variable.Label())
+			}
+			assert.Equal(t, "env", values["from_env"].AsString())
+			assert.Equal(t, test.expected, values["overridden"].AsString())
+			assert.Equal(t, cty.MapVal(map[string]cty.Value{"owner": cty.StringVal("env")}), values["tags"])
+		})
+	}
+}
+
+func Test_InputVarTypeConversion(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"main.tf": `
+variable "bucket" {
+	type = object({
+		name       = string
+		versioning = optional(bool, true)
+		acl        = optional(string)
+		logging    = optional(object({
+			enabled = optional(bool, true)
+		}), {})
+	})
+}
+
+variable "ports" {
+	type    = list(number)
+	default = ["80", "443"]
+}
+
+resource "aws_s3_bucket" "example" {
+	bucket     = var.bucket.name
+	versioning = var.bucket.versioning
+	logging    = var.bucket.logging.enabled
+	acl        = var.bucket.acl
+	ports      = var.ports
+}
+`,
+		"terraform.tfvars": `
+bucket = {
+	name = "my-bucket"
+}
+`,
+	})
+
+	parser := New(fs, "", OptionStopOnHCLError(true))
+	require.NoError(t, parser.ParseFS(context.TODO(), "."))
+	modules, _, err := parser.EvaluateAll(context.TODO())
+	require.NoError(t, err)
+	assert.Empty(t, parser.Diagnostics())
+
+	buckets := modules.GetResourcesByType("aws_s3_bucket")
+	require.Len(t, buckets, 1)
+	bucket := buckets[0]
+	assert.Equal(t, "my-bucket", bucket.GetAttribute("bucket").Value().AsString())
+	assert.True(t, bucket.GetAttribute("versioning").IsTrue())
+	assert.True(t, bucket.GetAttribute("logging").IsTrue())
+	assert.Equal(t, cty.NilVal, bucket.GetAttribute("acl").Value())
+	assert.Equal(t, cty.List(cty.Number), bucket.GetAttribute("ports").Value().Type())
+}
+
+func Test_InputVarValidation(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"main.tf": `
+variable "environment" {
+	type = string
+	validation {
+		condition     = contains(["dev", "prod"], var.environment)
+		error_message = "Environment must be dev or prod."
+	}
+}
+
+variable "replicas" {
+	type    = number
+	default = "three"
+}
+`,
+	})
+
+	parser := New(fs, "", OptionStopOnHCLError(true), OptionWithInputVars(map[string]string{"environment": "staging"}))
+	require.NoError(t, parser.ParseFS(context.TODO(), "."))
+	_, _, err := parser.EvaluateAll(context.TODO())
+	require.NoError(t, err)
+
+	diags := parser.Diagnostics()
+	require.Len(t, diags, 2)
+	assert.Contains(t, diags[0].Detail, "Environment must be dev or prod.")
+	assert.Equal(t, 5, diags[0].Subject.Start.Line)
+	assert.Contains(t, diags[1].Detail, `variable "replicas" is not compatible with its type constraint`)
+}
@@ -13,6 +13,7 @@ type ModuleResolver interface {
 }
 
 var defaultResolvers = []ModuleResolver{
+	resolvers.Mapped,
 	resolvers.Cache,
 	resolvers.Local,
 	resolvers.Remote,
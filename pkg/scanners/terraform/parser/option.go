@@ -2,14 +2,17 @@ package parser
 
 import (
 	"github.com/aquasecurity/defsec/pkg/scanners/options"
+	"github.com/aquasecurity/defsec/pkg/scanners/terraform/parser/resolvers"
 )
 
 type ConfigurableTerraformParser interface {
 	options.ConfigurableParser
 	SetTFVarsPaths(...string)
+	SetInputVars(map[string]string)
 	SetStopOnHCLError(bool)
 	SetWorkspaceName(string)
 	SetAllowDownloads(bool)
+	SetModuleSourceMap(*resolvers.SourceMap)
 }
 
 type Option func(p ConfigurableTerraformParser)
@@ -22,6 +25,16 @@ func OptionWithTFVarsPaths(paths ...string) options.ParserOption {
 	}
 }
 
+// OptionWithInputVars sets variable values in the same way as terraform's -var flag. These take precedence over
+// values from the environment and tfvars files.
+func OptionWithInputVars(vars map[string]string) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if tf, ok := p.(ConfigurableTerraformParser); ok {
+			tf.SetInputVars(vars)
+		}
+	}
+}
+
 func OptionStopOnHCLError(stop bool) options.ParserOption {
 	return func(p options.ConfigurableParser) {
 		if tf, ok := p.(ConfigurableTerraformParser); ok {
@@ -45,3 +58,12 @@ func OptionWithDownloads(allowed bool) options.ParserOption {
 		}
 	}
 }
+
+// OptionWithModuleSourceMap resolves remote modules using the given source map, in preference to downloading them
+func OptionWithModuleSourceMap(sourceMap *resolvers.SourceMap) options.ParserOption {
+	return func(p options.ConfigurableParser) {
+		if tf, ok := p.(ConfigurableTerraformParser); ok {
+			tf.SetModuleSourceMap(sourceMap)
+		}
+	}
+}
@@ -12,9 +12,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aquasecurity/defsec/internal/types"
 	"github.com/aquasecurity/defsec/pkg/scanners/options"
 
 	tfcontext "github.com/aquasecurity/defsec/pkg/scanners/terraform/context"
+	"github.com/aquasecurity/defsec/pkg/scanners/terraform/parser/resolvers"
 	"github.com/aquasecurity/defsec/pkg/terraform"
 
 	"github.com/aquasecurity/defsec/pkg/extrafs"
@@ -65,6 +67,12 @@ type Parser struct {
 	allowDownloads bool
 	fsMap          map[string]fs.FS
 	skipRequired   bool
+	sourceMap      *resolvers.SourceMap
+	inputVars      map[string]string
+	inputSources   map[string][]types.ProvenanceStep
+	diagnostics    hcl.Diagnostics
+	// planTimestamp is returned by plantimestamp() in every module of the plan
+	planTimestamp time.Time
 }
 
 func (p *Parser) SetDebugWriter(writer io.Writer) {
@@ -75,6 +83,10 @@ func (p *Parser) SetTFVarsPaths(s ...string) {
 	p.tfvarsPaths = s
 }
 
+func (p *Parser) SetInputVars(vars map[string]string) {
+	p.inputVars = vars
+}
+
 func (p *Parser) SetStopOnHCLError(b bool) {
 	p.stopOnHCLError = b
 }
@@ -87,6 +99,10 @@ func (p *Parser) SetAllowDownloads(b bool) {
 	p.allowDownloads = b
 }
 
+func (p *Parser) SetModuleSourceMap(sourceMap *resolvers.SourceMap) {
+	p.sourceMap = sourceMap
+}
+
 func (p *Parser) SetSkipRequiredCheck(b bool) {
 	p.skipRequired = b
 }
@@ -102,6 +118,7 @@ func New(moduleFS fs.FS, moduleSource string, opts ...options.ParserOption) *Par
 		allowDownloads: true,
 		moduleFS:       moduleFS,
 		moduleSource:   moduleSource,
+		planTimestamp:  time.Now(),
 	}
 
 	for _, option := range opts {
@@ -125,6 +142,7 @@ func (p *Parser) newModuleParser(moduleFS fs.FS, moduleSource, modulePath, modul
 	mp.moduleBlock = moduleBlock
 	mp.moduleName = moduleName
 	mp.projectRoot = p.projectRoot
+	mp.planTimestamp = p.planTimestamp
 	p.children = append(p.children, mp)
 	return mp
 }
@@ -261,15 +279,16 @@ func (p *Parser) EvaluateAll(ctx context.Context) (terraform.Modules, cty.Value,
 	p.metrics.Counts.Blocks = len(blocks)
 
 	var inputVars map[string]cty.Value
+	inputSources := p.inputSources
 	if p.moduleBlock != nil {
 		inputVars = p.moduleBlock.Values().AsValueMap()
 		p.debug("Added %d input variables from module definition.", len(inputVars))
 	} else {
-		inputVars, err = loadTFVars(p.moduleFS, p.tfvarsPaths)
+		inputVars, inputSources, err = p.loadInputVars(blocks.OfType("variable"))
 		if err != nil {
 			return nil, cty.NilVal, err
 		}
-		p.debug("Added %d variables from tfvars.", len(inputVars))
+		p.debug("Added %d input variables from the environment, tfvars and overrides.", len(inputVars))
 	}
 
 	modulesMetadata, err := loadModuleMetadata(p.moduleFS, p.projectRoot)
@@ -292,13 +311,16 @@ func (p *Parser) EvaluateAll(ctx context.Context) (terraform.Modules, cty.Value,
 		p.moduleName,
 		blocks,
 		inputVars,
+		inputSources,
 		modulesMetadata,
 		p.workspaceName,
 		ignores,
 		p.debugWriter,
 		p.allowDownloads,
+		p.planTimestamp,
 	)
 	modules, fsMap, parseDuration := evaluator.EvaluateAll(ctx)
+	p.diagnostics = append(p.diagnostics, evaluator.diagnostics...)
 	p.metrics.Counts.Modules = len(modules)
 	p.metrics.Timings.ParseDuration = parseDuration
 	p.debug("Finished parsing module '%s'.", p.moduleName)
@@ -306,6 +328,16 @@ func (p *Parser) EvaluateAll(ctx context.Context) (terraform.Modules, cty.Value,
 	return modules, evaluator.exportOutputs(), nil
 }
 
+// Diagnostics returns problems found while evaluating this module and its children which do not prevent
+// evaluation, such as variable values which fail their type constraint or validation rules.
+func (p *Parser) Diagnostics() hcl.Diagnostics {
+	diags := p.diagnostics
+	for _, child := range p.children {
+		diags = append(diags, child.Diagnostics()...)
+	}
+	return diags
+}
+
 func (p *Parser) GetFilesystemMap() map[string]fs.FS {
 	if p.fsMap == nil {
 		return make(map[string]fs.FS)
@@ -314,11 +346,12 @@ func (p *Parser) GetFilesystemMap() map[string]fs.FS {
 }
 
 func (p *Parser) readBlocks(files []sourceFile) (terraform.Blocks, terraform.Ignores, error) {
-	var blocks terraform.Blocks
+	var hclBlocks hcl.Blocks
+	overrides := make(map[string]hcl.Blocks)
 	var ignores terraform.Ignores
 	moduleCtx := tfcontext.NewContext(&hcl.EvalContext{}, nil)
 	for _, file := range files {
-		fileBlocks, fileIgnores, err := loadBlocksFromFile(file, p.moduleSource)
+		fileBlocks, fileIgnores, err := loadBlocksFromFile(file, p.moduleSource, p.moduleFS)
 		if err != nil {
 			if p.stopOnHCLError {
 				return nil, nil, err
@@ -326,12 +359,29 @@ func (p *Parser) readBlocks(files []sourceFile) (terraform.Blocks, terraform.Ign
 			p.debug("Encountered HCL parse error: %s", err)
 			continue
 		}
-		for _, fileBlock := range fileBlocks {
-			blocks = append(blocks, terraform.NewBlock(fileBlock, moduleCtx, p.moduleBlock, nil, p.moduleSource, p.moduleFS))
+		if isOverrideFile(file.path) {
+			overrides[file.path] = fileBlocks
+		} else {
+			hclBlocks = append(hclBlocks, fileBlocks...)
 		}
 		ignores = append(ignores, fileIgnores...)
 	}
 
+	// override files are applied after all other files, in lexical order of filename
+	overridePaths := make([]string, 0, len(overrides))
+	for path := range overrides {
+		overridePaths = append(overridePaths, path)
+	}
+	sort.Strings(overridePaths)
+	for _, path := range overridePaths {
+		hclBlocks = p.applyOverride(hclBlocks, overrides[path])
+	}
+
+	var blocks terraform.Blocks
+	for _, hclBlock := range hclBlocks {
+		blocks = append(blocks, terraform.NewBlock(hclBlock, moduleCtx, p.moduleBlock, nil, p.moduleSource, p.moduleFS))
+	}
+
 	sortBlocksByHierarchy(blocks)
 	return blocks, ignores, nil
 }
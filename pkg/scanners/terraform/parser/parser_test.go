@@ -1,12 +1,17 @@
 package parser
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"os"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/aquasecurity/defsec/pkg/scanners/options"
+	"github.com/aquasecurity/defsec/pkg/scanners/terraform/parser/resolvers"
+	"github.com/aquasecurity/defsec/pkg/terraform"
 
 	"github.com/aquasecurity/defsec/test/testutil"
 
@@ -561,3 +566,531 @@ resource "something" "blah" {
 	assert.Equal(t, "c", values[2].Value())
 	assert.Equal(t, true, values[2].GetMetadata().IsResolvable())
 }
+
+func Test_ModuleSourceMap(t *testing.T) {
+
+	var archive bytes.Buffer
+	writer := zip.NewWriter(&archive)
+	f, err := writer.Create("modules/bucket/main.tf")
+	require.NoError(t, err)
+	_, err = f.Write([]byte(`
+variable "name" {}
+
+resource "aws_s3_bucket" "this" {
+	bucket = var.name
+}
+`))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/main.tf": `
+module "vpc" {
+	source  = "terraform-aws-modules/vpc/aws"
+	version = "~> 3.0"
+	cidr    = "10.0.0.0/16"
+}
+
+module "bucket" {
+	source = "git::https://example.com/org/modules.git//modules/bucket?ref=v1.0.0"
+	name   = "my-bucket"
+}
+`,
+		"vendor/vpc/main.tf": `
+variable "cidr" {}
+
+resource "aws_vpc" "this" {
+	cidr_block = var.cidr
+}
+`,
+		"vendor/modules.zip": archive.String(),
+		"modules.json": `{
+	"modules": [
+		{"source": "terraform-aws-modules/vpc/aws", "version": "2.78.0", "path": "vendor/old-vpc"},
+		{"source": "terraform-aws-modules/vpc/aws", "version": "3.14.0", "path": "vendor/vpc"},
+		{"source": "git::https://example.com/org/modules.git?ref=v1.0.0", "path": "vendor/modules.zip"}
+	]
+}`,
+	})
+
+	sourceMap, err := resolvers.LoadSourceMap(fs, "modules.json")
+	require.NoError(t, err)
+
+	parser := New(fs, "", OptionStopOnHCLError(true), OptionWithDownloads(false), OptionWithModuleSourceMap(sourceMap))
+	require.NoError(t, parser.ParseFS(context.TODO(), "code"))
+	modules, _, err := parser.EvaluateAll(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, modules, 3)
+
+	vpcs := modules.GetResourcesByType("aws_vpc")
+	require.Len(t, vpcs, 1)
+	assert.Equal(t, "10.0.0.0/16", vpcs[0].GetAttribute("cidr_block").Value().AsString())
+	assert.Equal(t, "terraform-aws-modules/vpc/aws", vpcs[0].GetMetadata().Range().GetSourcePrefix())
+
+	buckets := modules.GetResourcesByType("aws_s3_bucket")
+	require.Len(t, buckets, 1)
+	assert.Equal(t, "my-bucket", buckets[0].GetAttribute("bucket").Value().AsString())
+	assert.Equal(t, "git::https://example.com/org/modules.git//modules/bucket?ref=v1.0.0", buckets[0].GetMetadata().Range().GetSourcePrefix())
+}
+
+func Test_OverrideFiles(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"main.tf": `
+locals {
+	name = "original"
+	env  = "dev"
+}
+
+variable "acl" {
+	default = "private"
+}
+
+provider "aws" {
+	region = "us-east-1"
+}
+
+provider "aws" {
+	alias  = "west"
+	region = "us-west-1"
+}
+
+resource "aws_s3_bucket" "example" {
+	bucket = local.name
+	acl    = var.acl
+
+	logging {
+		target_bucket = "logs-a"
+	}
+
+	logging {
+		target_bucket = "logs-b"
+	}
+
+	lifecycle {
+		prevent_destroy = true
+	}
+}
+`,
+		"override.tf": `
+variable "acl" {
+	default = "public-read"
+}
+
+provider "aws" {
+	alias  = "west"
+	region = "eu-west-1"
+}
+`,
+		"bucket_override.tf": `
+locals {
+	name = "overridden"
+}
+
+resource "aws_s3_bucket" "example" {
+	logging {
+		target_bucket = "logs-override"
+	}
+
+	lifecycle {
+		ignore_changes = [tags]
+	}
+}
+`,
+	})
+
+	parser := New(fs, "", OptionStopOnHCLError(true))
+	require.NoError(t, parser.ParseFS(context.TODO(), "."))
+	modules, _, err := parser.EvaluateAll(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, modules, 1)
+
+	buckets := modules.GetResourcesByType("aws_s3_bucket")
+	require.Len(t, buckets, 1)
+	bucket := buckets[0]
+
+	assert.Equal(t, "overridden", bucket.GetAttribute("bucket").Value().AsString())
+	assert.Equal(t, "public-read", bucket.GetAttribute("acl").Value().AsString())
+	assert.Equal(t, "main.tf", bucket.GetMetadata().Range().GetFilename())
+	assert.Equal(t, "main.tf", bucket.GetAttribute("acl").GetMetadata().Range().GetFilename())
+
+	logging := bucket.GetBlocks("logging")
+	require.Len(t, logging, 1)
+	assert.Equal(t, "logs-override", logging[0].GetAttribute("target_bucket").Value().AsString())
+	assert.Equal(t, "bucket_override.tf", logging[0].GetAttribute("target_bucket").GetMetadata().Range().GetFilename())
+
+	lifecycle := bucket.GetBlock("lifecycle")
+	require.NotNil(t, lifecycle)
+	assert.True(t, lifecycle.GetAttribute("prevent_destroy").IsTrue())
+	assert.NotNil(t, lifecycle.GetAttribute("ignore_changes"))
+
+	acl := modules[0].GetBlocks().OfType("variable")[0].GetAttribute("default")
+	assert.Equal(t, "override.tf", acl.GetMetadata().Range().GetFilename())
+
+	providers := modules[0].GetBlocks().OfType("provider")
+	require.Len(t, providers, 2)
+	regions := make(map[string]string)
+	for _, provider := range providers {
+		regions[provider.GetAttribute("alias").AsStringValueOrDefault("", provider).Value()] = provider.GetAttribute("region").Value().AsString()
+	}
+	assert.Equal(t, map[string]string{"": "us-east-1", "west": "eu-west-1"}, regions)
+
+	locals := modules[0].GetBlocks().OfType("locals")
+	require.Len(t, locals, 1)
+	assert.Equal(t, "dev", locals[0].GetAttribute("env").Value().AsString())
+}
+
+func Test_JSONOverrideFile(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"main.tf": `
+resource "aws_s3_bucket" "example" {
+	bucket = "original"
+	acl    = "private"
+}
+`,
+		"override.tf.json": `{
+	"resource": {
+		"aws_s3_bucket": {
+			"example": {
+				"acl": "public-read"
+			}
+		}
+	}
+}`,
+	})
+
+	parser := New(fs, "", OptionStopOnHCLError(true))
+	require.NoError(t, parser.ParseFS(context.TODO(), "."))
+	modules, _, err := parser.EvaluateAll(context.TODO())
+	require.NoError(t, err)
+
+	buckets := modules.GetResourcesByType("aws_s3_bucket")
+	require.Len(t, buckets, 1)
+	assert.Equal(t, "original", buckets[0].GetAttribute("bucket").Value().AsString())
+	assert.Equal(t, "public-read", buckets[0].GetAttribute("acl").Value().AsString())
+	assert.Equal(t, "override.tf.json", buckets[0].GetAttribute("acl").GetMetadata().Range().GetFilename())
+}
+
+func Test_ModernFunctions(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/test.tf": `
+variable "password" {
+	default   = "hunter2"
+	sensitive = true
+}
+
+locals {
+	ports = [22, 443]
+}
+
+resource "something" "blah" {
+	policy      = templatefile("${path.module}/templates/policy.json.tpl", { bucket = "my-bucket", actions = ["s3:GetObject"] })
+	secret      = var.password
+	is_secret   = issensitive(var.password)
+	total       = sum(local.ports)
+	single      = one(["only"])
+	all_secure  = alltrue([for port in local.ports : port != 80])
+	is_private  = cidrcontains("10.0.0.0/8", "10.1.2.3")
+	is_https    = startswith("https://example.com", "https://")
+	has_wc      = strcontains("s3:*", "*")
+}
+`,
+		"code/templates/policy.json.tpl": `{"Resource": "arn:aws:s3:::${bucket}/*", "Action": ${jsonencode(actions)}}`,
+	})
+
+	parser := New(fs, "", OptionStopOnHCLError(true))
+	require.NoError(t, parser.ParseFS(context.TODO(), "code"))
+	modules, _, err := parser.EvaluateAll(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, modules, 1)
+
+	blocks := modules[0].GetResourcesByType("something")
+	require.Len(t, blocks, 1)
+	block := blocks[0]
+
+	assert.Equal(t, `{"Resource": "arn:aws:s3:::my-bucket/*", "Action": ["s3:GetObject"]}`, block.GetAttribute("policy").Value().AsString())
+	assert.Equal(t, "hunter2", block.GetAttribute("secret").Value().AsString())
+	assert.True(t, block.GetAttribute("is_secret").IsTrue())
+	assert.True(t, block.GetAttribute("total").Equals(465))
+	assert.Equal(t, "only", block.GetAttribute("single").Value().AsString())
+	assert.True(t, block.GetAttribute("all_secure").IsTrue())
+	assert.True(t, block.GetAttribute("is_private").IsTrue())
+	assert.True(t, block.GetAttribute("is_https").IsTrue())
+	assert.True(t, block.GetAttribute("has_wc").IsTrue())
+}
+
+func Test_PlanTimestampIsSharedByModules(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/main.tf": `
+module "child" {
+	source = "./child"
+}
+
+resource "something" "root" {
+	planned = plantimestamp()
+}
+`,
+		"code/child/main.tf": `
+resource "something" "child" {
+	planned = plantimestamp()
+}
+`,
+	})
+
+	parser := New(fs, "", OptionStopOnHCLError(true))
+	parser.planTimestamp = time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, parser.ParseFS(context.TODO(), "code"))
+	modules, _, err := parser.EvaluateAll(context.TODO())
+	require.NoError(t, err)
+
+	var planned []string
+	for _, module := range modules {
+		for _, block := range module.GetResourcesByType("something") {
+			planned = append(planned, block.GetAttribute("planned").Value().AsString())
+		}
+	}
+	assert.Equal(t, []string{"2023-06-01T12:00:00Z", "2023-06-01T12:00:00Z"}, planned)
+}
+
+func Test_ConfigurationBlocks(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/main.tf": `
+terraform {
+	cloud {
+		organization = "example"
+		workspaces {
+			name = "production"
+		}
+	}
+}
+
+variable "buckets" {
+	default = {
+		logs   = "example-logs"
+		assets = "example-assets"
+	}
+}
+
+resource "aws_s3_bucket" "this" {
+	for_each = var.buckets
+	bucket   = each.value
+}
+
+import {
+	for_each = var.buckets
+	to       = aws_s3_bucket.this[each.key]
+	id       = each.value
+}
+
+resource "aws_s3_bucket" "single" {
+	bucket = "example-single"
+}
+
+import {
+	to = aws_s3_bucket.single
+	id = "example-single"
+}
+
+moved {
+	from = aws_s3_bucket.old
+	to   = aws_s3_bucket.this
+}
+
+removed {
+	from = aws_s3_bucket.legacy
+	lifecycle {
+		destroy = false
+	}
+}
+
+check "health" {
+	data "http" "endpoint" {
+		url = "https://example.com/health"
+	}
+
+	assert {
+		condition     = startswith(data.http.endpoint.url, "https://")
+		error_message = "The health endpoint must use HTTPS."
+	}
+}
+
+resource "something" "outside_check" {
+	url = data.http.endpoint.url
+}
+`,
+	})
+
+	parser := New(fs, "", OptionStopOnHCLError(true))
+	require.NoError(t, parser.ParseFS(context.TODO(), "code"))
+	modules, _, err := parser.EvaluateAll(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, modules, 1)
+	blocks := modules[0].GetBlocks()
+
+	cloud := blocks.OfType("terraform")[0].GetBlock("cloud")
+	require.True(t, cloud.IsNotNil())
+	assert.Equal(t, "example", cloud.GetAttribute("organization").Value().AsString())
+	assert.Equal(t, "production", cloud.GetBlock("workspaces").GetAttribute("name").Value().AsString())
+
+	imports := blocks.OfType("import")
+	require.Len(t, imports, 3)
+	var ids []string
+	var single *terraform.Block
+	for _, imp := range imports {
+		id := imp.GetAttribute("id").Value().AsString()
+		ids = append(ids, id)
+		if id == "example-single" {
+			single = imp
+		}
+	}
+	assert.ElementsMatch(t, []string{"example-logs", "example-assets", "example-single"}, ids)
+
+	require.NotNil(t, single)
+	target, err := modules.GetReferencedBlock(single.GetAttribute("to"), single)
+	require.NoError(t, err)
+	assert.Equal(t, "aws_s3_bucket.single", target.FullName())
+
+	require.Len(t, blocks.OfType("moved"), 1)
+
+	removed := blocks.OfType("removed")
+	require.Len(t, removed, 1)
+	assert.True(t, removed[0].GetBlock("lifecycle").GetAttribute("destroy").IsFalse())
+
+	checks := blocks.OfType("check")
+	require.Len(t, checks, 1)
+	assert.Equal(t, "health", checks[0].Label())
+	assert.True(t, checks[0].GetBlock("assert").GetAttribute("condition").IsTrue())
+	assert.Len(t, modules[0].GetDatasByType("http"), 0, "data sources in check blocks are scoped to the check")
+
+	outside := modules.GetResourcesByType("something")
+	require.Len(t, outside, 1)
+	assert.False(t, outside[0].GetAttribute("url").IsResolvable())
+}
+
+func Test_JSONConfigurationBlocks(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/main.tf.json": `{
+	"terraform": {
+		"cloud": {
+			"organization": "example"
+		}
+	},
+	"check": {
+		"health": {
+			"data": {
+				"http": {
+					"endpoint": {
+						"url": "https://example.com/health"
+					}
+				}
+			},
+			"assert": {
+				"condition": "${startswith(data.http.endpoint.url, \"https://\")}",
+				"error_message": "The health endpoint must use HTTPS."
+			}
+		}
+	},
+	"import": {
+		"to": "aws_s3_bucket.this",
+		"id": "example-bucket"
+	}
+}`,
+	})
+
+	parser := New(fs, "", OptionStopOnHCLError(true))
+	require.NoError(t, parser.ParseFS(context.TODO(), "code"))
+	modules, _, err := parser.EvaluateAll(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, modules, 1)
+	blocks := modules[0].GetBlocks()
+
+	cloud := blocks.OfType("terraform")[0].GetBlock("cloud")
+	assert.Equal(t, "example", cloud.GetAttribute("organization").Value().AsString())
+
+	checks := blocks.OfType("check")
+	require.Len(t, checks, 1)
+	assert.True(t, checks[0].GetBlock("assert").GetAttribute("condition").IsTrue())
+
+	imports := blocks.OfType("import")
+	require.Len(t, imports, 1)
+	assert.Equal(t, "example-bucket", imports[0].GetAttribute("id").Value().AsString())
+}
+
+func Test_TopLevelAssertIsRejected(t *testing.T) {
+	tests := map[string]string{
+		"main.tf": `
+assert {
+	condition     = true
+	error_message = "assert is only valid in a check block."
+}
+`,
+		"main.tf.json": `{
+	"assert": {
+		"condition": true,
+		"error_message": "assert is only valid in a check block."
+	}
+}`,
+	}
+
+	for name, source := range tests {
+		t.Run(name, func(t *testing.T) {
+			fs := testutil.CreateFS(t, map[string]string{
+				"code/" + name: source,
+			})
+
+			parser := New(fs, "", OptionStopOnHCLError(true))
+			require.NoError(t, parser.ParseFS(context.TODO(), "code"))
+			_, _, err := parser.EvaluateAll(context.TODO())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "assert")
+		})
+	}
+}
+
+func Test_OverrideFilesWithoutBase(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"main.tf": `
+locals {
+	name = "original"
+}
+
+resource "aws_s3_bucket" "example" {
+	bucket = local.name
+}
+`,
+		"override.tf": `
+locals {
+	missing = "value"
+}
+
+resource "aws_s3_bucket" "missing" {
+	bucket = "missing"
+}
+
+terraform {
+	required_version = ">= 1.0"
+}
+`,
+	})
+
+	parser := New(fs, "", OptionStopOnHCLError(true))
+	require.NoError(t, parser.ParseFS(context.TODO(), "."))
+	modules, _, err := parser.EvaluateAll(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, modules, 1)
+
+	buckets := modules.GetResourcesByType("aws_s3_bucket")
+	require.Len(t, buckets, 1)
+	assert.Equal(t, "original", buckets[0].GetAttribute("bucket").Value().AsString())
+	assert.Len(t, modules[0].GetBlocks().OfType("terraform"), 1)
+
+	diags := parser.Diagnostics()
+	require.Len(t, diags, 2)
+	assert.Equal(t, "Missing base local value definition to override", diags[0].Summary)
+	assert.Equal(t, 3, diags[0].Subject.Start.Line)
+	assert.Equal(t, "Missing base block to override", diags[1].Summary)
+	assert.Contains(t, diags[1].Detail, `resource "aws_s3_bucket" "missing"`)
+}
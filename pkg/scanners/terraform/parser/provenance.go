@@ -0,0 +1,168 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/terraform"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// recordProvenance annotates each attribute with the chain of locals and variables which supplied its value, so
+// results can point at the place the value was actually set, rather than only the attribute which used it.
+func (e *evaluator) recordProvenance() {
+	tracer := newProvenanceTracer(e.blocks, e.inputSources)
+	for _, block := range e.blocks {
+		tracer.annotate(block)
+	}
+}
+
+// moduleInputSources returns the provenance of each argument passed to a module, which becomes the provenance of
+// the corresponding variable within the module.
+func (e *evaluator) moduleInputSources(moduleBlock *terraform.Block) map[string][]types.ProvenanceStep {
+	tracer := newProvenanceTracer(e.blocks, e.inputSources)
+	sources := make(map[string][]types.ProvenanceStep)
+	for _, attr := range moduleBlock.GetAttributes() {
+		switch attr.Name() {
+		case "source", "version", "count", "for_each", "providers", "depends_on":
+			continue
+		}
+		steps := []types.ProvenanceStep{{
+			Kind:  types.ProvenanceModuleArgument,
+			Name:  fmt.Sprintf("%s.%s", moduleBlock.FullName(), attr.Name()),
+			Range: attr.GetMetadata().Range(),
+		}}
+		sources[attr.Name()] = append(steps, tracer.trace(attr.HCLAttribute().Expr)...)
+	}
+	return sources
+}
+
+type provenanceTracer struct {
+	variables    map[string]*terraform.Block
+	locals       map[string]*terraform.Attribute
+	inputSources map[string][]types.ProvenanceStep
+	resolved     map[string][]types.ProvenanceStep
+	resolving    map[string]bool
+}
+
+func newProvenanceTracer(blocks terraform.Blocks, inputSources map[string][]types.ProvenanceStep) *provenanceTracer {
+	t := &provenanceTracer{
+		variables:    make(map[string]*terraform.Block),
+		locals:       make(map[string]*terraform.Attribute),
+		inputSources: inputSources,
+		resolved:     make(map[string][]types.ProvenanceStep),
+		resolving:    make(map[string]bool),
+	}
+	for _, block := range blocks.OfType("variable") {
+		t.variables[block.Label()] = block
+	}
+	for _, block := range blocks.OfType("locals") {
+		for _, attr := range block.GetAttributes() {
+			t.locals[attr.Name()] = attr
+		}
+	}
+	return t
+}
+
+func (t *provenanceTracer) annotate(block *terraform.Block) {
+	for _, attr := range block.GetAttributes() {
+		if hclAttr := attr.HCLAttribute(); hclAttr != nil {
+			if steps := t.trace(hclAttr.Expr); len(steps) > 0 {
+				attr.SetProvenance(steps...)
+			}
+		}
+	}
+	for _, child := range block.AllBlocks() {
+		t.annotate(child)
+	}
+}
+
+// trace follows the variables and locals referenced by an expression back to where their values were defined
+func (t *provenanceTracer) trace(expr hcl.Expression) []types.ProvenanceStep {
+	var steps []types.ProvenanceStep
+	seen := make(map[string]bool)
+	for _, traversal := range expr.Variables() {
+		if len(traversal) < 2 {
+			continue
+		}
+		attr, ok := traversal[1].(hcl.TraverseAttr)
+		if !ok {
+			continue
+		}
+		var chain []types.ProvenanceStep
+		switch traversal.RootName() {
+		case "var", "local":
+			chain = t.traceReference(traversal.RootName(), attr.Name)
+		default:
+			continue
+		}
+		for _, step := range chain {
+			key := step.String()
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			steps = append(steps, step)
+		}
+	}
+	return steps
+}
+
+func (t *provenanceTracer) traceReference(root string, name string) []types.ProvenanceStep {
+	key := fmt.Sprintf("%s.%s", root, name)
+	if steps, ok := t.resolved[key]; ok {
+		return steps
+	}
+	// locals which (invalidly) refer to each other would otherwise recurse forever
+	if t.resolving[key] {
+		return nil
+	}
+	t.resolving[key] = true
+	defer delete(t.resolving, key)
+
+	var steps []types.ProvenanceStep
+	if root == "var" {
+		steps = t.traceVariable(name)
+	} else {
+		steps = t.traceLocal(name)
+	}
+	t.resolved[key] = steps
+	return steps
+}
+
+func (t *provenanceTracer) traceVariable(name string) []types.ProvenanceStep {
+	block, ok := t.variables[name]
+	if !ok {
+		return nil
+	}
+	steps := []types.ProvenanceStep{{
+		Kind:  types.ProvenanceVariable,
+		Name:  fmt.Sprintf("var.%s", name),
+		Range: block.GetMetadata().Range(),
+	}}
+	if sources, ok := t.inputSources[name]; ok {
+		return append(steps, sources...)
+	}
+	if def := block.GetAttribute("default"); def.IsNotNil() {
+		steps = append(steps, types.ProvenanceStep{
+			Kind:  types.ProvenanceVariableDefault,
+			Name:  fmt.Sprintf("var.%s", name),
+			Range: def.GetMetadata().Range(),
+		})
+	}
+	return steps
+}
+
+func (t *provenanceTracer) traceLocal(name string) []types.ProvenanceStep {
+	attr, ok := t.locals[name]
+	if !ok {
+		return nil
+	}
+	steps := []types.ProvenanceStep{{
+		Kind:  types.ProvenanceLocal,
+		Name:  fmt.Sprintf("local.%s", name),
+		Range: attr.GetMetadata().Range(),
+	}}
+	return append(steps, t.trace(attr.HCLAttribute().Expr)...)
+}
@@ -0,0 +1,143 @@
+package parser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/test/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AttributeProvenance(t *testing.T) {
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/main.tf": `
+variable "environment" {
+	default = "dev"
+}
+
+variable "acl" {}
+
+locals {
+	bucket_acl = var.acl
+	name       = "logs-${var.environment}"
+}
+
+module "bucket" {
+	source = "./modules/bucket"
+	acl    = local.bucket_acl
+	name   = local.name
+}
+
+resource "aws_s3_bucket" "literal" {
+	acl = "private"
+}
+`,
+		"code/terraform.tfvars": `
+acl = "public-read"
+`,
+		"code/modules/bucket/main.tf": `
+variable "acl" {}
+variable "name" {}
+
+resource "aws_s3_bucket" "this" {
+	bucket = var.name
+	acl    = var.acl
+}
+`,
+	})
+
+	parser := New(fs, "", OptionStopOnHCLError(true))
+	require.NoError(t, parser.ParseFS(context.TODO(), "code"))
+	modules, _, err := parser.EvaluateAll(context.TODO())
+	require.NoError(t, err)
+
+	type step struct {
+		kind types.ProvenanceKind
+		name string
+		file string
+		line int
+	}
+	flatten := func(steps []types.ProvenanceStep) (flat []step) {
+		for _, s := range steps {
+			item := step{kind: s.Kind, name: s.Name}
+			if s.Range != nil {
+				item.file = s.Range.GetFilename()
+				item.line = s.Range.GetStartLine()
+			}
+			flat = append(flat, item)
+		}
+		return flat
+	}
+
+	buckets := modules.GetResourcesByType("aws_s3_bucket")
+	require.Len(t, buckets, 2)
+	for _, bucket := range buckets {
+		switch bucket.FullName() {
+		case "aws_s3_bucket.literal":
+			assert.Empty(t, bucket.GetAttribute("acl").Provenance())
+		case "module.bucket.aws_s3_bucket.this":
+			acl := bucket.GetAttribute("acl")
+			assert.Equal(t, "public-read", acl.Value().AsString())
+			assert.Equal(t, []step{
+				{kind: types.ProvenanceVariable, name: "var.acl", file: "code/modules/bucket/main.tf", line: 2},
+				{kind: types.ProvenanceModuleArgument, name: "module.bucket.acl", file: "code/main.tf", line: 15},
+				{kind: types.ProvenanceLocal, name: "local.bucket_acl", file: "code/main.tf", line: 9},
+				{kind: types.ProvenanceVariable, name: "var.acl", file: "code/main.tf", line: 6},
+				{kind: types.ProvenanceTFVars, name: "acl", file: "code/terraform.tfvars", line: 2},
+			}, flatten(acl.Provenance()))
+
+			name := bucket.GetAttribute("bucket")
+			assert.Equal(t, "logs-dev", name.Value().AsString())
+			assert.Equal(t, []step{
+				{kind: types.ProvenanceVariable, name: "var.name", file: "code/modules/bucket/main.tf", line: 3},
+				{kind: types.ProvenanceModuleArgument, name: "module.bucket.name", file: "code/main.tf", line: 16},
+				{kind: types.ProvenanceLocal, name: "local.name", file: "code/main.tf", line: 10},
+				{kind: types.ProvenanceVariable, name: "var.environment", file: "code/main.tf", line: 2},
+				{kind: types.ProvenanceVariableDefault, name: "var.environment", file: "code/main.tf", line: 3},
+			}, flatten(name.Provenance()))
+		default:
+			t.Errorf("unexpected bucket %s", bucket.FullName())
+		}
+	}
+}
+
+func Test_AttributeProvenanceFromOverrides(t *testing.T) {
+	t.Setenv("TF_VAR_region", "eu-west-1")
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/main.tf": `
+variable "region" {}
+variable "size" {
+	type = number
+}
+
+resource "aws_instance" "this" {
+	availability_zone = "${var.region}a"
+	root_block_device {
+		volume_size = var.size
+	}
+}
+`,
+	})
+
+	parser := New(fs, "", OptionStopOnHCLError(true), OptionWithInputVars(map[string]string{"size": "20"}))
+	require.NoError(t, parser.ParseFS(context.TODO(), "code"))
+	modules, _, err := parser.EvaluateAll(context.TODO())
+	require.NoError(t, err)
+
+	instances := modules.GetResourcesByType("aws_instance")
+	require.Len(t, instances, 1)
+
+	zone := instances[0].GetAttribute("availability_zone").Provenance()
+	require.Len(t, zone, 2)
+	assert.Equal(t, types.ProvenanceEnvironment, zone[1].Kind)
+	assert.Equal(t, "TF_VAR_region", zone[1].Name)
+	assert.Nil(t, zone[1].Range)
+
+	size := instances[0].GetBlock("root_block_device").GetAttribute("volume_size").Provenance()
+	require.Len(t, size, 2)
+	assert.Equal(t, types.ProvenanceInputVar, size[1].Kind)
+	assert.Equal(t, "size", size[1].Name)
+}
@@ -0,0 +1,293 @@
+package resolvers
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Masterminds/semver"
+	"github.com/hashicorp/go-getter"
+	"github.com/liamg/memoryfs"
+)
+
+// SourceMapping maps a module source (and optionally the version it was vendored at) to a local directory
+// or archive file. The path may include a '//' separated subdirectory, e.g. "vendor/vpc.zip//modules/subnets".
+type SourceMapping struct {
+	Source  string `json:"source"`
+	Version string `json:"version,omitempty"`
+	Path    string `json:"path"`
+}
+
+// SourceMap is a set of module source mappings, used to resolve remote modules without network access.
+// If FS is nil, mapped paths are read from the filesystem being scanned.
+type SourceMap struct {
+	FS       fs.FS
+	Mappings []SourceMapping
+
+	mu       sync.Mutex
+	archives map[string]fs.FS
+}
+
+type sourceMapManifest struct {
+	Modules []SourceMapping `json:"modules"`
+}
+
+// NewSourceMap creates a SourceMap which reads mapped paths from the given filesystem
+func NewSourceMap(srcFS fs.FS, mappings ...SourceMapping) *SourceMap {
+	return &SourceMap{
+		FS:       srcFS,
+		Mappings: mappings,
+	}
+}
+
+// LoadSourceMap reads a JSON manifest of the form {"modules": [{"source": "...", "version": "...", "path": "..."}]}.
+// Mapped paths are read from srcFS, and are relative to its root.
+func LoadSourceMap(srcFS fs.FS, manifestPath string) (*SourceMap, error) {
+	data, err := fs.ReadFile(srcFS, filepath.ToSlash(manifestPath))
+	if err != nil {
+		return nil, err
+	}
+	var manifest sourceMapManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse module source manifest %s: %w", manifestPath, err)
+	}
+	for i, mapping := range manifest.Modules {
+		if mapping.Source == "" || mapping.Path == "" {
+			return nil, fmt.Errorf("module source manifest %s: entry %d requires both 'source' and 'path'", manifestPath, i)
+		}
+	}
+	return NewSourceMap(srcFS, manifest.Modules...), nil
+}
+
+// Lookup finds the mapping for the given module source and version constraint
+func (m *SourceMap) Lookup(source string, version string) (*SourceMapping, string, bool) {
+	if m == nil {
+		return nil, "", false
+	}
+	if mapping := m.find(source, version); mapping != nil {
+		return mapping, "", true
+	}
+	// try again without the subdirectory, so a single mapping can serve multiple submodules
+	root, subdir := getter.SourceDirSubdir(source)
+	if subdir == "" || root == source {
+		return nil, "", false
+	}
+	if mapping := m.find(root, version); mapping != nil {
+		return mapping, subdir, true
+	}
+	return nil, "", false
+}
+
+func (m *SourceMap) find(source string, version string) *SourceMapping {
+	for i, mapping := range m.Mappings {
+		if mapping.Source != source {
+			continue
+		}
+		if versionMatches(version, mapping.Version) {
+			return &m.Mappings[i]
+		}
+	}
+	return nil
+}
+
+func versionMatches(constraint string, vendored string) bool {
+	if constraint == "" || vendored == "" || constraint == vendored {
+		return true
+	}
+	constraints, err := semver.NewConstraint(pessimisticToRange(constraint))
+	if err != nil {
+		return false
+	}
+	version, err := semver.NewVersion(vendored)
+	if err != nil {
+		return false
+	}
+	return constraints.Check(version)
+}
+
+// pessimisticToRange rewrites terraform's "~>" operator, which only allows the rightmost version component to
+// increment, into an explicit range, as the semver library treats "~>" as a patch-level constraint.
+func pessimisticToRange(constraint string) string {
+	parts := strings.Split(constraint, ",")
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if !strings.HasPrefix(part, "~>") {
+			continue
+		}
+		version := strings.TrimSpace(strings.TrimPrefix(part, "~>"))
+		segments := strings.Split(version, ".")
+		if len(segments) < 2 {
+			continue
+		}
+		upper := make([]string, len(segments)-1)
+		copy(upper, segments)
+		next, err := strconv.Atoi(upper[len(upper)-1])
+		if err != nil {
+			continue
+		}
+		upper[len(upper)-1] = strconv.Itoa(next + 1)
+		parts[i] = fmt.Sprintf(">= %s, < %s", version, strings.Join(upper, "."))
+	}
+	return strings.Join(parts, ",")
+}
+
+func (m *SourceMap) open(target fs.FS, mappedPath string) (fs.FS, string, error) {
+	srcFS := m.FS
+	if srcFS == nil {
+		srcFS = target
+	}
+	mappedPath, subdir := getter.SourceDirSubdir(mappedPath)
+	mappedPath = filepath.ToSlash(filepath.Clean(mappedPath))
+	if !isArchive(mappedPath) {
+		return srcFS, path.Join(mappedPath, subdir), nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.archives == nil {
+		m.archives = make(map[string]fs.FS)
+	}
+	if extracted, ok := m.archives[mappedPath]; ok {
+		return extracted, path.Join(".", subdir), nil
+	}
+	extracted, err := extractArchive(srcFS, mappedPath)
+	if err != nil {
+		return nil, "", err
+	}
+	m.archives[mappedPath] = extracted
+	return extracted, path.Join(".", subdir), nil
+}
+
+func isArchive(name string) bool {
+	for _, ext := range []string{".zip", ".tar", ".tar.gz", ".tgz"} {
+		if strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+func extractArchive(srcFS fs.FS, name string) (fs.FS, error) {
+	data, err := fs.ReadFile(srcFS, name)
+	if err != nil {
+		return nil, err
+	}
+	memfs := memoryfs.New()
+	switch {
+	case strings.HasSuffix(name, ".zip"):
+		err = extractZip(memfs, data)
+	case strings.HasSuffix(name, ".tar"):
+		err = extractTar(memfs, bytes.NewReader(data))
+	default:
+		var gz *gzip.Reader
+		if gz, err = gzip.NewReader(bytes.NewReader(data)); err == nil {
+			err = extractTar(memfs, gz)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract %s: %w", name, err)
+	}
+	return memfs, nil
+}
+
+func extractZip(memfs *memoryfs.FS, data []byte) error {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return err
+	}
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		f, err := file.Open()
+		if err != nil {
+			return err
+		}
+		content, err := io.ReadAll(f)
+		_ = f.Close()
+		if err != nil {
+			return err
+		}
+		if err := writeArchiveFile(memfs, file.Name, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractTar(memfs *memoryfs.FS, r io.Reader) error {
+	reader := tar.NewReader(r)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := io.ReadAll(reader)
+		if err != nil {
+			return err
+		}
+		if err := writeArchiveFile(memfs, header.Name, content); err != nil {
+			return err
+		}
+	}
+}
+
+func writeArchiveFile(memfs *memoryfs.FS, name string, content []byte) error {
+	name = path.Clean(strings.TrimPrefix(filepath.ToSlash(name), "/"))
+	if name == "." || strings.HasPrefix(name, "../") {
+		return nil
+	}
+	if err := memfs.MkdirAll(path.Dir(name), 0o700); err != nil {
+		return err
+	}
+	return memfs.WriteFile(name, content, 0o644)
+}
+
+type mappedResolver struct{}
+
+// Mapped resolves modules using a user supplied SourceMap, and is used in place of downloading modules
+var Mapped = &mappedResolver{}
+
+func (r *mappedResolver) Resolve(_ context.Context, target fs.FS, opt Options) (filesystem fs.FS, prefix string, downloadPath string, applies bool, err error) {
+	if opt.SourceMap == nil {
+		return nil, "", "", false, nil
+	}
+	mapping, subdir, ok := opt.SourceMap.Lookup(opt.OriginalSource, opt.OriginalVersion)
+	if !ok {
+		opt.Debug("No source mapping found for module '%s'.", opt.Name)
+		return nil, "", "", false, nil
+	}
+	mappedPath := mapping.Path
+	if subdir != "" {
+		if _, existing := getter.SourceDirSubdir(mappedPath); existing != "" {
+			mappedPath = fmt.Sprintf("%s/%s", mappedPath, subdir)
+		} else {
+			mappedPath = fmt.Sprintf("%s//%s", mappedPath, subdir)
+		}
+	}
+	filesystem, downloadPath, err = opt.SourceMap.open(target, mappedPath)
+	if err != nil {
+		return nil, "", "", true, err
+	}
+	if _, err := fs.Stat(filesystem, downloadPath); err != nil {
+		return nil, "", "", true, fmt.Errorf("mapped path '%s' for module '%s' is not accessible: %w", mappedPath, opt.Name, err)
+	}
+	opt.Debug("Module '%s' resolved via source map to '%s'.", opt.Name, mappedPath)
+	return filesystem, opt.OriginalSource, downloadPath, true, nil
+}
@@ -12,6 +12,7 @@ type Options struct {
 	AllowDownloads                                                                 bool
 	AllowCache                                                                     bool
 	RelativePath                                                                   string
+	SourceMap                                                                      *SourceMap
 }
 
 func (o *Options) hasPrefix(prefixes ...string) bool {
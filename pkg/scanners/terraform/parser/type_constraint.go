@@ -0,0 +1,212 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+const invalidTypeSummary = "Invalid type specification"
+
+// typeDefaults holds the default values declared for optional object attributes via optional(type, default).
+// Children are keyed by attribute name for objects, by index for tuples, and by "" for collection elements.
+type typeDefaults struct {
+	Type     cty.Type
+	Defaults map[string]cty.Value
+	Children map[string]*typeDefaults
+}
+
+// typeConstraintWithDefaults parses a variable type constraint, including terraform's optional() modifier
+// for object attributes, which the hcl typeexpr extension does not yet support.
+func typeConstraintWithDefaults(expr hcl.Expression) (cty.Type, *typeDefaults, hcl.Diagnostics) {
+	return getTypeConstraint(expr, false)
+}
+
+func getTypeConstraint(expr hcl.Expression, inObject bool) (cty.Type, *typeDefaults, hcl.Diagnostics) {
+	switch kw := hcl.ExprAsKeyword(expr); kw {
+	case "bool":
+		return cty.Bool, nil, nil
+	case "string":
+		return cty.String, nil, nil
+	case "number":
+		return cty.Number, nil, nil
+	case "any":
+		return cty.DynamicPseudoType, nil, nil
+	case "":
+	default:
+		return cty.DynamicPseudoType, nil, typeDiagnostic(expr, fmt.Sprintf("The keyword %q is not a valid type specification.", kw))
+	}
+
+	call, diags := hcl.ExprCall(expr)
+	if diags.HasErrors() {
+		return cty.DynamicPseudoType, nil, typeDiagnostic(expr, "A type specification is either a primitive type keyword (bool, number, string) or a complex type constructor call, like list(string).")
+	}
+
+	switch call.Name {
+	case "list", "set", "map":
+		if len(call.Arguments) != 1 {
+			return cty.DynamicPseudoType, nil, typeDiagnostic(expr, fmt.Sprintf("The %s type constructor requires one argument specifying the element type.", call.Name))
+		}
+		elemType, elemDefaults, diags := getTypeConstraint(call.Arguments[0], false)
+		var ty cty.Type
+		switch call.Name {
+		case "list":
+			ty = cty.List(elemType)
+		case "set":
+			ty = cty.Set(elemType)
+		default:
+			ty = cty.Map(elemType)
+		}
+		return ty, withChildren(ty, map[string]*typeDefaults{"": elemDefaults}), diags
+	case "object":
+		if len(call.Arguments) != 1 {
+			return cty.DynamicPseudoType, nil, typeDiagnostic(expr, "The object type constructor requires one argument specifying the attribute types and values as a map.")
+		}
+		pairs, diags := hcl.ExprMap(call.Arguments[0])
+		if diags.HasErrors() {
+			return cty.DynamicPseudoType, nil, typeDiagnostic(expr, "Object type constructor requires a map whose keys are attribute names and whose values are the corresponding attribute types.")
+		}
+		attrTypes := make(map[string]cty.Type)
+		defaults := make(map[string]cty.Value)
+		children := make(map[string]*typeDefaults)
+		var optional []string
+		for _, pair := range pairs {
+			name := hcl.ExprAsKeyword(pair.Key)
+			if name == "" {
+				return cty.DynamicPseudoType, nil, typeDiagnostic(pair.Key, "Object constructor map keys must be attribute names.")
+			}
+			attrType, attrDefaults, attrDiags := getTypeConstraint(pair.Value, true)
+			diags = append(diags, attrDiags...)
+			if attrCall, callDiags := hcl.ExprCall(pair.Value); !callDiags.HasErrors() && attrCall.Name == "optional" {
+				optional = append(optional, name)
+				if len(attrCall.Arguments) == 2 {
+					defaultVal, valDiags := attrCall.Arguments[1].Value(nil)
+					diags = append(diags, valDiags...)
+					if converted, err := convert.Convert(defaultVal, attrType); err == nil {
+						defaults[name] = converted
+					} else {
+						diags = append(diags, typeDiagnostic(attrCall.Arguments[1], fmt.Sprintf("Invalid default value for optional attribute %q: %s.", name, err))...)
+					}
+				}
+			}
+			attrTypes[name] = attrType
+			if attrDefaults != nil {
+				children[name] = attrDefaults
+			}
+		}
+		ty := cty.ObjectWithOptionalAttrs(attrTypes, optional)
+		result := withChildren(ty, children)
+		if len(defaults) > 0 {
+			if result == nil {
+				result = &typeDefaults{Type: ty}
+			}
+			result.Defaults = defaults
+		}
+		return ty, result, diags
+	case "tuple":
+		if len(call.Arguments) != 1 {
+			return cty.DynamicPseudoType, nil, typeDiagnostic(expr, "The tuple type constructor requires one argument specifying the element types as a list.")
+		}
+		elems, diags := hcl.ExprList(call.Arguments[0])
+		if diags.HasErrors() {
+			return cty.DynamicPseudoType, nil, typeDiagnostic(expr, "Tuple type constructor requires a list of element types.")
+		}
+		elemTypes := make([]cty.Type, len(elems))
+		children := make(map[string]*typeDefaults)
+		for i, elem := range elems {
+			elemType, elemDefaults, elemDiags := getTypeConstraint(elem, false)
+			diags = append(diags, elemDiags...)
+			elemTypes[i] = elemType
+			children[strconv.Itoa(i)] = elemDefaults
+		}
+		ty := cty.Tuple(elemTypes)
+		return ty, withChildren(ty, children), diags
+	case "optional":
+		if !inObject {
+			return cty.DynamicPseudoType, nil, typeDiagnostic(expr, "Keyword \"optional\" is valid only as a modifier for object type attributes.")
+		}
+		if len(call.Arguments) < 1 || len(call.Arguments) > 2 {
+			return cty.DynamicPseudoType, nil, typeDiagnostic(expr, "Optional attribute modifier requires the attribute type and an optional default value.")
+		}
+		return getTypeConstraint(call.Arguments[0], false)
+	default:
+		return cty.DynamicPseudoType, nil, typeDiagnostic(expr, fmt.Sprintf("Keyword %q is not a valid type constructor.", call.Name))
+	}
+}
+
+func withChildren(ty cty.Type, children map[string]*typeDefaults) *typeDefaults {
+	for key, child := range children {
+		if child == nil {
+			delete(children, key)
+		}
+	}
+	if len(children) == 0 {
+		return nil
+	}
+	return &typeDefaults{
+		Type:     ty,
+		Children: children,
+	}
+}
+
+func typeDiagnostic(expr hcl.Expression, detail string) hcl.Diagnostics {
+	return hcl.Diagnostics{{
+		Severity: hcl.DiagError,
+		Summary:  invalidTypeSummary,
+		Detail:   detail,
+		Subject:  expr.Range().Ptr(),
+	}}
+}
+
+// apply fills in default values for any missing or null optional attributes. The result may not conform to the
+// declared type until it has been converted.
+func (d *typeDefaults) apply(val cty.Value) cty.Value {
+	if d == nil || val.IsNull() || !val.IsKnown() {
+		return val
+	}
+	ty := val.Type()
+	switch {
+	case d.Type.IsObjectType() && (ty.IsObjectType() || ty.IsMapType()):
+		attrs := val.AsValueMap()
+		if attrs == nil {
+			attrs = make(map[string]cty.Value)
+		}
+		for name, def := range d.Defaults {
+			if existing, ok := attrs[name]; !ok || existing.IsNull() {
+				attrs[name] = def
+			}
+		}
+		for name, child := range d.Children {
+			if existing, ok := attrs[name]; ok {
+				attrs[name] = child.apply(existing)
+			}
+		}
+		return cty.ObjectVal(attrs)
+	case d.Type.IsMapType() && (ty.IsObjectType() || ty.IsMapType()):
+		attrs := val.AsValueMap()
+		if len(attrs) == 0 {
+			return val
+		}
+		for key, elem := range attrs {
+			attrs[key] = d.Children[""].apply(elem)
+		}
+		return cty.ObjectVal(attrs)
+	case (d.Type.IsListType() || d.Type.IsSetType() || d.Type.IsTupleType()) && (ty.IsListType() || ty.IsSetType() || ty.IsTupleType()):
+		elems := val.AsValueSlice()
+		if len(elems) == 0 {
+			return val
+		}
+		for i, elem := range elems {
+			key := ""
+			if d.Type.IsTupleType() {
+				key = strconv.Itoa(i)
+			}
+			elems[i] = d.Children[key].apply(elem)
+		}
+		return cty.TupleVal(elems)
+	}
+	return val
+}
@@ -11,6 +11,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/hcl/v2"
+
 	"github.com/aquasecurity/defsec/internal/debug"
 	"github.com/aquasecurity/defsec/pkg/scanners/options"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/aquasecurity/defsec/pkg/scanners/terraform/executor"
 	"github.com/aquasecurity/defsec/pkg/scanners/terraform/parser"
 	"github.com/aquasecurity/defsec/pkg/scanners/terraform/parser/resolvers"
+	"github.com/aquasecurity/defsec/pkg/terraform"
 
 	"github.com/aquasecurity/defsec/pkg/scan"
 
@@ -41,6 +44,7 @@ type Scanner struct {
 	execLock                sync.RWMutex
 	debug                   debug.Logger
 	enableEmbeddedLibraries bool
+	diagnostics             hcl.Diagnostics
 	sync.Mutex
 	loadEmbedded bool
 }
@@ -121,6 +125,29 @@ func New(options ...options.ScannerOption) *Scanner {
 	return s
 }
 
+// Diagnostics returns the problems found by the last scan which did not prevent it, such as variable values which fail
+// their type constraint or validation blocks, and ignores which did not match any failed result
+func (s *Scanner) Diagnostics() hcl.Diagnostics {
+	return s.diagnostics
+}
+
+func unusedIgnoreDiagnostics(ignores terraform.Ignores) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+	for _, ignore := range ignores {
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagWarning,
+			Summary:  "Unused ignore",
+			Detail:   fmt.Sprintf("The ignore for %q did not match any failed result, so it can be removed.", ignore.RuleID),
+			Subject: &hcl.Range{
+				Filename: ignore.Range.GetFilename(),
+				Start:    hcl.Pos{Line: ignore.Range.GetStartLine()},
+				End:      hcl.Pos{Line: ignore.Range.GetEndLine()},
+			},
+		})
+	}
+	return diags
+}
+
 func (s *Scanner) ScanFS(ctx context.Context, target fs.FS, dir string) (scan.Results, error) {
 	results, _, err := s.ScanFSWithMetrics(ctx, target, dir)
 	return results, err
@@ -148,6 +175,7 @@ func (s *Scanner) initRegoScanner(srcFS fs.FS) (*rego.Scanner, error) {
 func (s *Scanner) ScanFSWithMetrics(ctx context.Context, target fs.FS, dir string) (scan.Results, Metrics, error) {
 
 	var metrics Metrics
+	s.diagnostics = nil
 
 	s.debug.Log("scanning [%s] at %s", target, dir)
 
@@ -190,6 +218,8 @@ func (s *Scanner) ScanFSWithMetrics(ctx context.Context, target fs.FS, dir strin
 			return nil, metrics, err
 		}
 
+		s.diagnostics = append(s.diagnostics, p.Diagnostics()...)
+
 		parserMetrics := p.Metrics()
 		metrics.Parser.Counts.Blocks += parserMetrics.Counts.Blocks
 		metrics.Parser.Counts.Modules += parserMetrics.Counts.Modules
@@ -223,12 +253,16 @@ func (s *Scanner) ScanFSWithMetrics(ctx context.Context, target fs.FS, dir strin
 		metrics.Executor.Counts.Passed += execMetrics.Counts.Passed
 		metrics.Executor.Counts.Failed += execMetrics.Counts.Failed
 		metrics.Executor.Counts.Ignored += execMetrics.Counts.Ignored
+		metrics.Executor.Counts.Unresolved += execMetrics.Counts.Unresolved
 		metrics.Executor.Counts.Critical += execMetrics.Counts.Critical
 		metrics.Executor.Counts.High += execMetrics.Counts.High
 		metrics.Executor.Counts.Medium += execMetrics.Counts.Medium
 		metrics.Executor.Counts.Low += execMetrics.Counts.Low
 		metrics.Executor.Timings.Adaptation += execMetrics.Timings.Adaptation
 		metrics.Executor.Timings.RunningChecks += execMetrics.Timings.RunningChecks
+		metrics.Executor.Ignores.Unused = append(metrics.Executor.Ignores.Unused, execMetrics.Ignores.Unused...)
+		s.diagnostics = append(s.diagnostics, unusedIgnoreDiagnostics(execMetrics.Ignores.Unused)...)
+		metrics.Executor.Ignores.Rejected = append(metrics.Executor.Ignores.Rejected, execMetrics.Ignores.Rejected...)
 
 		allResults = append(allResults, results...)
 	}
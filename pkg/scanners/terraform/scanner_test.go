@@ -636,3 +636,273 @@ deny[res] {
 		fmt.Printf("Debug logs:\n%s\n", debugLog.String())
 	}
 }
+
+func Test_ResultProvenance(t *testing.T) {
+	reg := rules.Register(scan.Rule{
+		Provider:  providers.AWSProvider,
+		Service:   "service",
+		ShortCode: "acl",
+		Severity:  severity.High,
+		CustomChecks: scan.CustomChecks{
+			Terraform: &scan.TerraformCustomCheck{
+				RequiredTypes:  []string{"resource"},
+				RequiredLabels: []string{"aws_s3_bucket"},
+				Check: func(resourceBlock *terraform.Block, _ *terraform.Module) (results scan.Results) {
+					if acl := resourceBlock.GetAttribute("acl"); acl.Equals("public-read") {
+						results.Add("public acl", acl)
+					}
+					return
+				},
+			},
+		},
+	}, nil)
+	defer rules.Deregister(reg)
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/main.tf": `
+variable "acl" {
+	default = "private"
+}
+
+locals {
+	acl = var.acl
+}
+
+resource "aws_s3_bucket" "this" {
+	acl = local.acl
+}
+`,
+		"code/prod.tfvars": `acl = "public-read"`,
+	})
+
+	scanner := New(ScannerWithTFVarsPaths("code/prod.tfvars"), ScannerWithIncludedRules([]string{"aws-service-acl"}))
+	results, err := scanner.ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+	require.Len(t, results.GetFailed(), 1)
+
+	provenance := results.GetFailed()[0].Provenance()
+	require.Len(t, provenance, 3)
+	assert.Equal(t, "local.acl", provenance[0].Name)
+	assert.Equal(t, "var.acl", provenance[1].Name)
+	assert.Equal(t, "code/prod.tfvars", provenance[2].Range.GetLocalFilename())
+
+	flat := results.GetFailed()[0].Flatten()
+	require.Len(t, flat.Provenance, 3)
+	assert.Equal(t, "tfvars", flat.Provenance[2].Kind)
+	assert.Equal(t, 1, flat.Provenance[2].Location.StartLine)
+}
+
+func Test_ResultProvenance_Rego(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"code/main.tf": `
+variable "name" {
+	default = "good"
+}
+
+locals {
+	name = var.name
+}
+
+resource "aws_s3_bucket" "this" {
+	bucket = local.name
+}
+`,
+		"code/prod.tfvars": `name = "evil"`,
+		"rules/test.rego": `
+package defsec.abcdefg
+
+import data.lib.result
+
+__rego_metadata__ := {
+	"id": "TEST123",
+	"avd_id": "AVD-TEST-0123",
+	"title": "Buckets should not be evil",
+	"short_code": "no-evil-buckets",
+	"severity": "CRITICAL",
+	"type": "DefSec Security Check",
+}
+
+__rego_input__ := {
+	"combine": false,
+	"selector": [{"type": "defsec"}],
+}
+
+deny[res] {
+	bucket := input.aws.s3.buckets[_]
+	bucket.name.value == "evil"
+	res := result.new("oh no", bucket.name)
+}
+`,
+	})
+
+	scanner := New(
+		options.ScannerWithPolicyDirs("rules"),
+		ScannerWithRegoOnly(true),
+		ScannerWithEmbeddedLibraries(true),
+		ScannerWithTFVarsPaths("code/prod.tfvars"),
+	)
+	results, err := scanner.ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+	require.Len(t, results.GetFailed(), 1)
+
+	provenance := results.GetFailed()[0].Provenance()
+	require.Len(t, provenance, 3)
+	assert.Equal(t, "local.name", provenance[0].Name)
+	assert.Equal(t, "var.name", provenance[1].Name)
+	assert.Equal(t, "code/prod.tfvars", provenance[2].Range.GetLocalFilename())
+	assert.Equal(t, 1, provenance[2].Range.GetStartLine())
+}
+
+func Test_OptionWithUnresolvedReporting(t *testing.T) {
+	reg := rules.Register(scan.Rule{
+		Provider:  providers.AWSProvider,
+		Service:   "service",
+		ShortCode: "versioning",
+		Severity:  severity.High,
+	}, func(s *state.State) (results scan.Results) {
+		for _, bucket := range s.AWS.S3.Buckets {
+			if bucket.Versioning.Enabled.IsFalse() {
+				results.Add("Bucket is not versioned", bucket.Versioning.Enabled)
+			} else {
+				results.AddPassed(bucket.Versioning.Enabled)
+			}
+		}
+		return
+	})
+	defer rules.Deregister(reg)
+
+	// a check which never reads the unknown attribute should not be flagged
+	named := rules.Register(scan.Rule{
+		Provider:  providers.AWSProvider,
+		Service:   "service",
+		ShortCode: "named",
+		Severity:  severity.High,
+	}, func(s *state.State) (results scan.Results) {
+		for _, bucket := range s.AWS.S3.Buckets {
+			results.AddPassed(&bucket)
+		}
+		return
+	})
+	defer rules.Deregister(named)
+
+	code := `
+data "aws_ssm_parameter" "versioning" {
+	name = "versioning"
+}
+
+resource "aws_s3_bucket" "unknown" {
+	bucket = "unknown"
+	versioning {
+		enabled = data.aws_ssm_parameter.versioning.value
+	}
+}
+
+resource "aws_s3_bucket" "enabled" {
+	bucket = "enabled"
+	versioning {
+		enabled = true
+	}
+}
+`
+	included := ScannerWithIncludedRules([]string{"aws-service-versioning", "aws-service-named"})
+
+	results := scanWithOptions(t, code, included)
+	require.Len(t, results.GetPassed(), 4)
+	assert.Empty(t, results.GetUnresolved())
+
+	results = scanWithOptions(t, code, included, ScannerWithUnresolvedReporting(true))
+	require.Len(t, results.GetPassed(), 4)
+	unresolved := results.GetUnresolved()
+	require.Len(t, unresolved, 1)
+	assert.Equal(t, "aws-service-versioning", unresolved[0].Rule().LongID())
+	assert.Equal(t, 9, unresolved[0].Range().GetStartLine())
+	assert.True(t, unresolved[0].Flatten().Unresolved)
+}
+
+func Test_OptionWithUnresolvedReporting_Rego(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"/code/main.tf": `
+data "aws_ssm_parameter" "versioning" {
+	name = "versioning"
+}
+
+resource "aws_s3_bucket" "unknown" {
+	bucket = "unknown"
+	versioning {
+		enabled = data.aws_ssm_parameter.versioning.value
+	}
+}
+
+resource "aws_s3_bucket" "disabled" {
+	bucket = "disabled"
+	versioning {
+		enabled = false
+	}
+}
+`,
+		"/rules/test.rego": `
+package defsec.abcdefg
+
+__rego_metadata__ := {
+	"id": "TEST123",
+	"avd_id": "AVD-TEST-0123",
+	"title": "Buckets should be versioned",
+	"short_code": "versioned-buckets",
+	"severity": "HIGH",
+	"type": "DefSec Security Check",
+}
+
+__rego_input__ := {
+	"combine": false,
+	"selector": [{"type": "defsec"}],
+}
+
+deny[cause] {
+	bucket := input.aws.s3.buckets[_]
+	bucket.versioning.enabled.value == false
+	cause := bucket.versioning.enabled
+}
+`,
+	})
+
+	scanner := New(
+		options.ScannerWithPolicyDirs("rules"),
+		ScannerWithRegoOnly(true),
+		ScannerWithUnresolvedReporting(true),
+	)
+
+	results, err := scanner.ScanFS(context.TODO(), fs, "code")
+	require.NoError(t, err)
+
+	require.Len(t, results.GetFailed(), 2)
+	unresolved := results.GetUnresolved()
+	require.Len(t, unresolved, 1)
+	assert.Equal(t, 9, unresolved[0].Range().GetStartLine())
+}
+
+func Test_ScannerDiagnostics(t *testing.T) {
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"project/main.tf": `
+variable "environment" {
+	type    = string
+	default = "staging"
+	validation {
+		condition     = contains(["dev", "prod"], var.environment)
+		error_message = "Environment must be dev or prod."
+	}
+}
+`,
+	})
+
+	scanner := New(options.ScannerWithEmbeddedPolicies(false))
+	_, err := scanner.ScanFS(context.TODO(), fs, "project")
+	require.NoError(t, err)
+
+	diags := scanner.Diagnostics()
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Environment must be dev or prod.", diags[0].Detail)
+	assert.Equal(t, "project/main.tf", diags[0].Subject.Filename)
+}
@@ -45,6 +45,24 @@ func (a *Attribute) GetMetadata() types.Metadata {
 	return a.metadata
 }
 
+// SetProvenance records the chain of variables, locals and other definitions which supplied the attribute's value
+func (a *Attribute) SetProvenance(steps ...types.ProvenanceStep) {
+	a.metadata = a.metadata.WithProvenance(steps...)
+}
+
+// Provenance returns the chain of definitions which supplied the attribute's value, nearest first
+func (a *Attribute) Provenance() []types.ProvenanceStep {
+	return a.metadata.Provenance()
+}
+
+// HCLAttribute returns the underlying hcl attribute, for callers which need the unevaluated expression
+func (a *Attribute) HCLAttribute() *hcl.Attribute {
+	if a == nil {
+		return nil
+	}
+	return a.hclAttribute
+}
+
 func (a *Attribute) GetRawValue() interface{} {
 	switch typ := a.Type(); typ {
 	case cty.String:
@@ -238,6 +256,8 @@ func (a *Attribute) Value() (ctyVal cty.Value) {
 		}
 	}()
 	ctyVal, _ = a.hclAttribute.Expr.Value(a.ctx.Inner())
+	// marks such as "sensitive" only affect how terraform displays values, so they're removed for analysis
+	ctyVal, _ = ctyVal.UnmarkDeep()
 	if !ctyVal.IsKnown() || ctyVal.IsNull() {
 		return cty.NilVal
 	}
@@ -325,6 +345,7 @@ func (a *Attribute) valueToStrings(value cty.Value) (results []types.StringValue
 			results = []types.StringValue{types.StringUnresolvable(a.metadata)}
 		}
 	}()
+	value, _ = value.UnmarkDeep()
 	if value.IsNull() {
 		return nil
 	}
@@ -348,6 +369,7 @@ func (a *Attribute) valueToString(value cty.Value) (result types.StringValue) {
 
 	result = types.StringUnresolvable(a.metadata)
 
+	value, _ = value.UnmarkDeep()
 	if value.IsNull() || !value.IsKnown() {
 		return result
 	}
@@ -104,7 +104,7 @@ func NewBlock(hclBlock *hcl.Block, ctx *context.Context, moduleBlock *Block, par
 			children = append(children, NewBlock(b2.AsHCLBlock(), ctx, moduleBlock, &b, moduleSource, moduleFS))
 		}
 	default:
-		content, _, diag := hclBlock.Body.PartialContent(Schema)
+		content, _, diag := hclBlock.Body.PartialContent(nestedSchema(hclBlock.Type))
 		if diag == nil {
 			for _, hb := range content.Blocks {
 				children = append(children, NewBlock(hb, ctx, moduleBlock, &b, moduleSource, moduleFS))
@@ -231,7 +231,7 @@ func (b *Block) createAttributes() hcl.Attributes {
 		}
 		return attributes
 	default:
-		_, body, diag := b.hclBlock.Body.PartialContent(Schema)
+		_, body, diag := b.hclBlock.Body.PartialContent(nestedSchema(b.hclBlock.Type))
 		if diag != nil {
 			return nil
 		}
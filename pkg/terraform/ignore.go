@@ -15,7 +15,11 @@ type Ignore struct {
 	Expiry    *time.Time
 	Workspace string
 	Block     bool
-	Params    map[string]string
+	// File is set for tfsec:ignore-file comments, which cover everything defined in the file they appear in
+	File   bool
+	Params map[string]string
+	// Reason is the justification given after the ignore, if any
+	Reason string
 }
 
 type Ignores []Ignore
@@ -29,11 +33,16 @@ func (ignores Ignores) Covering(modules Modules, m types.Metadata, workspace str
 	return nil
 }
 
-func (ignore Ignore) Covering(modules Modules, m types.Metadata, workspace string, ids ...string) bool {
+// IsActive returns false for ignores which have expired or which apply only to a different workspace
+func (ignore Ignore) IsActive(workspace string) bool {
 	if ignore.Expiry != nil && time.Now().After(*ignore.Expiry) {
 		return false
 	}
-	if ignore.Workspace != "" && ignore.Workspace != workspace {
+	return ignore.Workspace == "" || ignore.Workspace == workspace
+}
+
+func (ignore Ignore) Covering(modules Modules, m types.Metadata, workspace string, ids ...string) bool {
+	if !ignore.IsActive(workspace) {
 		return false
 	}
 	idMatch := ignore.RuleID == "*" || len(ids) == 0
@@ -48,11 +57,19 @@ func (ignore Ignore) Covering(modules Modules, m types.Metadata, workspace strin
 	}
 
 	metaHierarchy := &m
+	if ignore.File {
+		// only the result's own location is compared, as its parents include the calls of the module it is defined in,
+		// which live in other files
+		if m.Range() == nil || !ignore.inFileOf(m.Range()) {
+			return false
+		}
+		return ignore.MatchParams(modules, &m)
+	}
 	for metaHierarchy != nil {
 		if metaHierarchy.Range() == nil {
 			break
 		}
-		if ignore.Range.GetFilename() != metaHierarchy.Range().GetFilename() {
+		if !ignore.inFileOf(metaHierarchy.Range()) {
 			metaHierarchy = metaHierarchy.Parent()
 			continue
 		}
@@ -65,6 +82,10 @@ func (ignore Ignore) Covering(modules Modules, m types.Metadata, workspace strin
 
 }
 
+func (ignore Ignore) inFileOf(r types.Range) bool {
+	return ignore.Range.GetFSKey() == r.GetFSKey() && ignore.Range.GetFilename() == r.GetFilename()
+}
+
 func (ignore Ignore) MatchParams(modules Modules, blockMetadata *types.Metadata) bool {
 	if len(ignore.Params) == 0 {
 		return true
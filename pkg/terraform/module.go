@@ -2,7 +2,10 @@ package terraform
 
 import (
 	"fmt"
+	"io"
 	"strings"
+
+	"github.com/aquasecurity/defsec/internal/debug"
 )
 
 type Module struct {
@@ -11,6 +14,7 @@ type Module struct {
 	rootPath   string
 	modulePath string
 	ignores    Ignores
+	debug      debug.Logger
 }
 
 func NewModule(rootPath string, modulePath string, blocks Blocks, ignores Ignores) *Module {
@@ -32,6 +36,17 @@ func NewModule(rootPath string, modulePath string, blocks Blocks, ignores Ignore
 	}
 }
 
+// SetDebugWriter sets where the debug log of the module is written, which adapters use to report content they can't
+// use. Nothing is logged until a writer is set.
+func (c *Module) SetDebugWriter(w io.Writer) {
+	c.debug = debug.New(w, "adapt:terraform")
+}
+
+// Debug writes to the debug log of the scan, for adapters to report content they can't use
+func (c *Module) Debug(format string, args ...interface{}) {
+	c.debug.Log(format, args...)
+}
+
 func (c *Module) RootPath() string {
 	return c.rootPath
 }
@@ -2,8 +2,11 @@ package terraform
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aquasecurity/defsec/internal/types"
+
+	"github.com/hashicorp/hcl/v2"
 )
 
 type Modules []*Module
@@ -97,3 +100,88 @@ func (m Modules) GetBlockByIgnoreRange(blockMetadata *types.Metadata) *Block {
 
 	return nil
 }
+
+// GetProviderBlock returns the provider block which configures the given resource or data block. This is either the
+// provider named by the block's provider argument, or the default configuration of the provider implied by the
+// resource type. Configurations are looked up in the block's own module first, then in parent modules, following
+// provider configurations passed down via the providers argument of each module block.
+func (m Modules) GetProviderBlock(block *Block) *Block {
+	providerName, alias := providerReference(block)
+	moduleBlock := block.moduleBlock
+	for {
+		if module := m.moduleFor(moduleBlock); module != nil {
+			if providers := module.GetProviderBlocksByProvider(providerName, alias); len(providers) > 0 {
+				return providers[0]
+			}
+		}
+		if moduleBlock == nil {
+			return nil
+		}
+		var inherited bool
+		if alias, inherited = inheritedProviderAlias(moduleBlock, providerName, alias); !inherited {
+			return nil
+		}
+		moduleBlock = moduleBlock.moduleBlock
+	}
+}
+
+func (m Modules) moduleFor(moduleBlock *Block) *Module {
+	for _, module := range m {
+		if len(module.blocks) > 0 && module.blocks[0].moduleBlock == moduleBlock {
+			return module
+		}
+	}
+	return nil
+}
+
+// providerReference returns the provider name and alias used by a resource or data block
+func providerReference(block *Block) (string, string) {
+	if attr := block.GetAttribute("provider"); attr.IsNotNil() && attr.hclAttribute != nil {
+		if name, alias, ok := splitProviderTraversal(attr.hclAttribute.Expr); ok {
+			return name, alias
+		}
+	}
+	name, _, _ := strings.Cut(block.TypeLabel(), "_")
+	return name, ""
+}
+
+// inheritedProviderAlias returns the alias of the provider configuration in the parent module which is used by a
+// module for the given provider. Only default configurations are inherited implicitly, and only when the module
+// block does not pass providers explicitly.
+func inheritedProviderAlias(moduleBlock *Block, providerName string, alias string) (string, bool) {
+	attr := moduleBlock.GetAttribute("providers")
+	if attr.IsNil() || attr.hclAttribute == nil {
+		return "", alias == ""
+	}
+	pairs, diags := hcl.ExprMap(attr.hclAttribute.Expr)
+	if diags.HasErrors() {
+		return "", false
+	}
+	for _, pair := range pairs {
+		childName, childAlias, ok := splitProviderTraversal(pair.Key)
+		if !ok || childName != providerName || childAlias != alias {
+			continue
+		}
+		parentName, parentAlias, ok := splitProviderTraversal(pair.Value)
+		if !ok || parentName != providerName {
+			return "", false
+		}
+		return parentAlias, true
+	}
+	return "", false
+}
+
+func splitProviderTraversal(expr hcl.Expression) (string, string, bool) {
+	traversal, diags := hcl.AbsTraversalForExpr(expr)
+	if diags.HasErrors() || len(traversal) == 0 {
+		return "", "", false
+	}
+	name := traversal.RootName()
+	if len(traversal) == 1 {
+		return name, "", true
+	}
+	if attr, ok := traversal[1].(hcl.TraverseAttr); ok {
+		return name, attr.Name, true
+	}
+	return "", "", false
+}
@@ -41,5 +41,41 @@ var Schema = &hcl.BodySchema{
 		{
 			Type: "moved",
 		},
+		{
+			Type: "import",
+		},
+		{
+			Type: "removed",
+		},
+		{
+			Type:       "check",
+			LabelNames: []string{"name"},
+		},
+		// the following is only valid as a nested block, but is listed so that it is parsed from JSON configuration,
+		// where nested blocks are read using this schema
+		{
+			Type: "cloud",
+		},
 	},
 }
+
+// checkSchema describes the blocks nested in a check block, which are only read using a schema from JSON configuration
+var checkSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{
+			Type:       "data",
+			LabelNames: []string{"type", "name"},
+		},
+		{
+			Type: "assert",
+		},
+	},
+}
+
+// nestedSchema returns the schema used to read the nested blocks of a block of the given type from JSON configuration
+func nestedSchema(blockType string) *hcl.BodySchema {
+	if blockType == "check" {
+		return checkSchema
+	}
+	return Schema
+}
@@ -0,0 +1,135 @@
+package test
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	cfAWS "github.com/aquasecurity/defsec/internal/adapters/cloudformation/aws"
+	tfAWS "github.com/aquasecurity/defsec/internal/adapters/terraform/aws"
+	"github.com/aquasecurity/defsec/internal/rules"
+	"github.com/aquasecurity/defsec/internal/types"
+	"github.com/aquasecurity/defsec/pkg/providers"
+	"github.com/aquasecurity/defsec/pkg/scanners/cloudformation/parser"
+	tfParser "github.com/aquasecurity/defsec/pkg/scanners/terraform/parser"
+	"github.com/aquasecurity/defsec/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// terraformOnlyFields are fields of the AWS provider which have no equivalent in CloudFormation, so are only
+// populated by the Terraform adapter
+var terraformOnlyFields = map[string]string{
+	"athena.Athena.Databases":    "athena databases are created through glue in CloudFormation",
+	"athena.Database.Name":       "athena databases are created through glue in CloudFormation",
+	"athena.Database.Encryption": "athena databases are created through glue in CloudFormation",
+	"iam.IAM.PasswordPolicy":     "the account password policy can't be managed by CloudFormation",
+	"vpc.VPC.DefaultVPCs":        "the default VPC can't be managed by CloudFormation",
+	"vpc.DefaultVPC.Tags":        "the default VPC can't be managed by CloudFormation",
+}
+
+// Test_AWSAdapterCoverage adapts the example code of every AWS rule with the Terraform and CloudFormation adapters,
+// and fails for any field which the Terraform adapter populates but the CloudFormation adapter never does.
+func Test_AWSAdapterCoverage(t *testing.T) {
+	terraformFields := make(map[string]bool)
+	cloudformationFields := make(map[string]bool)
+
+	for _, rule := range rules.GetRegistered() {
+		if rule.Rule().Provider != providers.AWSProvider {
+			continue
+		}
+		if engine := rule.Rule().Terraform; engine != nil {
+			for _, example := range append(engine.GoodExamples, engine.BadExamples...) {
+				fs := testutil.CreateFS(t, map[string]string{
+					"main.tf": example,
+				})
+				p := tfParser.New(fs, "")
+				require.NoError(t, p.ParseFS(context.TODO(), "."))
+				modules, _, err := p.EvaluateAll(context.TODO())
+				require.NoError(t, err)
+				collectPopulatedFields(reflect.ValueOf(tfAWS.Adapt(modules)), terraformFields)
+			}
+		}
+		if engine := rule.Rule().CloudFormation; engine != nil {
+			for _, example := range append(engine.GoodExamples, engine.BadExamples...) {
+				fs := testutil.CreateFS(t, map[string]string{
+					"main.yaml": example,
+				})
+				file, err := parser.New().ParseFile(context.TODO(), fs, "main.yaml")
+				require.NoError(t, err)
+				collectPopulatedFields(reflect.ValueOf(cfAWS.Adapt(*file)), cloudformationFields)
+			}
+		}
+	}
+
+	var missing []string
+	for field := range terraformFields {
+		if _, ok := terraformOnlyFields[field]; ok {
+			continue
+		}
+		if !cloudformationFields[field] {
+			missing = append(missing, field)
+		}
+	}
+	sort.Strings(missing)
+
+	if len(missing) > 0 {
+		t.Errorf("Fields populated from the Terraform examples, but not from the CloudFormation examples:\n%v", missing)
+	}
+}
+
+type adaptedValue interface {
+	GetMetadata() types.Metadata
+	GetRawValue() interface{}
+}
+
+var adaptedValueType = reflect.TypeOf((*adaptedValue)(nil)).Elem()
+
+// collectPopulatedFields records each field of the adapted state which was set from the source, named by the type
+// which declares it, such as "s3.Bucket.Name". Lists are populated when they have any items.
+func collectPopulatedFields(value reflect.Value, fields map[string]bool) {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !value.IsNil() {
+			collectPopulatedFields(value.Elem(), fields)
+		}
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			collectPopulatedFields(value.Index(i), fields)
+		}
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			collectPopulatedFields(value.MapIndex(key), fields)
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() || field.Anonymous {
+				continue
+			}
+			fieldValue := value.Field(i)
+			name := value.Type().String() + "." + field.Name
+			if isPopulated(fieldValue) {
+				fields[name] = true
+			}
+			if !field.Type.Implements(adaptedValueType) {
+				collectPopulatedFields(fieldValue, fields)
+			}
+		}
+	}
+}
+
+func isPopulated(value reflect.Value) bool {
+	if (value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr) && value.IsNil() {
+		return false
+	}
+	if value.Type().Implements(adaptedValueType) {
+		metadata := value.Interface().(adaptedValue).GetMetadata()
+		return metadata.IsManaged() && !metadata.IsDefault()
+	}
+	switch value.Kind() {
+	case reflect.Slice, reflect.Map:
+		return value.Len() > 0
+	}
+	return false
+}
@@ -1,9 +1,15 @@
 package test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	tfScanner "github.com/aquasecurity/defsec/pkg/scanners/terraform"
+	"github.com/aquasecurity/defsec/test/testutil"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/stretchr/testify/require"
+
 	"github.com/aquasecurity/defsec/pkg/terraform"
 
 	"github.com/aquasecurity/defsec/pkg/severity"
@@ -326,3 +332,182 @@ resource "bad" "my-rule" {
 `, "testworkspace")
 	assert.Len(t, results.GetFailed(), 1)
 }
+
+func Test_IgnoreFile(t *testing.T) {
+	reg := rules.Register(exampleRule, nil)
+	defer rules.Deregister(reg)
+
+	results := scanHCL(t, `
+# tfsec:ignore-file:aws-service-abc123
+
+resource "bad" "first" {
+	secure = false
+}
+
+resource "bad" "second" {
+}
+`)
+	assert.Len(t, results.GetFailed(), 0)
+	assert.Len(t, results.GetIgnored(), 2)
+}
+
+func Test_IgnoreFileWithOtherID(t *testing.T) {
+	reg := rules.Register(exampleRule, nil)
+	defer rules.Deregister(reg)
+
+	results := scanHCL(t, `
+# tfsec:ignore-file:aws-service-other
+
+resource "bad" "first" {
+	secure = false
+}
+`)
+	assert.Len(t, results.GetFailed(), 1)
+}
+
+func Test_IgnoreFileDoesNotCoverCalledModules(t *testing.T) {
+	reg := rules.Register(exampleRule, nil)
+	defer rules.Deregister(reg)
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"project/main.tf": `
+# tfsec:ignore-file:aws-service-abc123
+
+module "bad" {
+	source = "./modules/bad"
+}
+
+resource "bad" "my-rule" {
+	secure = false
+}
+`,
+		"project/modules/bad/main.tf": `
+resource "bad" "my-rule" {
+	secure = false
+}
+`,
+	})
+
+	results, err := tfScanner.New(tfScanner.ScannerWithIncludedRules([]string{exampleRule.LongID()})).ScanFS(context.TODO(), fs, "project")
+	require.NoError(t, err)
+
+	require.Len(t, results.GetFailed(), 1)
+	assert.Equal(t, "project/modules/bad/main.tf", results.GetFailed()[0].Range().GetFilename())
+	for _, ignored := range results.GetIgnored() {
+		assert.Equal(t, "project/main.tf", ignored.Range().GetFilename())
+	}
+}
+
+func Test_IgnoreMultipleIDsInOneComment(t *testing.T) {
+	reg := rules.Register(exampleRule, nil)
+	defer rules.Deregister(reg)
+
+	results := scanHCL(t, `
+# tfsec:ignore:aws-service-other,aws-service-abc123[secure=false]:exp:2221-01-02
+resource "bad" "my-rule" {
+	secure = false
+}
+`)
+	assert.Len(t, results.GetFailed(), 0)
+}
+
+func Test_IgnoreModuleBlock(t *testing.T) {
+	reg := rules.Register(exampleRule, nil)
+	defer rules.Deregister(reg)
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"project/main.tf": `
+# tfsec:ignore:aws-service-abc123
+module "ignored" {
+	source = "./modules/bad"
+}
+
+module "scanned" {
+	source = "./modules/bad"
+}
+`,
+		"project/modules/bad/main.tf": `
+resource "bad" "my-rule" {
+	secure = false
+}
+`,
+	})
+
+	results, err := tfScanner.New(tfScanner.ScannerWithIncludedRules([]string{exampleRule.LongID()})).ScanFS(context.TODO(), fs, "project")
+	require.NoError(t, err)
+
+	assert.Len(t, results.GetFailed(), 1)
+	assert.Len(t, results.GetIgnored(), 1)
+}
+
+func Test_IgnoreReasonRequired(t *testing.T) {
+	reg := rules.Register(exampleRule, nil)
+	defer rules.Deregister(reg)
+
+	source := `
+resource "bad" "justified" {
+	secure = false # tfsec:ignore:aws-service-abc123 -- only holds public test fixtures
+}
+
+resource "bad" "unjustified" {
+	secure = false # tfsec:ignore:aws-service-abc123
+}
+`
+
+	results := scanHCL(t, source)
+	assert.Len(t, results.GetFailed(), 0)
+
+	results = scanHCL(t, source, tfScanner.ScannerWithIgnoreReasonRequired(true))
+	require.Len(t, results.GetFailed(), 1)
+	assert.Equal(t, 7, results.GetFailed()[0].Range().GetStartLine())
+}
+
+func Test_IgnoreMetrics(t *testing.T) {
+	reg := rules.Register(exampleRule, nil)
+	defer rules.Deregister(reg)
+
+	fs := testutil.CreateFS(t, map[string]string{
+		"project/main.tf": `
+resource "bad" "used" {
+	secure = false # tfsec:ignore:aws-service-abc123 -- accepted risk
+}
+
+# tfsec:ignore:aws-service-abc123
+resource "bad" "stale" {
+	secure = true
+}
+
+# tfsec:ignore:aws-service-abc123:exp:2000-01-01
+resource "bad" "expired" {
+	secure = true
+}
+`,
+	})
+
+	scanner := tfScanner.New(
+		tfScanner.ScannerWithIncludedRules([]string{exampleRule.LongID()}),
+	)
+	_, metrics, err := scanner.ScanFSWithMetrics(context.TODO(), fs, "project")
+	require.NoError(t, err)
+
+	require.Len(t, metrics.Executor.Ignores.Unused, 1)
+	assert.Equal(t, 6, metrics.Executor.Ignores.Unused[0].Range.GetStartLine())
+	assert.Empty(t, metrics.Executor.Ignores.Rejected)
+
+	diags := scanner.Diagnostics()
+	require.Len(t, diags, 1)
+	assert.Equal(t, hcl.DiagWarning, diags[0].Severity)
+	assert.Equal(t, 6, diags[0].Subject.Start.Line)
+
+	_, metrics, err = tfScanner.New(
+		tfScanner.ScannerWithIncludedRules([]string{exampleRule.LongID()}),
+		tfScanner.ScannerWithIgnoreReasonRequired(true),
+	).ScanFSWithMetrics(context.TODO(), fs, "project")
+	require.NoError(t, err)
+
+	assert.Empty(t, metrics.Executor.Ignores.Unused)
+	require.Len(t, metrics.Executor.Ignores.Rejected, 2)
+	for _, rejected := range metrics.Executor.Ignores.Rejected {
+		assert.Empty(t, rejected.Reason)
+	}
+}